@@ -0,0 +1,53 @@
+package cosmos
+
+import (
+	sdkmath "cosmossdk.io/math"
+	evmante "github.com/HarryBin2002/kairoschain/v12/app/ante/evm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BaseFeeBurnDecorator burns the configured share of the base fee part of the
+// Cosmos transaction fees from the fee collector. It is a no-op unless the
+// BurnCosmosTxFees fee market param is enabled.
+// NOTE: This decorator does not perform any validation and must be placed after
+// the DeductFeeDecorator
+type BaseFeeBurnDecorator struct {
+	feeMarketKeeper evmante.FeeMarketKeeper
+	evmKeeper       evmante.EVMKeeper
+}
+
+// NewBaseFeeBurnDecorator creates a new BaseFeeBurnDecorator instance used only
+// for Cosmos transactions.
+func NewBaseFeeBurnDecorator(fk evmante.FeeMarketKeeper, ek evmante.EVMKeeper) BaseFeeBurnDecorator {
+	return BaseFeeBurnDecorator{feeMarketKeeper: fk, evmKeeper: ek}
+}
+
+func (bbd BaseFeeBurnDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || simulate || !bbd.feeMarketKeeper.GetParams(ctx).BurnCosmosTxFees {
+		return next(ctx, tx, simulate)
+	}
+
+	evmParams := bbd.evmKeeper.GetParams(ctx)
+	ethCfg := evmParams.GetChainConfig().EthereumConfig(bbd.evmKeeper.ChainID())
+	baseFee := bbd.evmKeeper.GetBaseFee(ctx, ethCfg)
+	if baseFee == nil || baseFee.Sign() <= 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	// the base fee part is capped by the fee paid, any surplus is the tip
+	paid := feeTx.GetFee().AmountOf(evmParams.EvmDenom)
+	baseFeePaid := sdkmath.MinInt(paid, sdkmath.NewIntFromBigInt(baseFee).Mul(sdkmath.NewIntFromUint64(feeTx.GetGas())))
+	if baseFeePaid.IsPositive() {
+		_, err := bbd.feeMarketKeeper.BurnBaseFee(
+			ctx,
+			sdk.Coins{sdk.NewCoin(evmParams.EvmDenom, baseFeePaid)},
+			sdk.Coins{sdk.NewCoin(evmParams.EvmDenom, paid)},
+		)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,106 @@
+package cosmos_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cosmosante "github.com/HarryBin2002/kairoschain/v12/app/ante/cosmos"
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	"github.com/HarryBin2002/kairoschain/v12/testutil"
+	testutiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+)
+
+func (suite *AnteTestSuite) TestBaseFeeBurnDecorator() {
+	denom := constants.BaseDenom
+	baseFee := sdkmath.NewInt(100)
+	testMsg := banktypes.MsgSend{
+		FromAddress: "kairos1x8fhpj9nmhqk8z9kpgjt95ck2xwyue0p7g88sh",
+		ToAddress:   "kairos1dx67l23hz9l0k9hcher8xz04uj7wf3yulsw3v9",
+		Amount:      sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: denom}},
+	}
+
+	testCases := []struct {
+		name      string
+		enabled   bool
+		simulate  bool
+		tx        func() sdk.Tx
+		expBurned sdkmath.Int
+	}{
+		{
+			"not a fee tx",
+			true,
+			false,
+			func() sdk.Tx { return &testutiltx.InvalidTx{} },
+			sdkmath.ZeroInt(),
+		},
+		{
+			"burn of Cosmos tx fees disabled",
+			false,
+			false,
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(sdkmath.NewInt(150), denom, &testMsg).GetTx()
+			},
+			sdkmath.ZeroInt(),
+		},
+		{
+			"simulation is not burned",
+			true,
+			true,
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(sdkmath.NewInt(150), denom, &testMsg).GetTx()
+			},
+			sdkmath.ZeroInt(),
+		},
+		{
+			"gas price above the base fee, the tip is not burned",
+			true,
+			false,
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(sdkmath.NewInt(150), denom, &testMsg).GetTx()
+			},
+			baseFee.MulRaw(int64(TestGasLimit)),
+		},
+		{
+			"gas price below the base fee, the whole fee is burned",
+			true,
+			false,
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(sdkmath.NewInt(50), denom, &testMsg).GetTx()
+			},
+			sdkmath.NewInt(50).MulRaw(int64(TestGasLimit)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			params := suite.app.FeeMarketKeeper.GetParams(ctx)
+			params.BurnCosmosTxFees = tc.enabled
+			err := suite.app.FeeMarketKeeper.SetParams(ctx, params)
+			suite.Require().NoError(err)
+			suite.app.FeeMarketKeeper.SetBaseFee(ctx, big.NewInt(baseFee.Int64()))
+
+			// the fees are deducted to the fee collector before the burn
+			tx := tc.tx()
+			if feeTx, ok := tx.(sdk.FeeTx); ok {
+				err = testutil.FundModuleAccount(ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, feeTx.GetFee())
+				suite.Require().NoError(err)
+			}
+			supplyBefore := suite.app.BankKeeper.GetSupply(ctx, denom)
+
+			dec := cosmosante.NewBaseFeeBurnDecorator(suite.app.FeeMarketKeeper, suite.app.EvmKeeper)
+			_, err = dec.AnteHandle(ctx, tx, tc.simulate, testutil.NextFn)
+			suite.Require().NoError(err)
+
+			burned := suite.app.FeeMarketKeeper.GetBurnedSupply(ctx).AmountOf(denom)
+			suite.Require().Equal(tc.expBurned.String(), burned.String())
+			supplyAfter := suite.app.BankKeeper.GetSupply(ctx, denom)
+			suite.Require().Equal(tc.expBurned.String(), supplyBefore.Amount.Sub(supplyAfter.Amount).String())
+		})
+	}
+}
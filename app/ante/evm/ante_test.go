@@ -1257,3 +1257,105 @@ func (suite *AnteTestSuite) TestAnteHandlerWithParams() {
 	}
 	suite.evmParamsOption = nil
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerWithAccessControlParams() {
+	addr, privKey := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	ethContractCreationTxParams := &evmtypes.EvmTxArgs{
+		ChainID:   suite.app.EvmKeeper.ChainID(),
+		Nonce:     1,
+		Amount:    big.NewInt(10),
+		GasLimit:  100000,
+		GasFeeCap: big.NewInt(ethparams.InitialBaseFee + 1),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &types.AccessList{},
+	}
+
+	ethTxParams := &evmtypes.EvmTxArgs{
+		ChainID:   suite.app.EvmKeeper.ChainID(),
+		Nonce:     1,
+		Amount:    big.NewInt(10),
+		GasLimit:  100000,
+		GasFeeCap: big.NewInt(ethparams.InitialBaseFee + 1),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &types.AccessList{},
+		To:        &to,
+	}
+
+	testCases := []struct {
+		name     string
+		txParams *evmtypes.EvmTxArgs
+		malleate func(params *evmtypes.Params)
+		expErr   error
+	}{
+		{
+			"success - allowed deployer",
+			ethContractCreationTxParams,
+			func(params *evmtypes.Params) {
+				params.AllowedDeployers = []string{addr.Hex()}
+			},
+			nil,
+		},
+		{
+			"fail - deployer not allowed",
+			ethContractCreationTxParams,
+			func(params *evmtypes.Params) {
+				params.AllowedDeployers = []string{to.Hex()}
+			},
+			evmtypes.ErrDeployerNotAllowed,
+		},
+		{
+			"success - call with deployer allowlist",
+			ethTxParams,
+			func(params *evmtypes.Params) {
+				params.AllowedDeployers = []string{to.Hex()}
+			},
+			nil,
+		},
+		{
+			"fail - call to denied contract",
+			ethTxParams,
+			func(params *evmtypes.Params) {
+				params.DeniedContracts = []string{to.Hex()}
+			},
+			evmtypes.ErrContractDenied,
+		},
+		{
+			"fail - blocked sender",
+			ethTxParams,
+			func(params *evmtypes.Params) {
+				params.BlockedSenders = []string{addr.Hex()}
+			},
+			evmtypes.ErrSenderBlocked,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.evmParamsOption = tc.malleate
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			suite.ctx = suite.ctx.WithIsCheckTx(true)
+			err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt((ethparams.InitialBaseFee+10)*100000))
+			suite.Require().NoError(err)
+
+			signedTx := evmtypes.NewTx(tc.txParams)
+			signedTx.From = addr.Hex()
+			tx := suite.CreateTestTx(signedTx, privKey, 1, false)
+
+			_, err = suite.anteHandler(suite.ctx, tx, false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(errors.Is(err, tc.expErr))
+			}
+		})
+	}
+	suite.evmParamsOption = nil
+}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	anteutils "github.com/HarryBin2002/kairoschain/v12/app/ante/utils"
 	"github.com/HarryBin2002/kairoschain/v12/types"
@@ -34,7 +35,8 @@ func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper)
 	}
 }
 
-// AnteHandle validates checks that the sender balance is greater than the total transaction cost.
+// AnteHandle validates checks that the sender balance is greater than the total transaction cost,
+// or than the value transferred if the gas is paid by a sponsor.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This AnteHandler decorator will fail if:
 // - any of the msgs is not a MsgEthereumTx
@@ -80,6 +82,18 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
+		// the gas of sponsored txs is paid by the fee granter, so the sender only
+		// needs to cover the value transferred
+		if isSponsored(tx, from) {
+			if acct.Balance.Cmp(txData.GetValue()) < 0 {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInsufficientFunds,
+					"sender balance < tx value (%s < %s)", acct.Balance, txData.GetValue(),
+				)
+			}
+			continue
+		}
+
 		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
 			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
 		}
@@ -92,6 +106,7 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 type EthGasConsumeDecorator struct {
 	bankKeeper         anteutils.BankKeeper
 	distributionKeeper anteutils.DistributionKeeper
+	feegrantKeeper     authante.FeegrantKeeper
 	evmKeeper          EVMKeeper
 	stakingKeeper      anteutils.StakingKeeper
 	maxGasWanted       uint64
@@ -101,6 +116,7 @@ type EthGasConsumeDecorator struct {
 func NewEthGasConsumeDecorator(
 	bankKeeper anteutils.BankKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	evmKeeper EVMKeeper,
 	stakingKeeper anteutils.StakingKeeper,
 	maxGasWanted uint64,
@@ -108,6 +124,7 @@ func NewEthGasConsumeDecorator(
 	return EthGasConsumeDecorator{
 		bankKeeper,
 		distributionKeeper,
+		feegrantKeeper,
 		evmKeeper,
 		stakingKeeper,
 		maxGasWanted,
@@ -119,6 +136,11 @@ func NewEthGasConsumeDecorator(
 // If the balance is not sufficient, it will be attempted to withdraw enough staking rewards
 // for the payment.
 //
+// If the tx sets a fee granter, the gas is paid by this sponsor instead of the sender. The
+// sponsor must have granted a fee allowance to the sender, or sponsor the called contract within
+// the sponsorship limits.
+// The staking rewards of a sponsor are not withdrawn.
+//
 // Intrinsic gas for a transaction is the amount of gas that the transaction uses before the
 // transaction is executed. The gas is a constant value plus any cost incurred by additional bytes
 // of data supplied with the transaction.
@@ -128,6 +150,7 @@ func NewEthGasConsumeDecorator(
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
 // - user has neither enough balance nor staking rewards to deduct the transaction fees (gas_limit * gas_price)
+// - the fee granter doesn't sponsor the transaction, or the transaction exceeds its sponsorship limits
 // - transaction or block gas meter runs out of gas
 // - sets the gas meter limit
 // - gas limit is greater than the block gas meter limit
@@ -188,13 +211,24 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
 
-		// If the account balance is not sufficient, try to withdraw enough staking rewards
-		err = anteutils.ClaimStakingRewardsIfNecessary(ctx, egcd.bankKeeper, egcd.distributionKeeper, egcd.stakingKeeper, from, fees)
-		if err != nil {
-			return ctx, err
+		payer := from
+		if isSponsored(tx, from) {
+			payer = tx.(sdk.FeeTx).FeeGranter()
+			if err := egcd.useSponsorship(ctx, payer, msgEthTx, txData, fees, evmDenom, baseFee); err != nil {
+				return ctx, err
+			}
 		}
 
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.HexToAddress(msgEthTx.From))
+		// If the account balance is not sufficient, try to withdraw enough staking rewards. The rewards
+		// of a sponsor are never withdrawn, as it didn't sign the transaction.
+		if payer.Equals(from) {
+			err = anteutils.ClaimStakingRewardsIfNecessary(ctx, egcd.bankKeeper, egcd.distributionKeeper, egcd.stakingKeeper, payer, fees)
+			if err != nil {
+				return ctx, err
+			}
+		}
+
+		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(payer))
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
@@ -245,6 +279,59 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(newCtx, tx, simulate)
 }
 
+// useSponsorship checks that the sponsor pays the gas of the tx, either because the tx calls one of
+// its sponsored contracts within the sponsorship limits or through the fee allowance granted to the
+// sender, which is then used. The sponsor is recorded so that the leftover gas is refunded to it,
+// and the account of the sender is created if it doesn't exist yet, for the new users onboarded
+// without balance.
+func (egcd EthGasConsumeDecorator) useSponsorship(
+	ctx sdk.Context,
+	sponsor sdk.AccAddress,
+	msgEthTx *evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+	fees sdk.Coins,
+	evmDenom string,
+	baseFee *big.Int,
+) error {
+	from := msgEthTx.GetFrom()
+	fromAddr := common.BytesToAddress(from)
+
+	to := txData.GetTo()
+	feeAllowance := to == nil || !egcd.evmKeeper.IsSponsoredContract(ctx, sponsor, *to)
+	if feeAllowance {
+		if egcd.feegrantKeeper == nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+		}
+
+		if err := egcd.feegrantKeeper.UseGrantedFees(ctx, sponsor, from, fees, []sdk.Msg{msgEthTx}); err != nil {
+			return errorsmod.Wrapf(err, "%s does not sponsor the gas of %s", sponsor, fromAddr)
+		}
+	} else {
+		// the sponsor pays the effective gas price, made of the base fee and the tip
+		gasPrice := txData.EffectiveGasPrice(baseFee)
+		gasTip := gasPrice
+		if baseFee != nil {
+			gasTip = new(big.Int).Sub(gasPrice, baseFee)
+		}
+
+		if err := egcd.evmKeeper.UseSponsorship(ctx, sponsor, *to, txData.GetGas(), gasPrice, gasTip, fees.AmountOf(evmDenom)); err != nil {
+			return errorsmod.Wrapf(err, "%s does not sponsor the gas of %s", sponsor, fromAddr)
+		}
+	}
+
+	if egcd.evmKeeper.GetAccount(ctx, fromAddr) == nil {
+		if err := egcd.evmKeeper.SetAccount(ctx, fromAddr, *statedb.NewEmptyAccount()); err != nil {
+			return errorsmod.Wrapf(err, "failed to create the account of %s", fromAddr)
+		}
+	}
+
+	egcd.evmKeeper.SetTxSponsorTransient(ctx, fromAddr, txData.GetNonce(), evmtypes.TxSponsor{
+		Address:      sponsor,
+		FeeAllowance: feeAllowance,
+	})
+	return nil
+}
+
 // CanTransferDecorator checks if the sender is allowed to transfer funds according to the EVM block
 // context rules.
 type CanTransferDecorator struct {
@@ -258,12 +345,14 @@ func NewCanTransferDecorator(evmKeeper EVMKeeper) CanTransferDecorator {
 	}
 }
 
-// AnteHandle creates an EVM from the message and calls the BlockContext CanTransfer function to
-// see if the address can execute the transaction.
+// AnteHandle checks the message against the access control params, then creates an EVM from the
+// message and calls the BlockContext CanTransfer function to see if the address can execute the
+// transaction.
 func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	params := ctd.evmKeeper.GetParams(ctx)
 	ethCfg := params.ChainConfig.EthereumConfig(ctd.evmKeeper.ChainID())
 	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
+	accessLists := params.AccessLists()
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -281,6 +370,11 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			)
 		}
 
+		// return error if the sender, deployer or recipient are restricted through governance
+		if err := accessLists.ValidateMessage(coreMsg.From(), coreMsg.To()); err != nil {
+			return ctx, err
+		}
+
 		if evmtypes.IsLondon(ethCfg, ctx.BlockHeight()) {
 			if baseFee == nil {
 				return ctx, errorsmod.Wrap(
@@ -325,13 +419,18 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
-	ak evmtypes.AccountKeeper
+	ak             evmtypes.AccountKeeper
+	allowNonceGaps bool
 }
 
 // NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator.
-func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper) EthIncrementSenderSequenceDecorator {
+// If allowNonceGaps is set, the txs with a future nonce and the replacements of
+// the txs with the same nonce are accepted in CheckTx, the app-side mempool is
+// then responsible for queueing them until they become executable.
+func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper, allowNonceGaps bool) EthIncrementSenderSequenceDecorator {
 	return EthIncrementSenderSequenceDecorator{
-		ak: ak,
+		ak:             ak,
+		allowNonceGaps: allowNonceGaps,
 	}
 }
 
@@ -360,6 +459,18 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx s
 		}
 		nonce := acc.GetSequence()
 
+		// the sequence isn't increased in CheckTx when nonce gaps are allowed, so the
+		// queued txs and the replacements are only verified against the account one.
+		if issd.allowNonceGaps && ctx.IsCheckTx() {
+			if txData.GetNonce() < nonce {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInvalidSequence,
+					"nonce too low; got %d, expected at least %d", txData.GetNonce(), nonce,
+				)
+			}
+			continue
+		}
+
 		// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 		// with same sender, they'll be accepted.
 		if txData.GetNonce() != nonce {
@@ -445,3 +556,10 @@ func (bvd EthBasicValidationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, si
 
 	return next(ctx, tx, simulate)
 }
+
+// isSponsored returns true if the gas of the tx is paid by a fee granter other
+// than the sender.
+func isSponsored(tx sdk.Tx, from sdk.AccAddress) bool {
+	feeTx, ok := tx.(sdk.FeeTx)
+	return ok && feeTx.FeeGranter() != nil && !feeTx.FeeGranter().Equals(from)
+}
@@ -116,7 +116,7 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 
 func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 	suite.SetupTest()
-	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, false)
 
 	addr := testutiltx.GenerateAddress()
 
@@ -178,9 +178,57 @@ func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 	}
 }
 
+func (suite *AnteTestSuite) TestEthNonceVerificationDecoratorNonceGaps() {
+	suite.SetupTest()
+	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, true)
+
+	addr := testutiltx.GenerateAddress()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	newTx := func(nonce uint64) sdk.Tx {
+		tx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			Nonce:    nonce,
+			Amount:   big.NewInt(10),
+			GasLimit: 1000,
+			GasPrice: big.NewInt(1),
+		})
+		tx.From = addr.Hex()
+		return tx
+	}
+
+	testCases := []struct {
+		name    string
+		tx      sdk.Tx
+		checkTx bool
+		expPass bool
+	}{
+		{"nonce too low", newTx(0), true, false},
+		{"account nonce", newTx(1), true, true},
+		{"future nonce", newTx(3), true, true},
+		{"future nonce in deliver tx", newTx(3), false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(tc.checkTx), tc.tx, false, testutil.NextFn)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+			// the sequence is never increased in check tx
+			suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(suite.ctx, addr.Bytes()).GetSequence())
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	chainID := suite.app.EvmKeeper.ChainID()
-	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, config.DefaultMaxTxGasWanted)
+	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.FeeGrantKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, config.DefaultMaxTxGasWanted)
 
 	addr := testutiltx.GenerateAddress()
 
@@ -588,7 +636,7 @@ func (suite *AnteTestSuite) TestCanTransferDecorator() {
 }
 
 func (suite *AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
-	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, false)
 	addr, privKey := testutiltx.NewAddrKey()
 
 	ethTxContractParamsNonce0 := &evmtypes.EvmTxArgs{
@@ -3,6 +3,7 @@ package evm
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
@@ -26,12 +27,16 @@ type EVMKeeper interface { //nolint: revive
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
+	IsSponsoredContract(ctx sdk.Context, sponsor sdk.AccAddress, contract common.Address) bool
+	UseSponsorship(ctx sdk.Context, sponsor sdk.AccAddress, contract common.Address, gasLimit uint64, gasPrice, gasTip *big.Int, fee sdkmath.Int) error
+	SetTxSponsorTransient(ctx sdk.Context, from common.Address, nonce uint64, sponsor evmtypes.TxSponsor)
 }
 
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
+	BurnBaseFee(ctx sdk.Context, baseFeePaid, feesLeft sdk.Coins) (sdk.Coins, error)
 }
 
 // DynamicFeeEVMKeeper is a subset of EVMKeeper interface that supports dynamic fee checker
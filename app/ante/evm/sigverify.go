@@ -1,12 +1,14 @@
 package evm
 
 import (
+	"bytes"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -73,3 +75,96 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 
 	return next(ctx, tx, simulate)
 }
+
+// EthSponsorSigVerificationDecorator verifies the signature of the fee granter of an eth tx.
+// The fee granter is set on the AuthInfo of the wrapping Cosmos tx, which isn't covered by the
+// signature of the eth tx, so the sponsor must sign the wrapping tx to consent to pay the gas.
+type EthSponsorSigVerificationDecorator struct {
+	ak              evmtypes.AccountKeeper
+	signModeHandler authsigning.SignModeHandler
+}
+
+// NewEthSponsorSigVerificationDecorator creates a new EthSponsorSigVerificationDecorator
+func NewEthSponsorSigVerificationDecorator(ak evmtypes.AccountKeeper, signModeHandler authsigning.SignModeHandler) EthSponsorSigVerificationDecorator {
+	return EthSponsorSigVerificationDecorator{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+	}
+}
+
+// AnteHandle verifies the signature of the fee granter over the wrapping Cosmos tx and increments its sequence.
+// Txs without a fee granter must not carry any signature, this is enforced by EthValidateBasicDecorator.
+func (essvd EthSponsorSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	granter := feeTx.FeeGranter()
+	if granter.Empty() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid transaction type %T, expected authsigning.SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(sigs) != 1 {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "eth tx with a fee granter must be signed by the fee granter, got %d signatures", len(sigs))
+	}
+	sig := sigs[0]
+
+	acc := essvd.ak.GetAccount(ctx, granter)
+	if acc == nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "fee granter %s does not exist", granter)
+	}
+
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		if sig.PubKey == nil {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "missing public key of fee granter %s", granter)
+		}
+		if !bytes.Equal(sig.PubKey.Address(), granter) {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "public key does not match fee granter %s", granter)
+		}
+		pubKey = sig.PubKey
+		if err := acc.SetPubKey(pubKey); err != nil {
+			return ctx, errorsmod.Wrap(errortypes.ErrInvalidPubKey, err.Error())
+		}
+	} else if sig.PubKey != nil && !pubKey.Equals(sig.PubKey) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "public key does not match fee granter %s", granter)
+	}
+
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrWrongSequence,
+			"account sequence mismatch of fee granter %s, expected %d, got %d", granter, acc.GetSequence(), sig.Sequence,
+		)
+	}
+
+	if !simulate {
+		signerData := authsigning.SignerData{
+			Address:       granter.String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        pubKey,
+		}
+		if err := authsigning.VerifySignature(pubKey, signerData, sig.Data, essvd.signModeHandler, tx); err != nil {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "signature verification failed for fee granter %s: %s", granter, err.Error())
+		}
+	}
+
+	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+		return ctx, err
+	}
+	essvd.ak.SetAccount(ctx, acc)
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,238 @@
+package evm_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/testutil"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestAnteHandlerSponsoredTx() {
+	var (
+		sponsor     sdk.AccAddress
+		grantee     sdk.AccAddress
+		to          common.Address
+		sponsorSigs bool
+	)
+	gasPrice := big.NewInt(150)
+	fee := sdkmath.NewIntFromBigInt(gasPrice).MulRaw(int64(TestGasLimit))
+	limits := evmtypes.NewSponsorshipLimits(TestGasLimit, sdkmath.NewInt(150), sdkmath.NewInt(50), fee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		amount   *big.Int
+		checkTx  bool
+		expPass  bool
+	}{
+		{
+			"fail - no fee allowance granted to the sender",
+			func() {},
+			big.NewInt(0),
+			false,
+			false,
+		},
+		{
+			"fail - fee granter did not sign the tx",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, sponsor, grantee, &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+				sponsorSigs = false
+			},
+			big.NewInt(0),
+			false,
+			false,
+		},
+		{
+			"fail - fee allowance lower than the fee",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, sponsor, grantee, &feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, fee.SubRaw(1))),
+				})
+				suite.Require().NoError(err)
+			},
+			big.NewInt(0),
+			false,
+			false,
+		},
+		{
+			"fail - sender cannot pay the value transferred",
+			func() {
+				suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{to}, limits)
+			},
+			big.NewInt(10),
+			true,
+			false,
+		},
+		{
+			"fail - gas limit above the sponsorship limit",
+			func() {
+				l := limits
+				l.MaxGasPerTx = TestGasLimit - 1
+				suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{to}, l)
+			},
+			big.NewInt(0),
+			false,
+			false,
+		},
+		{
+			"fail - gas price above the sponsorship limit",
+			func() {
+				l := limits
+				l.MaxFeePerGas = sdkmath.NewInt(149)
+				l.MaxPriorityFeePerGas = sdkmath.NewInt(49)
+				suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{to}, l)
+			},
+			big.NewInt(0),
+			false,
+			false,
+		},
+		{
+			"fail - priority fee above the sponsorship limit",
+			func() {
+				l := limits
+				l.MaxPriorityFeePerGas = sdkmath.NewInt(49)
+				suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{to}, l)
+			},
+			big.NewInt(0),
+			false,
+			false,
+		},
+		{
+			"fail - fee above the sponsorship spend limit",
+			func() {
+				l := limits
+				l.SpendLimit = fee.SubRaw(1)
+				suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{to}, l)
+			},
+			big.NewInt(0),
+			false,
+			false,
+		},
+		{
+			"pass - fee allowance granted to the sender",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, sponsor, grantee, &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			big.NewInt(0),
+			false,
+			true,
+		},
+		{
+			"pass - fee allowance granted to the sender, CheckTx",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, sponsor, grantee, &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			big.NewInt(0),
+			true,
+			true,
+		},
+		{
+			"pass - contract sponsored",
+			func() {
+				suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{to}, limits)
+			},
+			big.NewInt(0),
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = false
+			suite.SetupTest() // reset
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+
+			// the sender is a new account without balance
+			from, privKey := utiltx.NewAddrKey()
+			grantee = sdk.AccAddress(from.Bytes())
+			to = utiltx.GenerateAddress()
+
+			sponsorAddr, sponsorPrivKey := utiltx.NewAddrKey()
+			sponsor = sdk.AccAddress(sponsorAddr.Bytes())
+			sponsorSigs = true
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, fee.MulRaw(10))))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msg := suite.BuildTestEthTx(from, to, tc.amount, nil, gasPrice, nil, nil, nil)
+			txBuilder := suite.CreateTestTxBuilder(msg, privKey, 1, false)
+			txBuilder.SetFeeGranter(sponsor)
+			if sponsorSigs {
+				suite.signAsFeeGranter(txBuilder, sponsorPrivKey)
+			}
+
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx)
+			sponsorBalance := suite.app.BankKeeper.GetBalance(ctx, sponsor, evmtypes.DefaultEVMDenom)
+
+			_, err = suite.anteHandler(ctx, txBuilder.GetTx(), false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the sponsor paid the gas and is recorded for the refund
+			sponsorBalanceAfter := suite.app.BankKeeper.GetBalance(ctx, sponsor, evmtypes.DefaultEVMDenom)
+			suite.Require().Equal(sponsorBalance.Amount.Sub(fee).String(), sponsorBalanceAfter.Amount.String())
+			suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(ctx, from).Int64())
+			recorded, found := suite.app.EvmKeeper.GetTxSponsorTransient(ctx, from, 0)
+			suite.Require().True(found)
+			suite.Require().Equal(sponsor, recorded.Address)
+
+			// the signature of the sponsor can't be replayed
+			suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(ctx, sponsor).GetSequence())
+
+			// the fee is taken from the spend limit of a sponsored contract
+			if !recorded.FeeAllowance {
+				remaining, found := suite.app.EvmKeeper.GetSponsorshipLimits(ctx, sponsor)
+				suite.Require().True(found)
+				suite.Require().True(remaining.SpendLimit.IsZero())
+			}
+		})
+	}
+}
+
+// signAsFeeGranter signs the wrapping Cosmos tx with the private key of the fee granter.
+func (suite *AnteTestSuite) signAsFeeGranter(txBuilder client.TxBuilder, priv cryptotypes.PrivKey) {
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.AccAddress(priv.PubKey().Address()))
+	suite.Require().NotNil(acc)
+	signMode := suite.clientCtx.TxConfig.SignModeHandler().DefaultMode()
+
+	// First round: set the signer info with an empty signature
+	err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: priv.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode: signMode,
+		},
+		Sequence: acc.GetSequence(),
+	})
+	suite.Require().NoError(err)
+
+	// Second round: sign the tx
+	signerData := authsigning.SignerData{
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}
+	sig, err := tx.SignWithPrivKey(signMode, signerData, txBuilder, priv, suite.clientCtx.TxConfig, acc.GetSequence())
+	suite.Require().NoError(err)
+
+	err = txBuilder.SetSignatures(sig)
+	suite.Require().NoError(err)
+}
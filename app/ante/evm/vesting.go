@@ -41,7 +41,7 @@ func NewEthVestingTransactionDecorator(ak evmtypes.AccountKeeper, bk evmtypes.Ba
 //
 // This AnteHandler decorator will fail if:
 //   - the message is not a MsgEthereumTx
-//   - sender account cannot be found
+//   - sender account cannot be found, unless the gas is paid by a sponsor
 //   - tx values are in excess of any account's spendable balances
 func (vtd EthVestingTransactionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// Track the total value to be spent by each address across all messages and ensure
@@ -58,6 +58,10 @@ func (vtd EthVestingTransactionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 		}
 
 		acc := vtd.ak.GetAccount(ctx, msgEthTx.GetFrom())
+		if acc == nil && isSponsored(tx, msgEthTx.GetFrom()) {
+			// the account of a sponsored sender is created when its gas is paid
+			continue
+		}
 		if acc == nil {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownAddress,
 				"account %s does not exist", acc)
@@ -20,6 +20,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -32,10 +33,12 @@ import (
 
 	"cosmossdk.io/simapp"
 	simappparams "cosmossdk.io/simapp/params"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -128,11 +131,16 @@ import (
 	_ "github.com/HarryBin2002/kairoschain/v12/client/docs/statik"
 
 	"github.com/HarryBin2002/kairoschain/v12/app/ante"
+	evmmempool "github.com/HarryBin2002/kairoschain/v12/app/mempool"
+	"github.com/HarryBin2002/kairoschain/v12/app/upgrades/v13"
 	"github.com/HarryBin2002/kairoschain/v12/app/upgrades/v3_sample"
 	"github.com/HarryBin2002/kairoschain/v12/x/erc20"
 	erc20client "github.com/HarryBin2002/kairoschain/v12/x/erc20/client"
 	erc20keeper "github.com/HarryBin2002/kairoschain/v12/x/erc20/keeper"
 	erc20types "github.com/HarryBin2002/kairoschain/v12/x/erc20/types"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue"
+	revenuekeeper "github.com/HarryBin2002/kairoschain/v12/x/revenue/keeper"
+	revenuetypes "github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
 	"github.com/HarryBin2002/kairoschain/v12/x/vesting"
 	vestingkeeper "github.com/HarryBin2002/kairoschain/v12/x/vesting/keeper"
 	vestingtypes "github.com/HarryBin2002/kairoschain/v12/x/vesting/types"
@@ -202,6 +210,7 @@ var (
 		evm.AppModuleBasic{},
 		feemarket.AppModuleBasic{},
 		erc20.AppModuleBasic{},
+		revenue.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 	)
 
@@ -216,6 +225,7 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:            nil,
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		feemarkettypes.ModuleName:      {authtypes.Burner},                   // used to burn the base fee
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 	}
 
@@ -277,6 +287,7 @@ type Kairoschain struct {
 	// Kairoschain keepers
 	Erc20Keeper   erc20keeper.Keeper
 	VestingKeeper vestingkeeper.Keeper
+	RevenueKeeper revenuekeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -285,6 +296,10 @@ type Kairoschain struct {
 	configurator module.Configurator
 
 	tpsCounter *tpsCounter
+
+	// the app-side mempool, nil when it's disabled
+	evmMempool *evmmempool.EVMMempool
+	txDecoder  sdk.TxDecoder
 }
 
 // NewKairoschain returns a reference to a new initialized Ethermint application.
@@ -334,6 +349,7 @@ func NewKairoschain(
 		// kairoschain module keys
 		erc20types.StoreKey,
 		vestingtypes.StoreKey,
+		revenuetypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -414,12 +430,13 @@ func NewKairoschain(
 		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		keys[feemarkettypes.StoreKey],
 		tkeys[feemarkettypes.TransientKey],
+		chainApp.BankKeeper, authtypes.FeeCollectorName,
 		chainApp.GetSubspace(feemarkettypes.ModuleName),
 	)
 
 	chainApp.EvmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		chainApp.AccountKeeper, chainApp.BankKeeper, stakingKeeper, chainApp.FeeMarketKeeper,
+		chainApp.AccountKeeper, chainApp.BankKeeper, stakingKeeper, chainApp.FeeMarketKeeper, chainApp.FeeGrantKeeper,
 		tracer, chainApp.GetSubspace(evmtypes.ModuleName),
 	)
 
@@ -470,6 +487,11 @@ func NewKairoschain(
 		chainApp.AccountKeeper, chainApp.BankKeeper, chainApp.EvmKeeper, chainApp.StakingKeeper,
 	)
 
+	chainApp.RevenueKeeper = revenuekeeper.NewKeeper(
+		keys[revenuetypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		chainApp.BankKeeper, chainApp.EvmKeeper, authtypes.FeeCollectorName,
+	)
+
 	chainApp.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
 		//
@@ -479,9 +501,13 @@ func NewKairoschain(
 	chainApp.EvmKeeper = chainApp.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
 			chainApp.Erc20Keeper.Hooks(),
+			chainApp.RevenueKeeper.Hooks(),
 		),
 	)
 
+	// expose every bank denomination as an ERC20 precompile
+	chainApp.EvmKeeper.WithDynamicPrecompiles(chainApp.Erc20Keeper)
+
 	chainApp.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], chainApp.GetSubspace(ibctransfertypes.ModuleName),
 		chainApp.IBCKeeper.ChannelKeeper, // No ICS4 wrapper
@@ -492,6 +518,18 @@ func NewKairoschain(
 
 	// NOTE: app.Erc20Keeper is already initialized elsewhere
 
+	// register the precompiles of the Cosmos modules, enabled through the
+	// active precompiles parameter of the EVM module
+	chainApp.EvmKeeper.WithPrecompiles(
+		NewAvailablePrecompiles(
+			chainApp.AccountKeeper,
+			chainApp.StakingKeeper,
+			chainApp.DistrKeeper,
+			chainApp.TransferKeeper,
+			chainApp.AuthzKeeper,
+		)...,
+	)
+
 	// Override the ICS20 app module
 	transferModule := transfer.NewAppModule(chainApp.TransferKeeper)
 
@@ -586,6 +624,7 @@ func NewKairoschain(
 		erc20.NewAppModule(chainApp.Erc20Keeper, chainApp.AccountKeeper,
 			chainApp.GetSubspace(erc20types.ModuleName)),
 		vesting.NewAppModule(chainApp.VestingKeeper, chainApp.AccountKeeper, chainApp.BankKeeper, *chainApp.StakingKeeper),
+		revenue.NewAppModule(chainApp.RevenueKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -618,6 +657,7 @@ func NewKairoschain(
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		erc20types.ModuleName,
+		revenuetypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -647,6 +687,7 @@ func NewKairoschain(
 		// Kairoschain modules
 		vestingtypes.ModuleName,
 		erc20types.ModuleName,
+		revenuetypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -682,6 +723,7 @@ func NewKairoschain(
 		// Kairoschain modules
 		vestingtypes.ModuleName,
 		erc20types.ModuleName,
+		revenuetypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
@@ -705,7 +747,23 @@ func NewKairoschain(
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 
-	chainApp.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
+	// the app-side mempool queues the eth txs by nonce, it's always bounded when enabled
+	mempoolEnabled := cast.ToBool(appOpts.Get(srvflags.EVMMempoolEnable))
+	if mempoolEnabled {
+		maxTxs := cast.ToInt(appOpts.Get(sdkserver.FlagMempoolMaxTxs))
+		if maxTxs <= 0 {
+			maxTxs = evmmempool.DefaultMaxTx
+		}
+		chainApp.setMempool(
+			encodingConfig.TxConfig.TxDecoder(),
+			maxTxs,
+			cast.ToInt(appOpts.Get(srvflags.EVMMempoolMaxTxsPerSender)),
+			cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+			cast.ToInt64(appOpts.Get(srvflags.EVMMempoolQueueLifetime)),
+		)
+	}
+
+	chainApp.setAnteHandler(encodingConfig.TxConfig, maxGasWanted, mempoolEnabled)
 	chainApp.setPostHandler()
 	chainApp.SetEndBlocker(chainApp.EndBlocker)
 	chainApp.setupUpgradeHandlers()
@@ -733,7 +791,7 @@ func NewKairoschain(
 // Name returns the name of the App
 func (app *Kairoschain) Name() string { return app.BaseApp.Name() }
 
-func (app *Kairoschain) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Kairoschain) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, allowNonceGaps bool) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -749,6 +807,7 @@ func (app *Kairoschain) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
+		AllowNonceGaps:         allowNonceGaps,
 	}.WithDefaultDisabledAuthzMsgs()
 
 	if err := options.Validate(); err != nil {
@@ -758,6 +817,46 @@ func (app *Kairoschain) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 }
 
+// setMempool sets the EVM aware app-side mempool. The proposal handlers are
+// set again since the default ones are bound to the mempool of the BaseApp
+// when it's created.
+func (app *Kairoschain) setMempool(txDecoder sdk.TxDecoder, maxTxs, maxTxsPerSender int, priceBump uint64, queueLifetime int64) {
+	evmMempool := evmmempool.NewEVMMempool(app.AccountKeeper, app.FeeMarketKeeper, maxTxs, maxTxsPerSender, priceBump, queueLifetime)
+	app.SetMempool(evmMempool)
+	app.evmMempool = evmMempool
+	app.txDecoder = txDecoder
+
+	proposalHandler := baseapp.NewDefaultProposalHandler(evmMempool, app)
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+}
+
+// CheckTx implements the ABCI interface. The txs failing the recheck are
+// dropped from the CometBFT mempool, so they're evicted from the app-side
+// mempool too. Conversely, the txs evicted from the app-side mempool fail the
+// recheck, so they're dropped from the CometBFT mempool.
+func (app *Kairoschain) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.BaseApp.CheckTx(req)
+	if app.evmMempool == nil || req.Type != abci.CheckTxType_Recheck {
+		return res
+	}
+
+	tx, err := app.txDecoder(req.Tx)
+	if err != nil {
+		return res
+	}
+	if !res.IsOK() {
+		_ = app.evmMempool.Evict(tx)
+		return res
+	}
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	if err := app.evmMempool.RecheckTx(ctx, tx); err != nil {
+		return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, nil, app.Trace())
+	}
+	return res
+}
+
 func (app *Kairoschain) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
 		posthandler.HandlerOptions{},
@@ -1029,6 +1128,14 @@ func (app *Kairoschain) setupUpgradeHandlers() {
 		),
 	)
 
+	// v13.0.0 upgrade handler adding the revenue module
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v13.UpgradeName,
+		v13.CreateUpgradeHandler(
+			app.mm, app.configurator,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1048,6 +1155,10 @@ func (app *Kairoschain) setupUpgradeHandlers() {
 		storeUpgrades = &storetypes.StoreUpgrades{
 			//Deleted: []string{"feesplit"},
 		}
+	case v13.UpgradeName:
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{revenuetypes.StoreKey},
+		}
 	}
 
 	if storeUpgrades != nil {
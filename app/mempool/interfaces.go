@@ -0,0 +1,19 @@
+package mempool
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper used to get the committed
+// nonce of the senders.
+type AccountKeeper interface {
+	GetSequence(ctx sdk.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+// FeeMarketKeeper defines the expected fee market keeper used to compute the
+// effective tip of the eth txs.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
@@ -0,0 +1,634 @@
+package mempool
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+const (
+	// DefaultPriceBump is the default minimum fee bump percentage required to
+	// replace a transaction with the same nonce.
+	DefaultPriceBump uint64 = 10
+	// DefaultMaxTx is the default maximum number of transactions of the mempool
+	// of a node, used when it's not bounded by the configuration.
+	DefaultMaxTx = 5000
+)
+
+var (
+	// ErrReplaceUnderpriced is returned when a transaction replacing another one
+	// with the same nonce doesn't bump the fees enough.
+	ErrReplaceUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrSenderMaxCapacity is returned when the sender of a transaction already
+	// has the maximum number of transactions in the mempool.
+	ErrSenderMaxCapacity = errors.New("sender has too many transactions in the mempool")
+	// ErrQueueLifetimeExceeded is returned when a queued eth tx is evicted
+	// after waiting for the missing nonces longer than the queue lifetime.
+	ErrQueueLifetimeExceeded = errors.New("queued transaction exceeded the queue lifetime")
+)
+
+var _ sdkmempool.Mempool = (*EVMMempool)(nil)
+
+// evmTx is a MsgEthereumTx transaction of the mempool, a tx with multiple
+// messages is identified by the first one.
+type evmTx struct {
+	tx        sdk.Tx
+	hash      common.Hash
+	sender    common.Address
+	nonce     uint64
+	nextNonce uint64 // nonce following the messages of the sender
+	txData    evmtypes.TxData
+	height    int64 // height of the block when the tx was inserted
+}
+
+// cosmosTx is a Cosmos transaction of the mempool.
+type cosmosTx struct {
+	tx       sdk.Tx
+	signer   string
+	sequence uint64
+	priority int64
+}
+
+// EVMMempool is an app-side mempool aware of the Ethereum transactions
+// semantics:
+//
+//   - The eth txs are kept in per-sender queues indexed by nonce, the txs with a
+//     future nonce wait in the queue until the missing nonces are received.
+//     The queued txs are evicted once they have waited for queueLifetime blocks.
+//   - A sender can't have more than maxTxPerSender eth txs in the mempool.
+//   - When the mempool is full, a queued eth tx is evicted to make room for an
+//     executable tx, the queued txs are rejected.
+//   - A tx with the nonce of a queued one replaces it only if both its fee cap
+//     and its tip cap are bumped by at least the price bump percentage.
+//   - The block proposals select the executable eth txs by effective tip,
+//     respecting the nonce order of each sender.
+//
+// The Cosmos txs are kept in insertion order and merged with the eth txs by
+// priority, both priorities are the effective tip reduced by
+// DefaultPriorityReduction.
+type EVMMempool struct {
+	mtx             sync.Mutex
+	accountKeeper   AccountKeeper
+	feeMarketKeeper FeeMarketKeeper
+	maxTx           int
+	maxTxPerSender  int
+	priceBump       uint64
+	queueLifetime   int64
+	senders         map[common.Address]map[uint64]*evmTx
+	cosmosTxs       []*cosmosTx
+	evmCount        int
+}
+
+// NewEVMMempool creates a new EVMMempool. The mempool is unbounded if maxTx
+// is 0 and no tx is inserted if it's negative, as the SDK mempools. The eth
+// txs of a sender are unbounded if maxTxPerSender is 0, and the queued eth txs
+// are never evicted if queueLifetime is 0.
+func NewEVMMempool(ak AccountKeeper, fmk FeeMarketKeeper, maxTx, maxTxPerSender int, priceBump uint64, queueLifetime int64) *EVMMempool {
+	return &EVMMempool{
+		accountKeeper:   ak,
+		feeMarketKeeper: fmk,
+		maxTx:           maxTx,
+		maxTxPerSender:  maxTxPerSender,
+		priceBump:       priceBump,
+		queueLifetime:   queueLifetime,
+		senders:         make(map[common.Address]map[uint64]*evmTx),
+	}
+}
+
+// Insert adds the tx to the mempool, replacing the eth tx of the sender with
+// the same nonce if the fees are bumped enough.
+func (mp *EVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	if mp.maxTx < 0 {
+		return nil
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	if etx == nil {
+		return mp.insertCosmosTx(ctx, tx)
+	}
+
+	etx.height = ctx.BlockHeight()
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txs := mp.senders[etx.sender]
+	if old, ok := txs[etx.nonce]; ok {
+		if old.hash == etx.hash {
+			return nil
+		}
+		if !isPriceBumped(old.txData, etx.txData, mp.priceBump) {
+			return errorsmod.Wrapf(
+				ErrReplaceUnderpriced,
+				"nonce %d requires a fee bump of %d%% (fee cap %s, tip cap %s)",
+				etx.nonce, mp.priceBump, old.txData.GetGasFeeCap(), old.txData.GetGasTipCap(),
+			)
+		}
+		txs[etx.nonce] = etx
+		return nil
+	}
+
+	if mp.maxTxPerSender > 0 && len(txs) >= mp.maxTxPerSender {
+		return errorsmod.Wrapf(ErrSenderMaxCapacity, "sender %s has %d txs", etx.sender, len(txs))
+	}
+	if mp.maxTx > 0 && mp.countTx() >= mp.maxTx {
+		executable := isExecutable(txs, mp.accountNonce(ctx, etx.sender), etx.nonce)
+		if !executable || !mp.evictQueued(ctx) {
+			return sdkmempool.ErrMempoolTxMaxCapacity
+		}
+	}
+
+	// the map of the sender might have been deleted by the eviction
+	txs, ok := mp.senders[etx.sender]
+	if !ok {
+		txs = make(map[uint64]*evmTx)
+		mp.senders[etx.sender] = txs
+	}
+	txs[etx.nonce] = etx
+	mp.evmCount++
+	return nil
+}
+
+// Select returns an iterator over the executable txs of the mempool. The eth
+// txs of each sender are returned in nonce order starting from the committed
+// account nonce, the senders are interleaved by the effective tip of their
+// next tx. The eth txs that became stale are pruned.
+func (mp *EVMMempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.evictExpired(ctx)
+
+	baseFee := mp.feeMarketKeeper.GetBaseFee(ctx)
+	senders := &senderHeap{}
+	for sender, txs := range mp.senders {
+		nonce := mp.accountNonce(ctx, sender)
+
+		// the txs with a lower nonce are either included or replaced by other nodes
+		for n := range txs {
+			if n < nonce {
+				delete(txs, n)
+				mp.evmCount--
+			}
+		}
+		if len(txs) == 0 {
+			delete(mp.senders, sender)
+			continue
+		}
+
+		var queue []*evmTx
+		for tx, ok := txs[nonce]; ok; tx, ok = txs[nonce] {
+			// the tx can't be included until the base fee decreases
+			if baseFee != nil && tx.txData.GetGasFeeCap().Cmp(baseFee) < 0 {
+				break
+			}
+			queue = append(queue, tx)
+			nonce = tx.nextNonce
+		}
+		if len(queue) > 0 {
+			senders.txs = append(senders.txs, queue)
+		}
+	}
+	senders.baseFee = baseFee
+	heap.Init(senders)
+
+	selected := make([]sdk.Tx, 0, mp.countTx())
+	cosmosIdx := 0
+	for senders.Len() > 0 || cosmosIdx < len(mp.cosmosTxs) {
+		if senders.Len() == 0 ||
+			(cosmosIdx < len(mp.cosmosTxs) && mp.cosmosTxs[cosmosIdx].priority >= senders.headPriority(0)) {
+			selected = append(selected, mp.cosmosTxs[cosmosIdx].tx)
+			cosmosIdx++
+			continue
+		}
+
+		queue := senders.txs[0]
+		selected = append(selected, queue[0].tx)
+		if len(queue) == 1 {
+			heap.Pop(senders)
+			continue
+		}
+		senders.txs[0] = queue[1:]
+		heap.Fix(senders, 0)
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+	return &iterator{txs: selected}
+}
+
+// CountTx returns the number of txs of the mempool.
+func (mp *EVMMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.countTx()
+}
+
+// Remove removes the tx from the mempool. The eth tx of the sender with the
+// same nonce is removed even if it's a different one, since its nonce has
+// been used by the removed tx.
+func (mp *EVMMempool) Remove(tx sdk.Tx) error {
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	if etx == nil {
+		return mp.removeCosmosTx(tx)
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	old, ok := mp.senders[etx.sender][etx.nonce]
+	if !ok {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	mp.removeEVMTx(old)
+	if old.hash != etx.hash {
+		return sdkmempool.ErrTxNotFound
+	}
+	return nil
+}
+
+// Evict removes a tx dropped from the CometBFT mempool. Unlike Remove, the
+// eth tx of the sender with the same nonce is kept if it's a different one,
+// since it replaced the evicted tx.
+func (mp *EVMMempool) Evict(tx sdk.Tx) error {
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	if etx == nil {
+		return mp.removeCosmosTx(tx)
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	old, ok := mp.senders[etx.sender][etx.nonce]
+	if !ok || old.hash != etx.hash {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	mp.removeEVMTx(old)
+	return nil
+}
+
+// RecheckTx returns an error if the tx has been dropped from the mempool, so
+// that it's dropped from the CometBFT mempool too: it's been evicted to make
+// room for an executable tx or replaced. A queued eth tx that has waited for
+// more than the queue lifetime is evicted.
+func (mp *EVMMempool) RecheckTx(ctx sdk.Context, tx sdk.Tx) error {
+	if mp.maxTx < 0 {
+		return nil
+	}
+
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	// the Cosmos txs are never evicted
+	if etx == nil {
+		return nil
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txs := mp.senders[etx.sender]
+	cur, ok := txs[etx.nonce]
+	if !ok || cur.hash != etx.hash {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	if mp.isExpired(ctx, cur) && !isExecutable(txs, mp.accountNonce(ctx, cur.sender), cur.nonce) {
+		mp.removeEVMTx(cur)
+		return errorsmod.Wrapf(ErrQueueLifetimeExceeded, "nonce %d queued since block %d", cur.nonce, cur.height)
+	}
+	return nil
+}
+
+func (mp *EVMMempool) countTx() int {
+	return mp.evmCount + len(mp.cosmosTxs)
+}
+
+// accountNonce returns the committed nonce of the sender. The account might
+// not exist yet when it has been funded in the same block.
+func (mp *EVMMempool) accountNonce(ctx sdk.Context, sender common.Address) uint64 {
+	nonce, err := mp.accountKeeper.GetSequence(ctx, sender.Bytes())
+	if err != nil {
+		return 0
+	}
+	return nonce
+}
+
+// removeEVMTx removes the eth tx from the queue of its sender.
+func (mp *EVMMempool) removeEVMTx(etx *evmTx) {
+	txs := mp.senders[etx.sender]
+	delete(txs, etx.nonce)
+	mp.evmCount--
+	if len(txs) == 0 {
+		delete(mp.senders, etx.sender)
+	}
+}
+
+// queuedTxs returns the eth txs of the sender which can't be executed in nonce
+// order from its committed nonce.
+func (mp *EVMMempool) queuedTxs(ctx sdk.Context, sender common.Address, txs map[uint64]*evmTx) []*evmTx {
+	executable := make(map[uint64]bool)
+	nonce := mp.accountNonce(ctx, sender)
+	for tx, ok := txs[nonce]; ok; tx, ok = txs[nonce] {
+		executable[tx.nonce] = true
+		nonce = tx.nextNonce
+	}
+
+	var queued []*evmTx
+	for n, tx := range txs {
+		if !executable[n] {
+			queued = append(queued, tx)
+		}
+	}
+	return queued
+}
+
+// isExpired returns true if the eth tx was inserted more than queueLifetime
+// blocks ago.
+func (mp *EVMMempool) isExpired(ctx sdk.Context, etx *evmTx) bool {
+	return mp.queueLifetime > 0 && ctx.BlockHeight()-etx.height > mp.queueLifetime
+}
+
+// evictExpired evicts the queued eth txs inserted more than queueLifetime
+// blocks ago.
+func (mp *EVMMempool) evictExpired(ctx sdk.Context) {
+	if mp.queueLifetime == 0 {
+		return
+	}
+	for sender, txs := range mp.senders {
+		for _, tx := range mp.queuedTxs(ctx, sender, txs) {
+			if mp.isExpired(ctx, tx) {
+				mp.removeEVMTx(tx)
+			}
+		}
+	}
+}
+
+// evictQueued evicts a queued eth tx to make room for an executable tx: the
+// one with the highest nonce of the sender with the most queued txs. It
+// returns false if no eth tx is queued.
+func (mp *EVMMempool) evictQueued(ctx sdk.Context) bool {
+	var (
+		victim      *evmTx
+		victimCount int
+	)
+	for sender, txs := range mp.senders {
+		queued := mp.queuedTxs(ctx, sender, txs)
+		if len(queued) == 0 || len(queued) < victimCount {
+			continue
+		}
+		// the senders with the same number of queued txs are ordered by address,
+		// since the iteration order of the map is random
+		if len(queued) == victimCount && bytes.Compare(sender.Bytes(), victim.sender.Bytes()) > 0 {
+			continue
+		}
+
+		last := queued[0]
+		for _, tx := range queued[1:] {
+			if tx.nonce > last.nonce {
+				last = tx
+			}
+		}
+		victim, victimCount = last, len(queued)
+	}
+
+	if victim == nil {
+		return false
+	}
+	mp.removeEVMTx(victim)
+	return true
+}
+
+// isExecutable returns true if the eth txs of the sender cover all the nonces
+// from the committed nonce up to the given one, so a tx with this nonce can
+// be executed.
+func isExecutable(txs map[uint64]*evmTx, nonce, target uint64) bool {
+	for nonce < target {
+		tx, ok := txs[nonce]
+		if !ok {
+			return false
+		}
+		nonce = tx.nextNonce
+	}
+	return nonce == target
+}
+
+// insertCosmosTx appends the Cosmos tx to the mempool, replacing the tx with
+// the same signer and sequence.
+func (mp *EVMMempool) insertCosmosTx(ctx sdk.Context, tx sdk.Tx) error {
+	signer, sequence, err := cosmosTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	cTx := &cosmosTx{tx: tx, signer: signer, sequence: sequence, priority: ctx.Priority()}
+	for i, old := range mp.cosmosTxs {
+		if old.signer == signer && old.sequence == sequence {
+			mp.cosmosTxs[i] = cTx
+			return nil
+		}
+	}
+
+	// the Cosmos txs are verified against the account sequence, a queued eth tx
+	// makes room for them
+	if mp.maxTx > 0 && mp.countTx() >= mp.maxTx && !mp.evictQueued(ctx) {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	mp.cosmosTxs = append(mp.cosmosTxs, cTx)
+	return nil
+}
+
+// removeCosmosTx removes the Cosmos tx with the same signer and sequence.
+func (mp *EVMMempool) removeCosmosTx(tx sdk.Tx) error {
+	signer, sequence, err := cosmosTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for i, old := range mp.cosmosTxs {
+		if old.signer == signer && old.sequence == sequence {
+			mp.cosmosTxs = append(mp.cosmosTxs[:i], mp.cosmosTxs[i+1:]...)
+			return nil
+		}
+	}
+	return sdkmempool.ErrTxNotFound
+}
+
+// newEVMTx returns the evmTx of a transaction containing MsgEthereumTx
+// messages or nil if it's a Cosmos transaction.
+func newEVMTx(tx sdk.Tx) (*evmTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); !ok {
+		return nil, nil
+	}
+
+	var etx *evmTx
+	for _, msg := range msgs {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		ethTx := msgEthTx.AsTransaction()
+		// the sender is set by the ante handler, recover it otherwise
+		sender := common.BytesToAddress(msgEthTx.GetFrom())
+		if len(msgEthTx.GetFrom()) == 0 {
+			if sender, err = msgEthTx.GetSender(ethTx.ChainId()); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to recover the sender")
+			}
+		}
+
+		if etx == nil {
+			etx = &evmTx{
+				tx:        tx,
+				hash:      ethTx.Hash(),
+				sender:    sender,
+				nonce:     txData.GetNonce(),
+				nextNonce: txData.GetNonce() + 1,
+				txData:    txData,
+			}
+		} else if sender == etx.sender {
+			etx.nextNonce = txData.GetNonce() + 1
+		}
+	}
+
+	return etx, nil
+}
+
+// cosmosTxKey returns the first signer and its sequence, which identify a
+// Cosmos transaction in the mempool.
+func cosmosTxKey(tx sdk.Tx) (string, uint64, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return "", 0, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return "", 0, errors.New("tx must have at least one signer")
+	}
+
+	return signers[0].String(), sigs[0].Sequence, nil
+}
+
+// isPriceBumped returns true if both the fee cap and the tip cap of the
+// replacement are higher than the old ones by at least priceBump percent.
+func isPriceBumped(old, replacement evmtypes.TxData, priceBump uint64) bool {
+	bumped := func(oldPrice, newPrice *big.Int) bool {
+		if newPrice.Cmp(oldPrice) <= 0 {
+			return false
+		}
+		// threshold = oldPrice * (100 + priceBump) / 100
+		threshold := new(big.Int).Mul(oldPrice, new(big.Int).SetUint64(100+priceBump))
+		threshold.Quo(threshold, big.NewInt(100))
+		return newPrice.Cmp(threshold) >= 0
+	}
+
+	return bumped(old.GetGasFeeCap(), replacement.GetGasFeeCap()) &&
+		bumped(old.GetGasTipCap(), replacement.GetGasTipCap())
+}
+
+// senderHeap is a max heap of the executable eth txs of each sender, sorted
+// by the effective tip of their first tx.
+type senderHeap struct {
+	txs     [][]*evmTx
+	baseFee *big.Int
+}
+
+func (h senderHeap) headPriority(i int) int64 {
+	return evmtypes.GetTxPriority(h.txs[i][0].txData, h.baseFee)
+}
+
+func (h senderHeap) Len() int { return len(h.txs) }
+
+func (h senderHeap) Less(i, j int) bool {
+	pi, pj := h.headPriority(i), h.headPriority(j)
+	if pi != pj {
+		return pi > pj
+	}
+	// sort by sender to get a deterministic order
+	return bytes.Compare(h.txs[i][0].sender.Bytes(), h.txs[j][0].sender.Bytes()) < 0
+}
+
+func (h senderHeap) Swap(i, j int) { h.txs[i], h.txs[j] = h.txs[j], h.txs[i] }
+
+func (h *senderHeap) Push(x interface{}) {
+	h.txs = append(h.txs, x.([]*evmTx))
+}
+
+func (h *senderHeap) Pop() interface{} {
+	old := h.txs
+	n := len(old)
+	x := old[n-1]
+	h.txs = old[:n-1]
+	return x
+}
+
+// iterator iterates over the txs selected from the mempool.
+type iterator struct {
+	txs []sdk.Tx
+	idx int
+}
+
+var _ sdkmempool.Iterator = (*iterator)(nil)
+
+// Next returns the iterator over the next tx or nil at the end of the txs.
+func (it *iterator) Next() sdkmempool.Iterator {
+	it.idx++
+	if it.idx >= len(it.txs) {
+		return nil
+	}
+	return it
+}
+
+// Tx returns the current tx.
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[it.idx]
+}
@@ -0,0 +1,301 @@
+package mempool_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarryBin2002/kairoschain/v12/app"
+	"github.com/HarryBin2002/kairoschain/v12/app/mempool"
+	"github.com/HarryBin2002/kairoschain/v12/encoding"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+type mockAccountKeeper map[string]uint64
+
+func (ak mockAccountKeeper) GetSequence(_ sdk.Context, addr sdk.AccAddress) (uint64, error) {
+	seq, ok := ak[addr.String()]
+	if !ok {
+		return 0, errors.New("account not found")
+	}
+	return seq, nil
+}
+
+type mockFeeMarketKeeper struct {
+	baseFee *big.Int
+}
+
+func (fmk *mockFeeMarketKeeper) GetBaseFee(sdk.Context) *big.Int {
+	return fmk.baseFee
+}
+
+var encodingConfig = encoding.MakeConfig(app.ModuleBasics)
+
+// price returns the price with the given priority.
+func price(priority int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(priority), evmtypes.DefaultPriorityReduction.BigInt())
+}
+
+func newEthTx(t *testing.T, from common.Address, nonce uint64, feeCap, tipCap *big.Int) sdk.Tx {
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   big.NewInt(9000),
+		Nonce:     nonce,
+		To:        &common.Address{},
+		GasLimit:  21000,
+		GasFeeCap: feeCap,
+		GasTipCap: tipCap,
+		Accesses:  &ethtypes.AccessList{},
+	})
+	msg.From = from.Hex()
+
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	return txBuilder.GetTx()
+}
+
+func newCosmosTx(t *testing.T, from sdk.AccAddress, sequence uint64) sdk.Tx {
+	_, priv := utiltx.NewAccAddressAndKey()
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(from, from, nil)))
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}))
+	return txBuilder.GetTx()
+}
+
+func checkCtx(priority int64) sdk.Context {
+	return sdk.Context{}.WithContext(context.Background()).WithPriority(priority)
+}
+
+func selectTxs(mp *mempool.EVMMempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(checkCtx(0), nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestEVMMempoolNonceGaps(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	ak := mockAccountKeeper{sdk.AccAddress(from.Bytes()).String(): 1}
+	mp := mempool.NewEVMMempool(ak, &mockFeeMarketKeeper{}, 0, 0, mempool.DefaultPriceBump, 0)
+
+	tx1 := newEthTx(t, from, 1, price(2), price(1))
+	tx2 := newEthTx(t, from, 2, price(2), price(1))
+	tx3 := newEthTx(t, from, 3, price(2), price(1))
+
+	require.NoError(t, mp.Insert(checkCtx(0), tx3))
+	require.NoError(t, mp.Insert(checkCtx(0), tx1))
+	require.Equal(t, 2, mp.CountTx())
+	// tx3 waits for the missing nonce
+	require.Equal(t, []sdk.Tx{tx1}, selectTxs(mp))
+
+	require.NoError(t, mp.Insert(checkCtx(0), tx2))
+	require.Equal(t, []sdk.Tx{tx1, tx2, tx3}, selectTxs(mp))
+
+	// the included txs are removed and the stale ones are pruned
+	require.NoError(t, mp.Remove(tx1))
+	ak[sdk.AccAddress(from.Bytes()).String()] = 3
+	require.Equal(t, []sdk.Tx{tx3}, selectTxs(mp))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestEVMMempoolReplacement(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	ak := mockAccountKeeper{sdk.AccAddress(from.Bytes()).String(): 0}
+	mp := mempool.NewEVMMempool(ak, &mockFeeMarketKeeper{}, 0, 0, mempool.DefaultPriceBump, 0)
+
+	tx := newEthTx(t, from, 0, big.NewInt(1000), big.NewInt(100))
+	require.NoError(t, mp.Insert(checkCtx(0), tx))
+	// inserting the same tx is a no-op
+	require.NoError(t, mp.Insert(checkCtx(0), tx))
+
+	testCases := []struct {
+		name   string
+		feeCap int64
+		tipCap int64
+		expErr error
+	}{
+		{"fee cap not bumped", 1000, 110, mempool.ErrReplaceUnderpriced},
+		{"tip cap not bumped enough", 1100, 105, mempool.ErrReplaceUnderpriced},
+		{"fee cap not bumped enough", 1099, 110, mempool.ErrReplaceUnderpriced},
+		{"bumped", 1100, 110, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			replacement := newEthTx(t, from, 0, big.NewInt(tc.feeCap), big.NewInt(tc.tipCap))
+			err := mp.Insert(checkCtx(0), replacement)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Equal(t, []sdk.Tx{tx}, selectTxs(mp))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Tx{replacement}, selectTxs(mp))
+		})
+	}
+	require.Equal(t, 1, mp.CountTx())
+
+	// the replaced tx is removed when the replacement is included
+	require.ErrorIs(t, mp.Remove(tx), sdkmempool.ErrTxNotFound)
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestEVMMempoolSelect(t *testing.T) {
+	fromA := utiltx.GenerateAddress()
+	fromB := utiltx.GenerateAddress()
+	cosmosSender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	ak := mockAccountKeeper{}
+	fmk := &mockFeeMarketKeeper{baseFee: price(1)}
+	mp := mempool.NewEVMMempool(ak, fmk, 0, 0, mempool.DefaultPriceBump, 0)
+
+	a0 := newEthTx(t, fromA, 0, price(100), price(5))
+	a1 := newEthTx(t, fromA, 1, price(100), price(50))
+	b0 := newEthTx(t, fromB, 0, price(100), price(10))
+	c0 := newCosmosTx(t, cosmosSender, 0)
+	for _, tx := range []sdk.Tx{a0, a1, b0} {
+		require.NoError(t, mp.Insert(checkCtx(0), tx))
+	}
+	require.NoError(t, mp.Insert(checkCtx(7), c0))
+	require.Equal(t, 4, mp.CountTx())
+
+	// senders are ordered by the effective tip of their next tx
+	require.Equal(t, []sdk.Tx{b0, c0, a0, a1}, selectTxs(mp))
+
+	// the txs with a fee cap lower than the base fee are skipped
+	fmk.baseFee = price(101)
+	require.Equal(t, []sdk.Tx{c0}, selectTxs(mp))
+	require.Equal(t, 4, mp.CountTx())
+
+	require.NoError(t, mp.Remove(c0))
+	require.ErrorIs(t, mp.Remove(c0), sdkmempool.ErrTxNotFound)
+	require.Nil(t, mp.Select(checkCtx(0), nil))
+}
+
+func TestEVMMempoolMaxTx(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	mp := mempool.NewEVMMempool(mockAccountKeeper{}, &mockFeeMarketKeeper{}, 1, 0, mempool.DefaultPriceBump, 0)
+
+	require.NoError(t, mp.Insert(checkCtx(0), newEthTx(t, from, 0, big.NewInt(10), big.NewInt(10))))
+	require.ErrorIs(t, mp.Insert(checkCtx(0), newEthTx(t, from, 1, big.NewInt(10), big.NewInt(10))), sdkmempool.ErrMempoolTxMaxCapacity)
+	// replacements don't need additional capacity
+	require.NoError(t, mp.Insert(checkCtx(0), newEthTx(t, from, 0, big.NewInt(20), big.NewInt(20))))
+
+	disabled := mempool.NewEVMMempool(mockAccountKeeper{}, &mockFeeMarketKeeper{}, -1, 0, mempool.DefaultPriceBump, 0)
+	require.NoError(t, disabled.Insert(checkCtx(0), newEthTx(t, from, 0, big.NewInt(10), big.NewInt(10))))
+	require.Equal(t, 0, disabled.CountTx())
+}
+
+func TestEVMMempoolMaxTxEvictQueued(t *testing.T) {
+	from, other := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	mp := mempool.NewEVMMempool(mockAccountKeeper{}, &mockFeeMarketKeeper{}, 2, 0, mempool.DefaultPriceBump, 0)
+
+	tx0 := newEthTx(t, from, 0, big.NewInt(10), big.NewInt(10))
+	queued := newEthTx(t, other, 3, big.NewInt(10), big.NewInt(10))
+	require.NoError(t, mp.Insert(checkCtx(0), tx0))
+	require.NoError(t, mp.Insert(checkCtx(0), queued))
+
+	// a queued tx is rejected when the mempool is full
+	require.ErrorIs(t, mp.Insert(checkCtx(0), newEthTx(t, from, 2, big.NewInt(10), big.NewInt(10))), sdkmempool.ErrMempoolTxMaxCapacity)
+
+	// an executable tx evicts the queued one
+	tx1 := newEthTx(t, from, 1, big.NewInt(10), big.NewInt(10))
+	require.NoError(t, mp.Insert(checkCtx(0), tx1))
+	require.Equal(t, 2, mp.CountTx())
+	require.ErrorIs(t, mp.RecheckTx(checkCtx(0), queued), sdkmempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{tx0, tx1}, selectTxs(mp))
+
+	// no queued tx is left to evict
+	require.ErrorIs(t, mp.Insert(checkCtx(0), newEthTx(t, other, 0, big.NewInt(10), big.NewInt(10))), sdkmempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestEVMMempoolQueueLifetime(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	mp := mempool.NewEVMMempool(mockAccountKeeper{}, &mockFeeMarketKeeper{}, 0, 0, mempool.DefaultPriceBump, 10)
+
+	tx0 := newEthTx(t, from, 0, big.NewInt(10), big.NewInt(10))
+	queued := newEthTx(t, from, 2, big.NewInt(10), big.NewInt(10))
+	require.NoError(t, mp.Insert(checkCtx(0).WithBlockHeight(1), tx0))
+	require.NoError(t, mp.Insert(checkCtx(0).WithBlockHeight(1), queued))
+
+	require.NoError(t, mp.RecheckTx(checkCtx(0).WithBlockHeight(11), queued))
+	require.Equal(t, 2, mp.CountTx())
+
+	// the executable txs are kept past the queue lifetime
+	require.NoError(t, mp.RecheckTx(checkCtx(0).WithBlockHeight(12), tx0))
+	require.ErrorIs(t, mp.RecheckTx(checkCtx(0).WithBlockHeight(12), queued), mempool.ErrQueueLifetimeExceeded)
+	require.ErrorIs(t, mp.RecheckTx(checkCtx(0).WithBlockHeight(12), queued), sdkmempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{tx0}, selectTxs(mp))
+
+	// the expired queued txs are evicted when selecting the txs of a block
+	queued = newEthTx(t, from, 3, big.NewInt(10), big.NewInt(10))
+	require.NoError(t, mp.Insert(checkCtx(0).WithBlockHeight(12), queued))
+	require.NotNil(t, mp.Select(checkCtx(0).WithBlockHeight(23), nil))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestEVMMempoolMaxTxPerSender(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	mp := mempool.NewEVMMempool(mockAccountKeeper{}, &mockFeeMarketKeeper{}, 0, 2, mempool.DefaultPriceBump, 0)
+
+	require.NoError(t, mp.Insert(checkCtx(0), newEthTx(t, from, 0, big.NewInt(10), big.NewInt(10))))
+	require.NoError(t, mp.Insert(checkCtx(0), newEthTx(t, from, 5, big.NewInt(10), big.NewInt(10))))
+	require.ErrorIs(t, mp.Insert(checkCtx(0), newEthTx(t, from, 6, big.NewInt(10), big.NewInt(10))), mempool.ErrSenderMaxCapacity)
+	// replacements and other senders are accepted
+	require.NoError(t, mp.Insert(checkCtx(0), newEthTx(t, from, 5, big.NewInt(20), big.NewInt(20))))
+	require.NoError(t, mp.Insert(checkCtx(0), newEthTx(t, utiltx.GenerateAddress(), 0, big.NewInt(10), big.NewInt(10))))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestEVMMempoolEvict(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	ak := mockAccountKeeper{sdk.AccAddress(from.Bytes()).String(): 0}
+	mp := mempool.NewEVMMempool(ak, &mockFeeMarketKeeper{}, 0, 0, mempool.DefaultPriceBump, 0)
+
+	tx := newEthTx(t, from, 0, big.NewInt(1000), big.NewInt(100))
+	replacement := newEthTx(t, from, 0, big.NewInt(1100), big.NewInt(110))
+	queued := newEthTx(t, from, 1, big.NewInt(1000), big.NewInt(100))
+	require.NoError(t, mp.Insert(checkCtx(0), tx))
+	require.NoError(t, mp.Insert(checkCtx(0), replacement))
+	require.NoError(t, mp.Insert(checkCtx(0), queued))
+
+	// the replaced tx is evicted without removing its replacement
+	require.ErrorIs(t, mp.Evict(tx), sdkmempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{replacement, queued}, selectTxs(mp))
+
+	require.NoError(t, mp.Evict(queued))
+	require.Equal(t, []sdk.Tx{replacement}, selectTxs(mp))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestEVMMempoolMultipleMsgs(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	ak := mockAccountKeeper{sdk.AccAddress(from.Bytes()).String(): 0}
+	mp := mempool.NewEVMMempool(ak, &mockFeeMarketKeeper{}, 0, 0, mempool.DefaultPriceBump, 0)
+
+	// a single tx with the messages of nonces 0 and 1
+	msgs := make([]sdk.Msg, 0, 2)
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		msgs = append(msgs, newEthTx(t, from, nonce, big.NewInt(10), big.NewInt(10)).GetMsgs()[0])
+	}
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	batch := txBuilder.GetTx()
+	tx2 := newEthTx(t, from, 2, big.NewInt(10), big.NewInt(10))
+
+	require.NoError(t, mp.Insert(checkCtx(0), tx2))
+	require.NoError(t, mp.Insert(checkCtx(0), batch))
+	require.Equal(t, []sdk.Tx{batch, tx2}, selectTxs(mp))
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"fmt"
+
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	distributionprecompile "github.com/HarryBin2002/kairoschain/v12/precompiles/distribution"
+	ics20precompile "github.com/HarryBin2002/kairoschain/v12/precompiles/ics20"
+	stakingprecompile "github.com/HarryBin2002/kairoschain/v12/precompiles/staking"
+	transferkeeper "github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/keeper"
+)
+
+// NewAvailablePrecompiles returns the stateful precompiled contracts of the
+// Cosmos modules. They are registered on the EVM keeper and only callable once
+// enabled through the ActivePrecompiles parameter of the EVM module.
+func NewAvailablePrecompiles(
+	accountKeeper authkeeper.AccountKeeper,
+	stakingKeeper *stakingkeeper.Keeper,
+	distributionKeeper distrkeeper.Keeper,
+	transferKeeper transferkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+) []vm.PrecompiledContract {
+	stakingPrecompile, err := stakingprecompile.NewPrecompile(accountKeeper, stakingKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load staking precompile: %w", err))
+	}
+
+	distributionPrecompile, err := distributionprecompile.NewPrecompile(stakingKeeper, distributionKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load distribution precompile: %w", err))
+	}
+
+	ics20Precompile, err := ics20precompile.NewPrecompile(transferKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load ICS20 precompile: %w", err))
+	}
+
+	return []vm.PrecompiledContract{
+		stakingPrecompile,
+		distributionPrecompile,
+		ics20Precompile,
+	}
+}
@@ -0,0 +1,6 @@
+package v13
+
+const (
+	// UpgradeName is the shared upgrade plan name for mainnet
+	UpgradeName = "v13.0.0"
+)
@@ -240,6 +240,9 @@ require (
 replace (
 	// use cosmos fork of keyring
 	github.com/99designs/keyring => github.com/cosmos/keyring v1.2.0
+	// use the Evmos geth fork that supports stateful precompiled contracts and opcode hooks,
+	// patched with the instructions of the Cancun fork, see third_party/go-ethereum/README.md
+	github.com/ethereum/go-ethereum => github.com/HarryBin2002/go-ethereum v1.10.26-kairos-rc1
 	// Security Advisory https://github.com/advisories/GHSA-h395-qcrw-5vmq
 	github.com/gin-gonic/gin => github.com/gin-gonic/gin v1.9.1
 	// replace broken goleveldb
@@ -1,8 +1,12 @@
 package indexer
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/HarryBin2002/kairoschain/v12/constants"
+	"math"
+	"sort"
 	"sync"
 
 	errorsmod "cosmossdk.io/errors"
@@ -16,17 +20,31 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	evertypes "github.com/HarryBin2002/kairoschain/v12/types"
 	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixTxAddress  = 3
+	KeyPrefixLog        = 4
+	KeyPrefixLogAddress = 5
+	KeyPrefixLogTopic   = 6
+	// KeyPrefixLogFirstBlock is the key of the first block whose logs are indexed
+	KeyPrefixLogFirstBlock   = 7
+	KeyPrefixTxSenderNonce   = 8
+	KeyPrefixContractCreator = 9
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// TxAddressKeyLength is the length of tx-address key
+	TxAddressKeyLength = 1 + common.AddressLength + 8 + 8
+	// LogKeyLength is the length of log key
+	LogKeyLength = 1 + 8 + 8
 )
 
 var _ evertypes.EVMTxIndexer = &KVIndexer{}
@@ -63,6 +81,7 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a `indexer.TxResult` based on parsed events for every message
+// - Stores the logs of every successful Tx, indexed by address and by topic position
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
 
@@ -77,6 +96,12 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			continue
 		}
 
+		if result.Code == abci.CodeTypeOK {
+			if err := saveTxLogs(kv.clientCtx.Codec, batch, height, result.Events); err != nil {
+				kv.logger.Error("Fail to index tx logs", "err", err, "block", height, "txIndex", txIndex)
+			}
+		}
+
 		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
 		if err != nil {
 			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
@@ -129,8 +154,23 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			if err := saveTxAddresses(batch, ethMsg, &txResult); err != nil {
+				kv.logger.Error("Fail to index tx addresses", "err", err, "block", height, "txIndex", txIndex)
+			}
+		}
+	}
+
+	// the blocks indexed before the log index was introduced don't have their logs indexed
+	hasFirstLogBlock, err := kv.db.Has(LogFirstBlockKey())
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if !hasFirstLogBlock {
+		if err := batch.Set(LogFirstBlockKey(), sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set log first block", height)
 		}
 	}
+
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -177,6 +217,232 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ever
 	return kv.getByBlockAndIndex(blockNumber, txIndex)
 }
 
+// GetTxHashesByAddresses finds the eth txs sent by or to any of the addresses
+// within the block range, the results are ordered by block number and eth tx index.
+func (kv *KVIndexer) GetTxHashesByAddresses(addresses []common.Address, fromBlock, toBlock int64) ([]common.Hash, error) {
+	type indexedTx struct {
+		height  int64
+		txIndex int64
+		hash    common.Hash
+	}
+
+	seen := make(map[common.Hash]bool)
+	var txs []indexedTx
+	for _, address := range addresses {
+		it, err := kv.db.Iterator(TxAddressKey(address, fromBlock, 0), TxAddressKey(address, toBlock+1, 0))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetTxHashesByAddresses %s", address.Hex())
+		}
+		for ; it.Valid(); it.Next() {
+			hash := common.BytesToHash(it.Value())
+			if seen[hash] {
+				continue
+			}
+			seen[hash] = true
+
+			key := it.Key()
+			if len(key) != TxAddressKeyLength {
+				it.Close()
+				return nil, fmt.Errorf("wrong tx address key length, expect: %d, got: %d", TxAddressKeyLength, len(key))
+			}
+			txs = append(txs, indexedTx{
+				height:  int64(sdk.BigEndianToUint64(key[1+common.AddressLength : 1+common.AddressLength+8])),
+				txIndex: int64(sdk.BigEndianToUint64(key[1+common.AddressLength+8:])),
+				hash:    hash,
+			})
+		}
+		if err := it.Close(); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetTxHashesByAddresses %s", address.Hex())
+		}
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].height != txs[j].height {
+			return txs[i].height < txs[j].height
+		}
+		return txs[i].txIndex < txs[j].txIndex
+	})
+
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.hash
+	}
+	return hashes, nil
+}
+
+// FirstLogIndexedBlock returns the first block number whose logs are indexed, returns -1 if
+// no logs are indexed.
+func (kv *KVIndexer) FirstLogIndexedBlock() (int64, error) {
+	bz, err := kv.db.Get(LogFirstBlockKey())
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstLogIndexedBlock")
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// GetLogs finds the logs emitted by any of the addresses and matching the topics within the
+// block range, the results are ordered by block number and log index. The search stops once
+// more than limit logs are found, so at most limit+1 logs are returned.
+//
+// The candidate logs are read from the address index if any address is given, otherwise from
+// the index of the first non-wildcard topic position, and the remaining criteria are checked
+// on the stored logs.
+func (kv *KVIndexer) GetLogs(addresses []common.Address, topics [][]common.Hash, fromBlock, toBlock int64, limit int) ([]*ethtypes.Log, error) {
+	var prefixes [][]byte
+	if len(addresses) > 0 {
+		for _, address := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogAddress}, address.Bytes()...))
+		}
+	} else {
+		for position, sub := range topics {
+			if len(sub) == 0 {
+				continue
+			}
+			for _, topic := range sub {
+				prefixes = append(prefixes, append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...))
+			}
+			break
+		}
+	}
+	if len(prefixes) == 0 {
+		prefixes = [][]byte{{KeyPrefixLog}}
+	}
+
+	iterators := make([]dbm.Iterator, 0, len(prefixes))
+	defer func() {
+		for _, it := range iterators {
+			it.Close()
+		}
+	}()
+	for _, prefix := range prefixes {
+		it, err := kv.db.Iterator(logIndexKey(prefix, fromBlock, 0), logIndexKey(prefix, toBlock+1, 0))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		iterators = append(iterators, it)
+	}
+
+	// the candidates of the prefixes are merged in the order of the log keys, so that the
+	// iteration stops as soon as the limit is exceeded
+	logs := []*ethtypes.Log{}
+	var lastKey []byte
+	for len(logs) <= limit {
+		next := -1
+		var logKey []byte
+		for i, it := range iterators {
+			if !it.Valid() {
+				continue
+			}
+			key := it.Key()
+			if len(key) != len(prefixes[i])+LogKeyLength-1 {
+				return nil, fmt.Errorf("wrong log key length, expect: %d, got: %d", len(prefixes[i])+LogKeyLength-1, len(key))
+			}
+			if next < 0 || bytes.Compare(key[len(prefixes[i]):], logKey[1:]) < 0 {
+				next = i
+				logKey = append([]byte{KeyPrefixLog}, key[len(prefixes[i]):]...)
+			}
+		}
+		if next < 0 {
+			break
+		}
+		iterators[next].Next()
+
+		// a log indexed under several prefixes is merged once
+		if bytes.Equal(logKey, lastKey) {
+			continue
+		}
+		lastKey = logKey
+
+		bz, err := kv.db.Get(logKey)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		if len(bz) == 0 {
+			return nil, fmt.Errorf("log not found, key: %X", logKey)
+		}
+		var log evmtypes.Log
+		if err := kv.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		if ethLog := log.ToEthereum(); logMatches(ethLog, addresses, topics) {
+			logs = append(logs, ethLog)
+		}
+	}
+	return logs, nil
+}
+
+// SearchTxHashesByAddress finds the eth txs sent by or to the address in the blocks before the block
+// number, from the newest to the oldest, or after it, from the oldest to the newest. At least pageSize
+// txs are returned unless the search reaches the end, the txs of a block are never split between pages.
+// It also returns whether there are more txs to search.
+func (kv *KVIndexer) SearchTxHashesByAddress(address common.Address, blockNumber int64, pageSize int, before bool) ([]common.Hash, bool, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if before {
+		it, err = kv.db.ReverseIterator(TxAddressKey(address, 0, 0), TxAddressKey(address, blockNumber, 0))
+	} else {
+		it, err = kv.db.Iterator(TxAddressKey(address, blockNumber+1, 0), TxAddressKey(address, math.MaxInt64, 0))
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "SearchTxHashesByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var (
+		hashes     []common.Hash
+		lastHeight int64 = -1
+	)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != TxAddressKeyLength {
+			return nil, false, fmt.Errorf("wrong tx address key length, expect: %d, got: %d", TxAddressKeyLength, len(key))
+		}
+		height := int64(sdk.BigEndianToUint64(key[1+common.AddressLength : 1+common.AddressLength+8]))
+		if len(hashes) >= pageSize && height != lastHeight {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastHeight = height
+	}
+	return hashes, false, nil
+}
+
+// GetTxHashBySenderAndNonce finds the eth tx sent by the address with the nonce, returns nil if not found.
+func (kv *KVIndexer) GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	bz, err := kv.db.Get(TxSenderNonceKey(sender, nonce))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTxHashBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
+// GetContractCreator finds the eth tx which created the contract and its sender, returns nil if not found.
+func (kv *KVIndexer) GetContractCreator(contract common.Address) (*evertypes.ContractCreator, error) {
+	bz, err := kv.db.Get(ContractCreatorKey(contract))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreator %s", contract.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	if len(bz) != common.HashLength+common.AddressLength {
+		return nil, fmt.Errorf("wrong contract creator length, expect: %d, got: %d", common.HashLength+common.AddressLength, len(bz))
+	}
+	return &evertypes.ContractCreator{
+		TxHash:  common.BytesToHash(bz[:common.HashLength]),
+		Creator: common.BytesToAddress(bz[common.HashLength:]),
+	}, nil
+}
+
 // GetLastRequestIndexedBlock returns the block height of the latest success called to IndexBlock()
 func (kv *KVIndexer) GetLastRequestIndexedBlock() (int64, error) {
 	kv.mu.RLock()
@@ -229,6 +495,51 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// TxAddressKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func TxAddressKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	return append(append(append([]byte{KeyPrefixTxAddress}, address.Bytes()...), bz1...), bz2...)
+}
+
+// TxSenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func TxSenderNonceKey(sender common.Address, nonce uint64) []byte {
+	return append(append([]byte{KeyPrefixTxSenderNonce}, sender.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// ContractCreatorKey returns the key for db entry: `contract address -> (tx hash, creator)`
+func ContractCreatorKey(contract common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreator}, contract.Bytes()...)
+}
+
+// LogKey returns the key for db entry: `(block number, log index) -> log`
+func LogKey(blockNumber int64, logIndex uint64) []byte {
+	return logIndexKey([]byte{KeyPrefixLog}, blockNumber, logIndex)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint64) []byte {
+	return logIndexKey(append([]byte{KeyPrefixLogAddress}, address.Bytes()...), blockNumber, logIndex)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, log index) -> nil`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	return logIndexKey(append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...), blockNumber, logIndex)
+}
+
+// LogFirstBlockKey returns the key for db entry: `-> first block number whose logs are indexed`
+func LogFirstBlockKey() []byte {
+	return []byte{KeyPrefixLogFirstBlock}
+}
+
+// logIndexKey appends the block number and the log index to the key prefix
+func logIndexKey(prefix []byte, blockNumber int64, logIndex uint64) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(logIndex)
+	key := make([]byte, 0, len(prefix)+len(bz1)+len(bz2))
+	return append(append(append(key, prefix...), bz1...), bz2...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -280,6 +591,109 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveTxAddresses index the sender and the recipient of the eth tx into the kv db batch,
+// the recipient of a contract creation is the address of the created contract. The tx is
+// also indexed by sender and nonce, and by created contract if the creation succeeded.
+func saveTxAddresses(batch dbm.Batch, ethMsg *evmtypes.MsgEthereumTx, txResult *evertypes.TxResult) error {
+	tx := ethMsg.AsTransaction()
+
+	var from common.Address
+	if ethMsg.From != "" {
+		from = common.HexToAddress(ethMsg.From)
+	} else {
+		sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return errorsmod.Wrap(err, "recover tx sender")
+		}
+		from = sender
+	}
+
+	to := crypto.CreateAddress(from, tx.Nonce())
+	if tx.To() != nil {
+		to = *tx.To()
+	}
+
+	txHash := tx.Hash()
+	for _, address := range []common.Address{from, to} {
+		if err := batch.Set(TxAddressKey(address, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set tx-address key")
+		}
+	}
+	if err := batch.Set(TxSenderNonceKey(from, tx.Nonce()), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set tx-sender-nonce key")
+	}
+	if tx.To() == nil && !txResult.Failed {
+		if err := batch.Set(ContractCreatorKey(to), append(txHash.Bytes(), from.Bytes()...)); err != nil {
+			return errorsmod.Wrap(err, "set contract-creator key")
+		}
+	}
+	return nil
+}
+
+// saveTxLogs index the logs emitted by the tx into the kv db batch
+func saveTxLogs(codec codec.Codec, batch dbm.Batch, height int64, events []abci.Event) error {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+
+			var log evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+				return errorsmod.Wrap(err, "parse tx log")
+			}
+			if err := batch.Set(LogKey(height, log.Index), codec.MustMarshal(&log)); err != nil {
+				return errorsmod.Wrap(err, "set log key")
+			}
+			if err := batch.Set(LogAddressKey(common.HexToAddress(log.Address), height, log.Index), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-address key")
+			}
+			for position, topic := range log.Topics {
+				if err := batch.Set(LogTopicKey(position, common.HexToHash(topic), height, log.Index), []byte{}); err != nil {
+					return errorsmod.Wrap(err, "set log-topic key")
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// logMatches returns true if the log is emitted by any of the addresses and matches the topics,
+// an empty topic list at a position matches any topic.
+func logMatches(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if address == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		match := len(sub) == 0
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -1,6 +1,8 @@
 package indexer_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/HarryBin2002/kairoschain/v12/constants"
 	"math/big"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	evmenc "github.com/HarryBin2002/kairoschain/v12/encoding"
 	"github.com/HarryBin2002/kairoschain/v12/indexer"
 	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	evertypes "github.com/HarryBin2002/kairoschain/v12/types"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -19,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -186,11 +190,227 @@ func TestKVIndexer(t *testing.T) {
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				hashes, err := idxer.GetTxHashesByAddresses([]common.Address{from}, 1, 1)
+				require.NoError(t, err)
+				require.Equal(t, []common.Hash{txHash}, hashes)
+				hashes, err = idxer.GetTxHashesByAddresses([]common.Address{from, to}, 0, 1)
+				require.NoError(t, err)
+				require.Equal(t, []common.Hash{txHash}, hashes)
+				hashes, err = idxer.GetTxHashesByAddresses([]common.Address{to}, 2, 10)
+				require.NoError(t, err)
+				require.Empty(t, hashes)
+				hashes, err = idxer.GetTxHashesByAddresses([]common.Address{common.BigToAddress(big.NewInt(2))}, 1, 1)
+				require.NoError(t, err)
+				require.Empty(t, hashes)
 			}
 		})
 	}
 }
 
+func TestKVIndexerLogs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{Nonce: 0, To: &to, Amount: big.NewInt(1000), GasLimit: 100000})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	contractA := common.BigToAddress(big.NewInt(100))
+	contractB := common.BigToAddress(big.NewInt(200))
+	topicA := common.BigToHash(big.NewInt(1))
+	topicB := common.BigToHash(big.NewInt(2))
+
+	// logs of block 2 indexed 0, 1 and 2
+	logs := []*ethtypes.Log{
+		{Address: contractA, Topics: []common.Hash{topicA}, BlockNumber: 2, TxHash: txHash, Index: 0},
+		{Address: contractB, Topics: []common.Hash{topicA, topicB}, BlockNumber: 2, TxHash: txHash, Index: 1},
+		{Address: contractA, Topics: []common.Hash{topicB}, BlockNumber: 2, TxHash: txHash, Index: 2},
+	}
+	logAttrs := make([]abci.EventAttribute, len(logs))
+	for i, log := range logs {
+		bz, err := json.Marshal(types.NewLogFromEth(log))
+		require.NoError(t, err)
+		logAttrs[i] = abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+	}
+	blockResult := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "50000"},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: logAttrs},
+			},
+		},
+	}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	first, err := idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 2}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	require.NoError(t, idxer.IndexBlock(block, blockResult))
+	// the later blocks don't move the first log indexed block
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 3}}, nil))
+	first, err = idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), first)
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		topics    [][]common.Hash
+		from, to  int64
+		expLogs   []*ethtypes.Log
+	}{
+		{"all logs", nil, nil, 1, 3, logs},
+		{"out of range", nil, nil, 3, 10, []*ethtypes.Log{}},
+		{"by address", []common.Address{contractA}, nil, 2, 2, []*ethtypes.Log{logs[0], logs[2]}},
+		{"by addresses", []common.Address{contractB, contractA}, nil, 2, 2, logs},
+		{"by address and topic", []common.Address{contractA}, [][]common.Hash{{topicB}}, 2, 2, []*ethtypes.Log{logs[2]}},
+		{"by topic", nil, [][]common.Hash{{topicA}}, 2, 2, []*ethtypes.Log{logs[0], logs[1]}},
+		{"by topics", nil, [][]common.Hash{{topicA, topicB}}, 2, 2, logs},
+		{"by second topic", nil, [][]common.Hash{{}, {topicB}}, 2, 2, []*ethtypes.Log{logs[1]}},
+		{"by topic positions", nil, [][]common.Hash{{topicA}, {topicA}}, 2, 2, []*ethtypes.Log{}},
+		{"unknown address", []common.Address{to}, nil, 2, 2, []*ethtypes.Log{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := idxer.GetLogs(tc.addresses, tc.topics, tc.from, tc.to, len(logs))
+			require.NoError(t, err)
+			require.Equal(t, tc.expLogs, res)
+		})
+	}
+
+	// the search stops once the limit is exceeded
+	res, err := idxer.GetLogs([]common.Address{contractB, contractA}, nil, 1, 3, 1)
+	require.NoError(t, err)
+	require.Equal(t, logs[:2], res)
+}
+
+func TestKVIndexerSearch(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	to := common.BigToAddress(big.NewInt(1))
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// buildBlock builds a block with the txs of the nonces sent to the recipient
+	buildBlock := func(height int64, recipient *common.Address, nonces ...uint64) (*tmtypes.Block, []*abci.ResponseDeliverTx, []common.Hash) {
+		var (
+			txs     []tmtypes.Tx
+			results []*abci.ResponseDeliverTx
+			hashes  []common.Hash
+		)
+		for i, nonce := range nonces {
+			tx := types.NewTx(&types.EvmTxArgs{Nonce: nonce, To: recipient, Amount: big.NewInt(1), GasLimit: 100000})
+			tx.From = from.Hex()
+			require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), signer))
+			tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.BaseDenom)
+			require.NoError(t, err)
+			txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+			require.NoError(t, err)
+
+			hash := tx.AsTransaction().Hash()
+			txs = append(txs, txBz)
+			hashes = append(hashes, hash)
+			results = append(results, &abci.ResponseDeliverTx{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: hash.Hex()},
+						{Key: "txIndex", Value: fmt.Sprintf("%d", i)},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+				},
+			})
+		}
+		return &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: txs}}, results, hashes
+	}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	var hashes []common.Hash
+	for _, blk := range []struct {
+		height    int64
+		recipient *common.Address
+		nonces    []uint64
+	}{
+		{1, nil, []uint64{0}},
+		{2, &to, []uint64{1, 2}},
+		{3, &to, []uint64{3}},
+	} {
+		block, results, blockHashes := buildBlock(blk.height, blk.recipient, blk.nonces...)
+		require.NoError(t, idxer.IndexBlock(block, results))
+		hashes = append(hashes, blockHashes...)
+	}
+
+	t.Run("search", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			address     common.Address
+			blockNumber int64
+			pageSize    int
+			before      bool
+			expHashes   []common.Hash
+			expHasMore  bool
+		}{
+			{"before latest", from, 4, 1, true, []common.Hash{hashes[3]}, true},
+			{"before block, complete block", from, 3, 1, true, []common.Hash{hashes[2], hashes[1]}, true},
+			{"before block, exhausted", from, 3, 10, true, []common.Hash{hashes[2], hashes[1], hashes[0]}, false},
+			{"after genesis", from, 0, 1, false, []common.Hash{hashes[0]}, true},
+			{"after block, complete block", from, 1, 1, false, []common.Hash{hashes[1], hashes[2]}, true},
+			{"after block, exhausted", to, 1, 10, false, []common.Hash{hashes[1], hashes[2], hashes[3]}, false},
+			{"unknown address", common.BigToAddress(big.NewInt(2)), 0, 10, false, nil, false},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				res, hasMore, err := idxer.SearchTxHashesByAddress(tc.address, tc.blockNumber, tc.pageSize, tc.before)
+				require.NoError(t, err)
+				require.Equal(t, tc.expHashes, res)
+				require.Equal(t, tc.expHasMore, hasMore)
+			})
+		}
+	})
+
+	t.Run("sender and nonce", func(t *testing.T) {
+		hash, err := idxer.GetTxHashBySenderAndNonce(from, 2)
+		require.NoError(t, err)
+		require.Equal(t, &hashes[2], hash)
+
+		hash, err = idxer.GetTxHashBySenderAndNonce(from, 4)
+		require.NoError(t, err)
+		require.Nil(t, hash)
+	})
+
+	t.Run("contract creator", func(t *testing.T) {
+		creator, err := idxer.GetContractCreator(crypto.CreateAddress(from, 0))
+		require.NoError(t, err)
+		require.Equal(t, &evertypes.ContractCreator{TxHash: hashes[0], Creator: from}, creator)
+
+		creator, err = idxer.GetContractCreator(to)
+		require.NoError(t, err)
+		require.Nil(t, creator)
+	})
+}
+
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
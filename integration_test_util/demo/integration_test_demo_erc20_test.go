@@ -5,6 +5,8 @@ import (
 	"fmt"
 	erc20types "github.com/HarryBin2002/kairoschain/v12/x/erc20/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypeslegacy "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/ethereum/go-ethereum/common"
 	"math"
 	"strings"
@@ -60,18 +62,42 @@ func (suite *DemoTestSuite) Test_ERC20_RegisterIbcTokenPair() {
 	} else {
 		ibcDenomDisplay = strings.ToLower(transferCoin.Denom[1:])
 	}
-	proposalId := suite.CITS.TxFullRegisterCoinWithNewBankMetadata(proposer, ibcDenom, ibcDenomDisplay, uint32(fromChain.ChainConstantsConfig.GetBaseExponent()))
+
+	// the received coin is represented by its ERC20 precompile, so it can't be registered
+	content := erc20types.NewRegisterCoinProposal(
+		fmt.Sprintf("Register ERC-20 token pairs for %s", ibcDenom),
+		fmt.Sprintf("Register ERC-20 token pairs for %s", ibcDenom),
+		banktypes.Metadata{
+			Description: fmt.Sprintf("Denom metadata of %s", ibcDenom),
+			DenomUnits: []*banktypes.DenomUnit{
+				{
+					Denom:    ibcDenom,
+					Exponent: 0,
+				},
+				{
+					Denom:    ibcDenomDisplay,
+					Exponent: uint32(fromChain.ChainConstantsConfig.GetBaseExponent()),
+				},
+			},
+			Base:    ibcDenom,
+			Display: ibcDenomDisplay,
+			Name:    ibcDenomDisplay,
+			Symbol:  strings.ToUpper(ibcDenomDisplay),
+		},
+	)
+	msg, err := govtypeslegacy.NewMsgSubmitProposal(content, sdk.NewCoins(
+		sdk.NewCoin(suite.CITS.ChainConstantsConfig.GetMinDenom(), sdk.NewInt(int64(0.1*math.Pow10(18)))),
+	), proposer.GetCosmosAddress())
+	suite.Require().NoError(err)
+
+	_, _, err = suite.CITS.DeliverTx(suite.Ctx(), proposer, nil, msg)
+	suite.Require().ErrorContains(err, erc20types.ErrNativeCoinConversion.Error())
 	suite.Commit()
-	suite.Require().Equal(uint64(1), proposalId)
 
 	tokenPairs, err := suite.CITS.QueryClients.Erc20.TokenPairs(suite.Ctx(), &erc20types.QueryTokenPairsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().NotNil(tokenPairs)
-	suite.Require().Equal(1, len(tokenPairs.TokenPairs))
-
-	tokenPair := tokenPairs.TokenPairs[0]
-	suite.Equalf(ibcDenom, tokenPair.Denom, "token pair symbol %s must be equal to ibc denom %s", tokenPair.Denom, ibcDenom)
-	suite.assertContractCode(common.HexToAddress(tokenPair.Erc20Address))
+	suite.Require().Empty(tokenPairs.TokenPairs)
 }
 
 func (suite *DemoTestSuite) Test_ERC20_RegisterIbcTokenFromErc20() {
@@ -0,0 +1,86 @@
+package common
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+// revertSelector is the selector of the Solidity Error(string) type used to
+// encode revert reasons.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// LoadABI reads and parses the ABI JSON file from the embedded file system.
+func LoadABI(fs embed.FS, path string) (abi.ABI, error) {
+	bz, err := fs.ReadFile(path)
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("failed to load ABI %s: %w", path, err)
+	}
+
+	return abi.JSON(bytes.NewReader(bz))
+}
+
+// RevertWithReason returns the error message ABI encoded as a Solidity revert
+// reason together with the EVM revert error.
+func RevertWithReason(err error) ([]byte, error) {
+	stringType, _ := abi.NewType("string", "", nil)
+	packed, packErr := (abi.Arguments{{Type: stringType}}).Pack(err.Error())
+	if packErr != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, revertSelector...), packed...), vm.ErrExecutionReverted
+}
+
+// EmitEvent packs the event arguments following the event ABI and appends the
+// resulting log to the state of the transaction. Arguments must be provided in
+// the order of the event inputs.
+func (p Precompile) EmitEvent(ctx sdk.Context, stateDB *statedb.StateDB, name string, args ...interface{}) error {
+	event, ok := p.Events[name]
+	if !ok {
+		return fmt.Errorf(ErrUnknownEvent, name)
+	}
+
+	if len(args) != len(event.Inputs) {
+		return fmt.Errorf("event %s expects %d arguments, got %d", name, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.ID}
+	var nonIndexed []interface{}
+
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+
+		topic, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			return err
+		}
+		topics = append(topics, topic[0][0])
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AuthzKeeper defines the expected authz keeper interface of the precompiles
+// that execute messages on behalf of other accounts.
+type AuthzKeeper interface {
+	GetAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+	SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) error
+}
+
+// AcceptGrant checks that the granter has authorized the grantee to execute the
+// given message on its behalf. The grant is then updated or deleted following
+// the authorization response, as the x/authz module does for MsgExec.
+func AcceptGrant(
+	ctx sdk.Context,
+	authzKeeper AuthzKeeper,
+	grantee, granter common.Address,
+	msg sdk.Msg,
+) error {
+	msgType := sdk.MsgTypeURL(msg)
+
+	authorization, expiration := authzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), msgType)
+	if authorization == nil {
+		return fmt.Errorf(ErrAuthorizationNotFound, msgType, granter, grantee)
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return fmt.Errorf(ErrAuthorizationNotAccepted, msgType, granter, grantee)
+	}
+
+	if resp.Delete {
+		return authzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), msgType)
+	}
+
+	if resp.Updated != nil {
+		return authzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), resp.Updated, expiration)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package common
+
+const (
+	// ErrNotRunInEvm is raised when the precompile is not executed by the EVM of the x/evm module.
+	ErrNotRunInEvm = "precompile must be run by the EVM module state transition"
+	// ErrInvalidInput is raised when the call input is too short to contain a method selector.
+	ErrInvalidInput = "invalid input length, expected at least 4 bytes"
+	// ErrNonPayable is raised when value is sent to a non-payable method.
+	ErrNonPayable = "method %s is not payable"
+	// ErrUnknownEvent is raised when the event is not defined in the precompile ABI.
+	ErrUnknownEvent = "unknown event %s"
+	// ErrInvalidType is raised when an argument does not have the expected type.
+	ErrInvalidType = "invalid type for %s: expected %T, received %T"
+	// ErrInvalidNumberOfArgs is raised when a method receives an unexpected number of arguments.
+	ErrInvalidNumberOfArgs = "invalid number of arguments; expected %d; got: %d"
+	// ErrUnknownMethod is raised when the method is not handled by the precompile.
+	ErrUnknownMethod = "unknown method: %s"
+	// ErrAuthorizationNotFound is raised when the granter has not authorized the grantee to execute a message.
+	ErrAuthorizationNotFound = "authorization for %s from %s to %s does not exist or is expired"
+	// ErrAuthorizationNotAccepted is raised when the authorization does not accept the message.
+	ErrAuthorizationNotAccepted = "authorization for %s from %s to %s was not accepted"
+)
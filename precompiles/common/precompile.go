@@ -0,0 +1,163 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+// Handler executes the native logic of a precompile method. The context is a
+// branch of the transaction context that is discarded if the handler fails or
+// if the EVM reverts the call.
+type Handler func(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error)
+
+// Precompile is the base of the stateful precompiled contracts. It holds the
+// contract ABI and the gas configuration used to charge the native state
+// accesses performed by the contract methods.
+type Precompile struct {
+	abi.ABI
+
+	address              common.Address
+	KvGasConfig          storetypes.GasConfig
+	TransientKVGasConfig storetypes.GasConfig
+}
+
+// NewPrecompile creates a new Precompile base with the default SDK gas
+// configuration.
+func NewPrecompile(address common.Address, contractABI abi.ABI) Precompile {
+	return Precompile{
+		ABI:                  contractABI,
+		address:              address,
+		KvGasConfig:          storetypes.KVGasConfig(),
+		TransientKVGasConfig: storetypes.TransientGasConfig(),
+	}
+}
+
+// Address returns the address of the precompiled contract.
+func (p Precompile) Address() common.Address {
+	return p.address
+}
+
+// RequiredGas returns the base gas cost of a precompile call. Transactions pay
+// the flat write cost and view methods the flat read cost, in both cases plus a
+// cost per byte of the call input. The gas consumed by the store accesses is
+// charged on top of it during the execution.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+
+	argsLen := uint64(len(input[4:]))
+	if method.IsConstant() {
+		return p.KvGasConfig.ReadCostFlat + p.KvGasConfig.ReadCostPerByte*argsLen
+	}
+
+	return p.KvGasConfig.WriteCostFlat + p.KvGasConfig.WriteCostPerByte*argsLen
+}
+
+// Execute decodes the method called by the contract input and runs the given
+// handler with a gas metered branch of the transaction context. The gas
+// consumed by the handler is charged to the contract.
+//
+// Handler errors are returned as EVM reverts with the error message as revert
+// reason, so the caller keeps the unused gas. Running out of gas consumes all
+// the gas provided to the call.
+func (p Precompile) Execute(evm *vm.EVM, contract *vm.Contract, readOnly bool, handler Handler) ([]byte, error) {
+	method, args, err := p.UnpackInput(contract.Input)
+	if err != nil {
+		return RevertWithReason(err)
+	}
+
+	if readOnly && !method.IsConstant() {
+		return nil, vm.ErrWriteProtection
+	}
+
+	if !method.IsPayable() && contract.Value() != nil && contract.Value().Sign() > 0 {
+		return RevertWithReason(fmt.Errorf(ErrNonPayable, method.Name))
+	}
+
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, errors.New(ErrNotRunInEvm)
+	}
+
+	var (
+		bz       []byte
+		gasMeter = sdk.NewGasMeter(contract.Gas)
+	)
+
+	err = stateDB.RunPrecompile(func(ctx sdk.Context) (err error) {
+		ctx = ctx.
+			WithGasMeter(gasMeter).
+			WithKVGasConfig(p.KvGasConfig).
+			WithTransientKVGasConfig(p.TransientKVGasConfig)
+
+		defer HandleGasError(&err)()
+
+		bz, err = handler(ctx, stateDB, contract, method, args)
+		return err
+	})
+
+	if !contract.UseGas(gasMeter.GasConsumedToLimit()) || errors.Is(err, vm.ErrOutOfGas) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err != nil {
+		return RevertWithReason(err)
+	}
+
+	return bz, nil
+}
+
+// UnpackInput returns the ABI method and the unpacked arguments from the
+// call input.
+func (p Precompile) UnpackInput(input []byte) (*abi.Method, []interface{}, error) {
+	if len(input) < 4 {
+		return nil, nil, errors.New(ErrInvalidInput)
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return method, args, nil
+}
+
+// HandleGasError recovers from an out of gas panic raised by the SDK gas meter
+// and converts it to an EVM out of gas error. Any other panic is propagated.
+func HandleGasError(err *error) func() {
+	return func() {
+		if r := recover(); r != nil {
+			switch r.(type) {
+			case sdk.ErrorOutOfGas:
+				*err = vm.ErrOutOfGas
+			default:
+				panic(r)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Coin is the ABI representation of a Cosmos coin.
+type Coin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// NewCoin returns the ABI representation of the given coin.
+func NewCoin(coin sdk.Coin) Coin {
+	return Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.BigInt(),
+	}
+}
+
+// NewCoins returns the ABI representation of the given coins.
+func NewCoins(coins sdk.Coins) []Coin {
+	out := make([]Coin, len(coins))
+	for i, coin := range coins {
+		out[i] = NewCoin(coin)
+	}
+	return out
+}
+
+// DecCoin is the ABI representation of a Cosmos decimal coin. The amount is
+// the decimal value scaled by 10^precision.
+type DecCoin struct {
+	Denom     string
+	Amount    *big.Int
+	Precision uint8
+}
+
+// NewDecCoins returns the ABI representation of the given decimal coins.
+func NewDecCoins(coins sdk.DecCoins) []DecCoin {
+	out := make([]DecCoin, len(coins))
+	for i, coin := range coins {
+		out[i] = DecCoin{
+			Denom:     coin.Denom,
+			Amount:    coin.Amount.BigInt(),
+			Precision: uint8(sdk.Precision),
+		}
+	}
+	return out
+}
+
+// PageRequest is the ABI representation of the Cosmos pagination request.
+type PageRequest struct {
+	Key        []byte
+	Offset     uint64
+	Limit      uint64
+	CountTotal bool
+	Reverse    bool
+}
+
+// ParsePageRequest converts the tuple unpacked from the call input to a
+// PageRequest.
+func ParsePageRequest(arg interface{}) (PageRequest, error) {
+	value := reflect.ValueOf(arg)
+	pageRequestType := reflect.TypeOf(PageRequest{})
+	if !value.IsValid() || !value.Type().ConvertibleTo(pageRequestType) {
+		return PageRequest{}, fmt.Errorf(ErrInvalidType, "pageRequest", PageRequest{}, arg)
+	}
+
+	return value.Convert(pageRequestType).Interface().(PageRequest), nil
+}
+
+// ToQuery returns the Cosmos pagination request.
+func (p PageRequest) ToQuery() *query.PageRequest {
+	return &query.PageRequest{
+		Key:        p.Key,
+		Offset:     p.Offset,
+		Limit:      p.Limit,
+		CountTotal: p.CountTotal,
+		Reverse:    p.Reverse,
+	}
+}
+
+// PageResponse is the ABI representation of the Cosmos pagination response.
+type PageResponse struct {
+	NextKey []byte
+	Total   uint64
+}
+
+// NewPageResponse returns the ABI representation of the given pagination
+// response. A nil response is returned as an empty one.
+func NewPageResponse(res *query.PageResponse) PageResponse {
+	if res == nil {
+		return PageResponse{NextKey: []byte{}}
+	}
+
+	nextKey := res.NextKey
+	if nextKey == nil {
+		nextKey = []byte{}
+	}
+
+	return PageResponse{
+		NextKey: nextKey,
+		Total:   res.Total,
+	}
+}
@@ -0,0 +1,101 @@
+package distribution
+
+import (
+	"embed"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+// PrecompileAddress is the address of the distribution precompile.
+const PrecompileAddress = "0x0000000000000000000000000000000000000801"
+
+var _ vm.PrecompiledContract = Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the distribution precompiled contract. It withdraws the
+// staking rewards and the validator commission on behalf of the caller, or of
+// an account that has granted the caller the x/authz authorization to do so.
+type Precompile struct {
+	cmn.Precompile
+
+	stakingKeeper      StakingKeeper
+	distributionKeeper distrkeeper.Keeper
+	authzKeeper        cmn.AuthzKeeper
+}
+
+// LoadABI loads the distribution precompile ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates the distribution precompile.
+func NewPrecompile(
+	stakingKeeper StakingKeeper,
+	distributionKeeper distrkeeper.Keeper,
+	authzKeeper cmn.AuthzKeeper,
+) (Precompile, error) {
+	newABI, err := LoadABI()
+	if err != nil {
+		return Precompile{}, err
+	}
+
+	return Precompile{
+		Precompile:         cmn.NewPrecompile(common.HexToAddress(PrecompileAddress), newABI),
+		stakingKeeper:      stakingKeeper,
+		distributionKeeper: distributionKeeper,
+		authzKeeper:        authzKeeper,
+	}, nil
+}
+
+// Run executes the precompiled contract distribution methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.Execute(evm, contract, readOnly, p.handle)
+}
+
+// handle dispatches the call to the handler of the method.
+func (p Precompile) handle(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// Distribution transactions
+	case SetWithdrawAddressMethod:
+		return p.SetWithdrawAddress(ctx, stateDB, contract, method, args)
+	case WithdrawDelegatorRewardsMethod:
+		return p.WithdrawDelegatorRewards(ctx, stateDB, contract, method, args)
+	case WithdrawValidatorCommissionMethod:
+		return p.WithdrawValidatorCommission(ctx, stateDB, contract, method, args)
+	case ClaimRewardsMethod:
+		return p.ClaimRewards(ctx, stateDB, contract, method, args)
+	// Distribution queries
+	case DelegationRewardsMethod:
+		return p.DelegationRewards(ctx, method, args)
+	case DelegationTotalRewardsMethod:
+		return p.DelegationTotalRewards(ctx, method, args)
+	case DelegatorValidatorsMethod:
+		return p.DelegatorValidators(ctx, method, args)
+	case DelegatorWithdrawAddressMethod:
+		return p.DelegatorWithdrawAddress(ctx, method, args)
+	case ValidatorCommissionMethod:
+		return p.ValidatorCommission(ctx, method, args)
+	case ValidatorOutstandingRewardsMethod:
+		return p.ValidatorOutstandingRewards(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
@@ -0,0 +1,135 @@
+package distribution_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/precompiles/distribution"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+)
+
+func (suite *PrecompileTestSuite) TestWithdrawDelegatorRewards() {
+	var spender common.Address
+
+	testCases := []struct {
+		name string
+		test func()
+	}{
+		{
+			"delegator withdraws the rewards and the event is emitted",
+			func() {
+				balance := suite.balance(suite.address)
+
+				res := suite.execute(suite.address, distribution.WithdrawDelegatorRewardsMethod, suite.address, suite.validator.OperatorAddress)
+				suite.Require().False(res.Failed(), res.VmError)
+				suite.Require().Equal(balance+90, suite.balance(suite.address))
+
+				out, err := suite.precompile.Unpack(distribution.WithdrawDelegatorRewardsMethod, res.Ret)
+				suite.Require().NoError(err)
+				coins := abi.ConvertType(out[0], new([]cmn.Coin)).(*[]cmn.Coin)
+				suite.Require().Equal([]cmn.Coin{{Denom: constants.BaseDenom, Amount: big.NewInt(90)}}, *coins)
+
+				suite.Require().Len(res.Logs, 1)
+				suite.Require().Equal(suite.precompile.Events[distribution.EventTypeWithdrawDelegatorRewards].ID.String(), res.Logs[0].Topics[0])
+				suite.Require().Equal(common.BigToHash(big.NewInt(90)).Bytes(), res.Logs[0].Data)
+			},
+		},
+		{
+			"rewards are sent to the withdraw address",
+			func() {
+				withdrawer := utiltx.GenerateAddress()
+				suite.call(suite.address, distribution.SetWithdrawAddressMethod, suite.address, withdrawer.Hex())
+
+				out := suite.call(suite.address, distribution.DelegatorWithdrawAddressMethod, suite.address)
+				suite.Require().Equal(sdk.AccAddress(withdrawer.Bytes()).String(), out[0])
+
+				suite.call(suite.address, distribution.WithdrawDelegatorRewardsMethod, suite.address, suite.validator.OperatorAddress)
+				suite.Require().Equal(int64(90), suite.balance(withdrawer))
+			},
+		},
+		{
+			"spender cannot withdraw without authorization",
+			func() {
+				suite.callFails(spender, distribution.WithdrawDelegatorRewardsMethod, suite.address, suite.validator.OperatorAddress)
+				suite.callFails(spender, distribution.SetWithdrawAddressMethod, suite.address, spender.Hex())
+			},
+		},
+		{
+			"spender withdraws with an authz grant",
+			func() {
+				balance := suite.balance(suite.address)
+				authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}))
+				suite.Require().NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, spender.Bytes(), suite.address.Bytes(), authorization, nil))
+
+				suite.call(spender, distribution.WithdrawDelegatorRewardsMethod, suite.address, suite.validator.OperatorAddress)
+				suite.Require().Equal(balance+90, suite.balance(suite.address))
+			},
+		},
+		{
+			"delegator claims the rewards of all the delegations",
+			func() {
+				balance := suite.balance(suite.address)
+
+				suite.callFails(suite.address, distribution.ClaimRewardsMethod, suite.address, uint32(0))
+				suite.call(suite.address, distribution.ClaimRewardsMethod, suite.address, uint32(10))
+				suite.Require().Equal(balance+90, suite.balance(suite.address))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.allocateRewards(100)
+			spender = utiltx.GenerateAddress()
+			tc.test()
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestWithdrawValidatorCommission() {
+	suite.SetupTest()
+	suite.allocateRewards(100)
+
+	operator := common.BytesToAddress(suite.validator.GetOperator())
+	suite.callFails(suite.address, distribution.WithdrawValidatorCommissionMethod, suite.validator.OperatorAddress)
+
+	out := suite.call(suite.address, distribution.ValidatorCommissionMethod, suite.validator.OperatorAddress)
+	commission := abi.ConvertType(out[0], new([]cmn.DecCoin)).(*[]cmn.DecCoin)
+	suite.Require().Len(*commission, 1)
+	suite.Require().Equal(sdk.NewDec(10).BigInt(), (*commission)[0].Amount)
+	suite.Require().Equal(uint8(sdk.Precision), (*commission)[0].Precision)
+
+	suite.call(operator, distribution.WithdrawValidatorCommissionMethod, suite.validator.OperatorAddress)
+	suite.Require().Equal(int64(10), suite.balance(operator))
+}
+
+func (suite *PrecompileTestSuite) TestRewardQueries() {
+	suite.SetupTest()
+	suite.allocateRewards(100)
+
+	out := suite.call(suite.address, distribution.DelegationRewardsMethod, suite.address, suite.validator.OperatorAddress)
+	rewards := abi.ConvertType(out[0], new([]cmn.DecCoin)).(*[]cmn.DecCoin)
+	suite.Require().Equal([]cmn.DecCoin{{Denom: constants.BaseDenom, Amount: sdk.NewDec(90).BigInt(), Precision: uint8(sdk.Precision)}}, *rewards)
+
+	out = suite.call(suite.address, distribution.DelegationTotalRewardsMethod, suite.address)
+	delegationRewards := abi.ConvertType(out[0], new([]distribution.DelegationDelegatorReward)).(*[]distribution.DelegationDelegatorReward)
+	total := abi.ConvertType(out[1], new([]cmn.DecCoin)).(*[]cmn.DecCoin)
+	suite.Require().Len(*delegationRewards, 1)
+	suite.Require().Equal(suite.validator.OperatorAddress, (*delegationRewards)[0].ValidatorAddress)
+	suite.Require().Equal(*rewards, *total)
+
+	out = suite.call(suite.address, distribution.DelegatorValidatorsMethod, suite.address)
+	suite.Require().Equal([]string{suite.validator.OperatorAddress}, out[0])
+
+	out = suite.call(suite.address, distribution.ValidatorOutstandingRewardsMethod, suite.validator.OperatorAddress)
+	outstanding := abi.ConvertType(out[0], new([]cmn.DecCoin)).(*[]cmn.DecCoin)
+	suite.Require().Equal(sdk.NewDec(100).BigInt(), (*outstanding)[0].Amount)
+}
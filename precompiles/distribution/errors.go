@@ -0,0 +1,12 @@
+package distribution
+
+const (
+	// ErrInvalidDelegator is raised when the delegator address is the zero address.
+	ErrInvalidDelegator = "invalid delegator address: %s"
+	// ErrInvalidValidator is raised when the validator address is not a valid bech32 operator address.
+	ErrInvalidValidator = "invalid validator address %s: %s"
+	// ErrInvalidWithdrawer is raised when the withdrawer address is neither a bech32 nor a hex address.
+	ErrInvalidWithdrawer = "invalid withdrawer address %s: %s"
+	// ErrInvalidMaxRetrieve is raised when the maximum number of delegations to claim from is zero.
+	ErrInvalidMaxRetrieve = "maximum number of delegations to claim rewards from must be positive"
+)
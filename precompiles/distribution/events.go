@@ -0,0 +1,68 @@
+package distribution
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+const (
+	// EventTypeSetWithdrawAddress defines the event type for the distribution SetWithdrawerAddress event.
+	EventTypeSetWithdrawAddress = "SetWithdrawerAddress"
+	// EventTypeWithdrawDelegatorRewards defines the event type for the distribution WithdrawDelegatorRewards event.
+	EventTypeWithdrawDelegatorRewards = "WithdrawDelegatorRewards"
+	// EventTypeWithdrawValidatorCommission defines the event type for the distribution WithdrawValidatorCommission event.
+	EventTypeWithdrawValidatorCommission = "WithdrawValidatorCommission"
+	// EventTypeClaimRewards defines the event type for the distribution ClaimRewards event.
+	EventTypeClaimRewards = "ClaimRewards"
+)
+
+// EmitSetWithdrawAddressEvent emits the distribution SetWithdrawerAddress event.
+func (p Precompile) EmitSetWithdrawAddressEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	delegator common.Address,
+	withdrawer sdk.AccAddress,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeSetWithdrawAddress, delegator, withdrawer.String())
+}
+
+// EmitWithdrawDelegatorRewardsEvent emits the distribution WithdrawDelegatorRewards
+// event with the amount withdrawn in the bond denomination.
+func (p Precompile) EmitWithdrawDelegatorRewardsEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	delegator common.Address,
+	validator sdk.ValAddress,
+	amount *big.Int,
+) error {
+	return p.EmitEvent(
+		ctx, stateDB, EventTypeWithdrawDelegatorRewards,
+		delegator, common.BytesToAddress(validator), amount,
+	)
+}
+
+// EmitWithdrawValidatorCommissionEvent emits the distribution WithdrawValidatorCommission
+// event with the commission withdrawn in the bond denomination.
+func (p Precompile) EmitWithdrawValidatorCommissionEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	validator sdk.ValAddress,
+	commission *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeWithdrawValidatorCommission, validator.String(), commission)
+}
+
+// EmitClaimRewardsEvent emits the distribution ClaimRewards event with the total
+// amount claimed in the bond denomination.
+func (p Precompile) EmitClaimRewardsEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	delegator common.Address,
+	amount *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeClaimRewards, delegator, amount)
+}
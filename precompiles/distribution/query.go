@@ -0,0 +1,177 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+)
+
+const (
+	// DelegationRewardsMethod defines the ABI method name for the distribution
+	// delegationRewards query.
+	DelegationRewardsMethod = "delegationRewards"
+	// DelegationTotalRewardsMethod defines the ABI method name for the distribution
+	// delegationTotalRewards query.
+	DelegationTotalRewardsMethod = "delegationTotalRewards"
+	// DelegatorValidatorsMethod defines the ABI method name for the distribution
+	// delegatorValidators query.
+	DelegatorValidatorsMethod = "delegatorValidators"
+	// DelegatorWithdrawAddressMethod defines the ABI method name for the distribution
+	// delegatorWithdrawAddress query.
+	DelegatorWithdrawAddressMethod = "delegatorWithdrawAddress"
+	// ValidatorCommissionMethod defines the ABI method name for the distribution
+	// validatorCommission query.
+	ValidatorCommissionMethod = "validatorCommission"
+	// ValidatorOutstandingRewardsMethod defines the ABI method name for the distribution
+	// validatorOutstandingRewards query.
+	ValidatorOutstandingRewardsMethod = "validatorOutstandingRewards"
+)
+
+// DelegationRewards returns the rewards accrued by the delegation from the
+// delegator to the validator.
+func (p Precompile) DelegationRewards(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validator, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).DelegationRewards(
+		sdk.WrapSDKContext(ctx),
+		&distributiontypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+			ValidatorAddress: validator.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewDecCoins(res.Rewards))
+}
+
+// DelegationTotalRewards returns the rewards accrued by each delegation of the
+// delegator, together with their total.
+func (p Precompile) DelegationTotalRewards(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, err := parseDelegatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).DelegationTotalRewards(
+		sdk.WrapSDKContext(ctx),
+		&distributiontypes.QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDelegationDelegatorRewards(res.Rewards), cmn.NewDecCoins(res.Total))
+}
+
+// DelegatorValidators returns the operator addresses of the validators the
+// delegator is bonded to.
+func (p Precompile) DelegatorValidators(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, err := parseDelegatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).DelegatorValidators(
+		sdk.WrapSDKContext(ctx),
+		&distributiontypes.QueryDelegatorValidatorsRequest{
+			DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := res.Validators
+	if validators == nil {
+		validators = []string{}
+	}
+
+	return method.Outputs.Pack(validators)
+}
+
+// DelegatorWithdrawAddress returns the address that receives the rewards of the
+// delegator.
+func (p Precompile) DelegatorWithdrawAddress(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, err := parseDelegatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawer := p.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delegator.Bytes())
+	return method.Outputs.Pack(withdrawer.String())
+}
+
+// ValidatorCommission returns the commission accumulated by the validator.
+func (p Precompile) ValidatorCommission(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validator, err := parseValidatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).ValidatorCommission(
+		sdk.WrapSDKContext(ctx),
+		&distributiontypes.QueryValidatorCommissionRequest{
+			ValidatorAddress: validator.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewDecCoins(res.Commission.Commission))
+}
+
+// ValidatorOutstandingRewards returns the rewards of the validator that have
+// not been withdrawn yet by its delegators and its operator.
+func (p Precompile) ValidatorOutstandingRewards(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validator, err := parseValidatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).ValidatorOutstandingRewards(
+		sdk.WrapSDKContext(ctx),
+		&distributiontypes.QueryValidatorOutstandingRewardsRequest{
+			ValidatorAddress: validator.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewDecCoins(res.Rewards.Rewards))
+}
@@ -0,0 +1,144 @@
+package distribution_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/HarryBin2002/kairoschain/v12/app"
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	"github.com/HarryBin2002/kairoschain/v12/precompiles/distribution"
+	"github.com/HarryBin2002/kairoschain/v12/testutil"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	app        *app.Kairoschain
+	address    common.Address
+	validator  stakingtypes.Validator
+	precompile distribution.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	chainID := constants.TestnetFullChainId
+	suite.app = app.Setup(false, nil, chainID)
+	suite.ctx = suite.app.BaseApp.NewContext(false, testutil.NewHeader(
+		1, time.Now().UTC(), chainID, nil, nil, nil,
+	))
+
+	stakingParams := suite.app.StakingKeeper.GetParams(suite.ctx)
+	stakingParams.BondDenom = constants.BaseDenom
+	suite.Require().NoError(suite.app.StakingKeeper.SetParams(suite.ctx, stakingParams))
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.EvmDenom = constants.BaseDenom
+	evmParams.ActivePrecompiles = []string{distribution.PrecompileAddress}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+
+	precompile, found := suite.app.EvmKeeper.GetPrecompile(common.HexToAddress(distribution.PrecompileAddress))
+	suite.Require().True(found)
+	suite.precompile = precompile.(distribution.Precompile)
+
+	// validator with a 10% commission, operated by a fresh account
+	validator, err := stakingtypes.NewValidator(
+		sdk.ValAddress(utiltx.GenerateAddress().Bytes()), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{},
+	)
+	suite.Require().NoError(err)
+	validator.Commission = stakingtypes.NewCommission(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2),
+	)
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.Require().NoError(suite.app.StakingKeeper.Hooks().AfterValidatorCreated(suite.ctx, validator.GetOperator()))
+
+	// the block proposer is needed to set the EVM coinbase
+	consAddress, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	header := suite.ctx.BlockHeader()
+	header.ProposerAddress = consAddress
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+
+	// the address is the only delegator of the validator
+	suite.address = utiltx.GenerateAddress()
+	suite.fund(suite.address, 1_000_000)
+	_, err = suite.app.StakingKeeper.Delegate(
+		suite.ctx, suite.address.Bytes(), sdkmath.NewInt(1_000), stakingtypes.Unbonded, validator, true,
+	)
+	suite.Require().NoError(err)
+
+	suite.validator, found = suite.app.StakingKeeper.GetValidator(suite.ctx, validator.GetOperator())
+	suite.Require().True(found)
+}
+
+// fund mints the given amount of the bond denomination to the address.
+func (suite *PrecompileTestSuite) fund(address common.Address, amount int64) {
+	coins := sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(amount)))
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address.Bytes(), coins))
+}
+
+// allocateRewards distributes the given amount of the bond denomination to the
+// validator and its delegators on the next block, as delegations do not earn
+// rewards on their creation height.
+func (suite *PrecompileTestSuite) allocateRewards(amount int64) {
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	coins := sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(amount)))
+	suite.Require().NoError(testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, distributiontypes.ModuleName, coins))
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, suite.validator, sdk.NewDecCoinsFromCoins(coins...))
+}
+
+// balance returns the bond denomination balance of the address.
+func (suite *PrecompileTestSuite) balance(address common.Address) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, address.Bytes(), constants.BaseDenom).Amount.Int64()
+}
+
+// call executes the precompile method from the given address and returns the
+// unpacked outputs. It fails the test if the call reverts.
+func (suite *PrecompileTestSuite) call(from common.Address, method string, args ...interface{}) []interface{} {
+	res := suite.execute(from, method, args...)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.precompile.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return out
+}
+
+// callFails executes the precompile method from the given address and checks
+// that the call reverts.
+func (suite *PrecompileTestSuite) callFails(from common.Address, method string, args ...interface{}) {
+	res := suite.execute(from, method, args...)
+	suite.Require().True(res.Failed())
+}
+
+// execute applies a message calling the precompile method from the given address.
+func (suite *PrecompileTestSuite) execute(from common.Address, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+	input, err := suite.precompile.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	to := suite.precompile.Address()
+	msg := ethtypes.NewMessage(
+		from, &to, suite.app.EvmKeeper.GetNonce(suite.ctx, from),
+		big.NewInt(0), 1_000_000, big.NewInt(0), nil, nil, input, nil, true,
+	)
+
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	return res
+}
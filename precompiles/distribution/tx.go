@@ -0,0 +1,200 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+const (
+	// SetWithdrawAddressMethod defines the ABI method name for the distribution
+	// setWithdrawAddress transaction.
+	SetWithdrawAddressMethod = "setWithdrawAddress"
+	// WithdrawDelegatorRewardsMethod defines the ABI method name for the distribution
+	// withdrawDelegatorRewards transaction.
+	WithdrawDelegatorRewardsMethod = "withdrawDelegatorRewards"
+	// WithdrawValidatorCommissionMethod defines the ABI method name for the distribution
+	// withdrawValidatorCommission transaction.
+	WithdrawValidatorCommissionMethod = "withdrawValidatorCommission"
+	// ClaimRewardsMethod defines the ABI method name for the distribution claimRewards transaction.
+	ClaimRewardsMethod = "claimRewards"
+)
+
+// SetWithdrawAddress sets the address that receives the rewards of the delegator.
+func (p Precompile) SetWithdrawAddress(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, withdrawer, err := parseSetWithdrawAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &distributiontypes.MsgSetWithdrawAddress{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		WithdrawAddress:  withdrawer.String(),
+	}
+
+	if err := p.authorize(ctx, contract.CallerAddress, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := distrkeeper.NewMsgServerImpl(p.distributionKeeper).SetWithdrawAddress(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSetWithdrawAddressEvent(ctx, stateDB, delegator, withdrawer); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// WithdrawDelegatorRewards withdraws the rewards of the delegation from the
+// delegator to the validator. It returns the withdrawn coins.
+func (p Precompile) WithdrawDelegatorRewards(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validator, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := p.withdrawDelegatorRewards(ctx, contract.CallerAddress, delegator, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	bondAmount := amount.AmountOf(p.stakingKeeper.BondDenom(ctx)).BigInt()
+	if err := p.EmitWithdrawDelegatorRewardsEvent(ctx, stateDB, delegator, validator, bondAmount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoins(amount))
+}
+
+// WithdrawValidatorCommission withdraws the accumulated commission of the
+// validator. The caller must be the validator operator, or have been granted
+// the authorization by the operator. It returns the withdrawn coins.
+func (p Precompile) WithdrawValidatorCommission(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validator, err := parseValidatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &distributiontypes.MsgWithdrawValidatorCommission{
+		ValidatorAddress: validator.String(),
+	}
+
+	if err := p.authorize(ctx, contract.CallerAddress, common.BytesToAddress(validator), msg); err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewMsgServerImpl(p.distributionKeeper).WithdrawValidatorCommission(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	commission := res.Amount.AmountOf(p.stakingKeeper.BondDenom(ctx)).BigInt()
+	if err := p.EmitWithdrawValidatorCommissionEvent(ctx, stateDB, validator, commission); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoins(res.Amount))
+}
+
+// ClaimRewards withdraws the rewards of the delegator from at most maxRetrieve
+// of its delegations, which allows contracts to compound their rewards in a
+// single call.
+func (p Precompile) ClaimRewards(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, maxRetrieve, err := parseClaimRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the validators first, as withdrawing while iterating could modify the store
+	var validators []sdk.ValAddress
+	p.stakingKeeper.IterateDelegations(
+		ctx, delegator.Bytes(),
+		func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			validators = append(validators, delegation.GetValidatorAddr())
+			return uint32(len(validators)) >= maxRetrieve
+		},
+	)
+
+	var rewards sdk.Coins
+	for _, validator := range validators {
+		amount, err := p.withdrawDelegatorRewards(ctx, contract.CallerAddress, delegator, validator)
+		if err != nil {
+			return nil, err
+		}
+		rewards = rewards.Add(amount...)
+	}
+
+	bondAmount := rewards.AmountOf(p.stakingKeeper.BondDenom(ctx)).BigInt()
+	if err := p.EmitClaimRewardsEvent(ctx, stateDB, delegator, bondAmount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// withdrawDelegatorRewards withdraws the rewards of a single delegation once the
+// caller is authorized to act on behalf of the delegator.
+func (p Precompile) withdrawDelegatorRewards(
+	ctx sdk.Context,
+	caller, delegator common.Address,
+	validator sdk.ValAddress,
+) (sdk.Coins, error) {
+	msg := &distributiontypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator.String(),
+	}
+
+	if err := p.authorize(ctx, caller, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewMsgServerImpl(p.distributionKeeper).WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Amount, nil
+}
+
+// authorize checks that the caller is allowed to execute the message on behalf
+// of the owner, either because it is the owner or through an x/authz grant.
+func (p Precompile) authorize(ctx sdk.Context, caller, owner common.Address, msg sdk.Msg) error {
+	if caller == owner {
+		return nil
+	}
+
+	return cmn.AcceptGrant(ctx, p.authzKeeper, caller, owner, msg)
+}
@@ -0,0 +1,163 @@
+package distribution
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+)
+
+// StakingKeeper defines the expected staking keeper interface of the
+// distribution precompile.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool))
+}
+
+// DelegationDelegatorReward is the ABI representation of the rewards of a
+// delegation.
+type DelegationDelegatorReward struct {
+	ValidatorAddress string
+	Reward           []cmn.DecCoin
+}
+
+// NewDelegationDelegatorRewards returns the ABI representation of the rewards
+// of the delegations.
+func NewDelegationDelegatorRewards(rewards []distributiontypes.DelegationDelegatorReward) []DelegationDelegatorReward {
+	out := make([]DelegationDelegatorReward, len(rewards))
+	for i, reward := range rewards {
+		out[i] = DelegationDelegatorReward{
+			ValidatorAddress: reward.ValidatorAddress,
+			Reward:           cmn.NewDecCoins(reward.Reward),
+		}
+	}
+	return out
+}
+
+// parseDelegator returns the non-zero delegator address argument at the given position.
+func parseDelegator(args []interface{}, i int) (common.Address, error) {
+	delegator, ok := args[i].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "delegatorAddress", common.Address{}, args[i])
+	}
+
+	if delegator == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidDelegator, delegator)
+	}
+
+	return delegator, nil
+}
+
+// parseValidator returns the validator operator address argument at the given position.
+func parseValidator(args []interface{}, i int) (sdk.ValAddress, error) {
+	validator, ok := args[i].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "validatorAddress", "", args[i])
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidValidator, validator, err)
+	}
+
+	return valAddr, nil
+}
+
+// parseSetWithdrawAddressArgs parses the arguments of the setWithdrawAddress
+// method. The withdrawer can be given either as a bech32 or a hex address.
+func parseSetWithdrawAddressArgs(args []interface{}) (common.Address, sdk.AccAddress, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegator, err := parseDelegator(args, 0)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	withdrawer, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "withdrawerAddress", "", args[1])
+	}
+
+	if strings.HasPrefix(withdrawer, "0x") {
+		if !common.IsHexAddress(withdrawer) {
+			return common.Address{}, nil, fmt.Errorf(ErrInvalidWithdrawer, withdrawer, "invalid hex address")
+		}
+		return delegator, common.HexToAddress(withdrawer).Bytes(), nil
+	}
+
+	withdrawerAddr, err := sdk.AccAddressFromBech32(withdrawer)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidWithdrawer, withdrawer, err)
+	}
+
+	return delegator, withdrawerAddr, nil
+}
+
+// parseDelegationArgs parses the arguments of the withdrawDelegatorRewards and
+// delegationRewards methods.
+func parseDelegationArgs(args []interface{}) (common.Address, sdk.ValAddress, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegator, err := parseDelegator(args, 0)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	validator, err := parseValidator(args, 1)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return delegator, validator, nil
+}
+
+// parseClaimRewardsArgs parses the arguments of the claimRewards method.
+func parseClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
+	if len(args) != 2 {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegator, err := parseDelegator(args, 0)
+	if err != nil {
+		return common.Address{}, 0, err
+	}
+
+	maxRetrieve, ok := args[1].(uint32)
+	if !ok {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidType, "maxRetrieve", uint32(0), args[1])
+	}
+
+	if maxRetrieve == 0 {
+		return common.Address{}, 0, errors.New(ErrInvalidMaxRetrieve)
+	}
+
+	return delegator, maxRetrieve, nil
+}
+
+// parseDelegatorArgs parses the arguments of the queries by delegator.
+func parseDelegatorArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return parseDelegator(args, 0)
+}
+
+// parseValidatorArgs parses the arguments of the methods by validator.
+func parseValidatorArgs(args []interface{}) (sdk.ValAddress, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return parseValidator(args, 0)
+}
@@ -0,0 +1,101 @@
+package erc20
+
+import (
+	"embed"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	erc20types "github.com/HarryBin2002/kairoschain/v12/x/erc20/types"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+var _ vm.PrecompiledContract = Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the ERC20 precompiled contract of a Cosmos coin
+// denomination. Balances and total supply are read from and written to the
+// x/bank module directly, so the coin has a single representation.
+type Precompile struct {
+	cmn.Precompile
+
+	denom           string
+	bankKeeper      BankKeeper
+	allowanceKeeper AllowanceKeeper
+}
+
+// LoadABI loads the ERC20 precompile ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates the ERC20 precompile of the given denomination. The
+// precompile is deployed at the address returned by ERC20PrecompileAddress.
+func NewPrecompile(
+	denom string,
+	bankKeeper BankKeeper,
+	allowanceKeeper AllowanceKeeper,
+) (Precompile, error) {
+	newABI, err := LoadABI()
+	if err != nil {
+		return Precompile{}, err
+	}
+
+	return Precompile{
+		Precompile:      cmn.NewPrecompile(erc20types.ERC20PrecompileAddress(denom), newABI),
+		denom:           denom,
+		bankKeeper:      bankKeeper,
+		allowanceKeeper: allowanceKeeper,
+	}, nil
+}
+
+// Denom returns the Cosmos coin denomination of the precompile.
+func (p Precompile) Denom() string {
+	return p.denom
+}
+
+// Run executes the precompiled contract ERC20 methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.Execute(evm, contract, readOnly, p.handle)
+}
+
+// handle dispatches the call to the handler of the method.
+func (p Precompile) handle(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// ERC20 transactions
+	case TransferMethod:
+		return p.Transfer(ctx, stateDB, contract, method, args)
+	case TransferFromMethod:
+		return p.TransferFrom(ctx, stateDB, contract, method, args)
+	case ApproveMethod:
+		return p.Approve(ctx, stateDB, contract, method, args)
+	// ERC20 queries
+	case NameMethod:
+		return p.Name(ctx, method)
+	case SymbolMethod:
+		return p.Symbol(ctx, method)
+	case DecimalsMethod:
+		return p.Decimals(ctx, method)
+	case TotalSupplyMethod:
+		return p.TotalSupply(ctx, method)
+	case BalanceOfMethod:
+		return p.BalanceOf(ctx, method, args)
+	case AllowanceMethod:
+		return p.Allowance(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
@@ -0,0 +1,14 @@
+package erc20
+
+const (
+	// ErrTransferToZeroAddress is raised when the recipient of a transfer is the zero address.
+	ErrTransferToZeroAddress = "ERC20: transfer to the zero address"
+	// ErrApproveToZeroAddress is raised when the spender of an approval is the zero address.
+	ErrApproveToZeroAddress = "ERC20: approve to the zero address"
+	// ErrInsufficientAllowance is raised when the allowance of the spender is lower than the transferred amount.
+	ErrInsufficientAllowance = "ERC20: insufficient allowance"
+	// ErrBlockedAddress is raised when the recipient is not allowed to receive funds.
+	ErrBlockedAddress = "ERC20: %s is not allowed to receive funds"
+	// ErrDecimalsOverflow is raised when the exponent of the coin metadata does not fit in an uint8.
+	ErrDecimalsOverflow = "ERC20: decimals overflow for %s: %d"
+)
@@ -0,0 +1,27 @@
+package erc20
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+const (
+	// EventTypeTransfer defines the event type for the ERC20 Transfer event.
+	EventTypeTransfer = "Transfer"
+	// EventTypeApproval defines the event type for the ERC20 Approval event.
+	EventTypeApproval = "Approval"
+)
+
+// EmitTransferEvent emits the ERC20 Transfer event.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB *statedb.StateDB, from, to common.Address, value *big.Int) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeTransfer, from, to, value)
+}
+
+// EmitApprovalEvent emits the ERC20 Approval event.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB *statedb.StateDB, owner, spender common.Address, value *big.Int) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeApproval, owner, spender, value)
+}
@@ -0,0 +1,99 @@
+package erc20
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// NameMethod defines the ABI method name for the ERC20 name query.
+	NameMethod = "name"
+	// SymbolMethod defines the ABI method name for the ERC20 symbol query.
+	SymbolMethod = "symbol"
+	// DecimalsMethod defines the ABI method name for the ERC20 decimals query.
+	DecimalsMethod = "decimals"
+	// TotalSupplyMethod defines the ABI method name for the ERC20 totalSupply query.
+	TotalSupplyMethod = "totalSupply"
+	// BalanceOfMethod defines the ABI method name for the ERC20 balanceOf query.
+	BalanceOfMethod = "balanceOf"
+	// AllowanceMethod defines the ABI method name for the ERC20 allowance query.
+	AllowanceMethod = "allowance"
+)
+
+// Name returns the name of the coin from the bank metadata, or the denomination
+// if the coin has no metadata.
+func (p Precompile) Name(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	name := p.denom
+	if metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.denom); found && metadata.Name != "" {
+		name = metadata.Name
+	}
+
+	return method.Outputs.Pack(name)
+}
+
+// Symbol returns the symbol of the coin from the bank metadata, or the
+// denomination if the coin has no metadata.
+func (p Precompile) Symbol(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	symbol := p.denom
+	if metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.denom); found && metadata.Symbol != "" {
+		symbol = metadata.Symbol
+	}
+
+	return method.Outputs.Pack(symbol)
+}
+
+// Decimals returns the exponent of the display unit of the coin from the bank
+// metadata. The exponent of the last unit is used if the display unit is not
+// found, and zero if the coin has no metadata.
+func (p Precompile) Decimals(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	var exponent uint32
+
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.denom)
+	if found && len(metadata.DenomUnits) > 0 {
+		exponent = metadata.DenomUnits[len(metadata.DenomUnits)-1].Exponent
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				exponent = unit.Exponent
+				break
+			}
+		}
+	}
+
+	if exponent > math.MaxUint8 {
+		return nil, fmt.Errorf(ErrDecimalsOverflow, p.denom, exponent)
+	}
+
+	return method.Outputs.Pack(uint8(exponent))
+}
+
+// TotalSupply returns the bank supply of the coin.
+func (p Precompile) TotalSupply(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	supply := p.bankKeeper.GetSupply(ctx, p.denom)
+	return method.Outputs.Pack(supply.Amount.BigInt())
+}
+
+// BalanceOf returns the bank balance of the coin for the given account.
+func (p Precompile) BalanceOf(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	account, err := parseBalanceOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := p.bankKeeper.GetBalance(ctx, account.Bytes(), p.denom)
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+// Allowance returns the amount of coins that the spender is allowed to transfer
+// on behalf of the owner.
+func (p Precompile) Allowance(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	owner, spender, err := parseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := p.allowanceKeeper.GetAllowance(ctx, p.denom, owner, spender)
+	return method.Outputs.Pack(allowance)
+}
@@ -0,0 +1,146 @@
+package erc20
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+const (
+	// TransferMethod defines the ABI method name for the ERC20 transfer transaction.
+	TransferMethod = "transfer"
+	// TransferFromMethod defines the ABI method name for the ERC20 transferFrom transaction.
+	TransferFromMethod = "transferFrom"
+	// ApproveMethod defines the ABI method name for the ERC20 approve transaction.
+	ApproveMethod = "approve"
+)
+
+// Transfer sends the given amount of coins from the caller to the recipient.
+func (p Precompile) Transfer(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, amount, err := parseTransferArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.transfer(ctx, stateDB, contract.CallerAddress, to, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// TransferFrom sends the given amount of coins from the owner to the recipient
+// using the allowance granted by the owner to the caller. No allowance is
+// needed if the caller is the owner, and the maximum uint256 allowance is never
+// decreased.
+func (p Precompile) TransferFrom(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, amount, err := parseTransferFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	spender := contract.CallerAddress
+	if spender != from {
+		if err := p.spendAllowance(ctx, stateDB, from, spender, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.transfer(ctx, stateDB, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Approve sets the amount of coins that the spender is allowed to transfer on
+// behalf of the caller.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, amount, err := parseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.approve(ctx, stateDB, contract.CallerAddress, spender, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// transfer sends the coins through the bank keeper and emits the Transfer event.
+func (p Precompile) transfer(ctx sdk.Context, stateDB *statedb.StateDB, from, to common.Address, amount *big.Int) error {
+	if to == (common.Address{}) {
+		return errors.New(ErrTransferToZeroAddress)
+	}
+
+	// zero value transfers are valid ERC20 transfers but invalid bank sends
+	if amount.Sign() > 0 {
+		coins := sdk.Coins{{Denom: p.denom, Amount: sdkmath.NewIntFromBigInt(amount)}}
+
+		if err := p.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return err
+		}
+
+		if p.bankKeeper.BlockedAddr(to.Bytes()) {
+			return fmt.Errorf(ErrBlockedAddress, to)
+		}
+
+		if err := p.bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), coins); err != nil {
+			return err
+		}
+	}
+
+	return p.EmitTransferEvent(ctx, stateDB, from, to, amount)
+}
+
+// approve stores the allowance and emits the Approval event.
+func (p Precompile) approve(ctx sdk.Context, stateDB *statedb.StateDB, owner, spender common.Address, amount *big.Int) error {
+	if spender == (common.Address{}) {
+		return errors.New(ErrApproveToZeroAddress)
+	}
+
+	p.allowanceKeeper.SetAllowance(ctx, p.denom, owner, spender, amount)
+	return p.EmitApprovalEvent(ctx, stateDB, owner, spender, amount)
+}
+
+// spendAllowance decreases the allowance granted by the owner to the spender by
+// the given amount. Infinite allowances are left unchanged.
+func (p Precompile) spendAllowance(ctx sdk.Context, stateDB *statedb.StateDB, owner, spender common.Address, amount *big.Int) error {
+	allowance := p.allowanceKeeper.GetAllowance(ctx, p.denom, owner, spender)
+	if allowance.Cmp(abi.MaxUint256) == 0 {
+		return nil
+	}
+
+	if allowance.Cmp(amount) < 0 {
+		return errors.New(ErrInsufficientAllowance)
+	}
+
+	return p.approve(ctx, stateDB, owner, spender, new(big.Int).Sub(allowance, amount))
+}
@@ -0,0 +1,115 @@
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+)
+
+// BankKeeper defines the expected bank keeper interface of the ERC20 precompile.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// AllowanceKeeper defines the expected keeper interface storing the ERC20
+// allowances of the precompile.
+type AllowanceKeeper interface {
+	GetAllowance(ctx sdk.Context, denom string, owner, spender common.Address) *big.Int
+	SetAllowance(ctx sdk.Context, denom string, owner, spender common.Address, value *big.Int)
+}
+
+// parseTransferArgs parses the arguments of the transfer method.
+func parseTransferArgs(args []interface{}) (to common.Address, amount *big.Int, err error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[0])
+	}
+
+	amount, ok = args[1].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "amount", big.NewInt(0), args[1])
+	}
+
+	return to, amount, nil
+}
+
+// parseTransferFromArgs parses the arguments of the transferFrom method.
+func parseTransferFromArgs(args []interface{}) (from, to common.Address, amount *big.Int, err error) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "from", common.Address{}, args[0])
+	}
+
+	to, amount, err = parseTransferArgs(args[1:])
+	return from, to, amount, err
+}
+
+// parseApproveArgs parses the arguments of the approve method.
+func parseApproveArgs(args []interface{}) (spender common.Address, amount *big.Int, err error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	spender, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "spender", common.Address{}, args[0])
+	}
+
+	amount, ok = args[1].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "amount", big.NewInt(0), args[1])
+	}
+
+	return spender, amount, nil
+}
+
+// parseBalanceOfArgs parses the arguments of the balanceOf method.
+func parseBalanceOfArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "account", common.Address{}, args[0])
+	}
+
+	return account, nil
+}
+
+// parseAllowanceArgs parses the arguments of the allowance method.
+func parseAllowanceArgs(args []interface{}) (owner, spender common.Address, err error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "owner", common.Address{}, args[0])
+	}
+
+	spender, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "spender", common.Address{}, args[1])
+	}
+
+	return owner, spender, nil
+}
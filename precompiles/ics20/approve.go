@@ -0,0 +1,132 @@
+package ics20
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+const (
+	// ApproveMethod defines the ABI method name for the ICS20 approve transaction.
+	ApproveMethod = "approve"
+	// RevokeMethod defines the ABI method name for the ICS20 revoke transaction.
+	RevokeMethod = "revoke"
+	// AllowanceMethod defines the ABI method name for the ICS20 allowance query.
+	AllowanceMethod = "allowance"
+)
+
+// transferMsgType is the message type of the ICS20 transfer grants.
+var transferMsgType = sdk.MsgTypeURL(&transfertypes.MsgTransfer{})
+
+// Approve grants the grantee the authorization to send fungible token packets
+// on behalf of the caller. Each allocation limits the amounts per denomination
+// that can be sent on a channel. The maximum uint256 amount grants an unlimited
+// spend limit for the denomination. An existing grant is replaced.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	var input ApproveInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	if err := validateGrantee(owner, input.Grantee); err != nil {
+		return nil, err
+	}
+
+	authorization := ToTransferAuthorization(input.Allocations)
+	if err := authorization.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := p.authzKeeper.SaveGrant(ctx, input.Grantee.Bytes(), owner.Bytes(), authorization, nil); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, input.Grantee, NewAllocations(authorization)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the transfer authorization granted by the caller to the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, err := parseAddress(args, 0, "grantee")
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	if err := p.authzKeeper.DeleteGrant(ctx, grantee.Bytes(), owner.Bytes(), transferMsgType); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRevocationEvent(ctx, stateDB, owner, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Allowance returns the allocations of the transfer authorization granted by
+// the granter to the grantee. It returns an empty list if there is no grant.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter, grantee, err := parseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, _ := p.authzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), transferMsgType)
+	if authorization == nil {
+		return method.Outputs.Pack([]Allocation{})
+	}
+
+	transferAuthorization, ok := authorization.(*transfertypes.TransferAuthorization)
+	if !ok {
+		return nil, fmt.Errorf(ErrUnexpectedAuthorization, authorization, transferMsgType)
+	}
+
+	return method.Outputs.Pack(NewAllocations(transferAuthorization))
+}
+
+// validateGrantee checks that the grantee of an approval is neither the zero
+// address nor the owner.
+func validateGrantee(owner, grantee common.Address) error {
+	if grantee == (common.Address{}) {
+		return errors.New(ErrApproveToZeroAddress)
+	}
+
+	if grantee == owner {
+		return fmt.Errorf(ErrApproveSelf, owner)
+	}
+
+	return nil
+}
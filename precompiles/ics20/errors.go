@@ -0,0 +1,14 @@
+package ics20
+
+const (
+	// ErrInvalidSender is raised when the sender address is the zero address.
+	ErrInvalidSender = "invalid sender address: %s"
+	// ErrInvalidAmount is raised when the amount is not a positive integer.
+	ErrInvalidAmount = "invalid amount: %s"
+	// ErrApproveSelf is raised when the caller approves itself.
+	ErrApproveSelf = "cannot approve %s to act on its own behalf"
+	// ErrApproveToZeroAddress is raised when the grantee of an approval is the zero address.
+	ErrApproveToZeroAddress = "ics20: approve to the zero address"
+	// ErrUnexpectedAuthorization is raised when the transfer grant is not a transfer authorization.
+	ErrUnexpectedAuthorization = "unexpected authorization type %T for %s"
+)
@@ -0,0 +1,52 @@
+package ics20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+const (
+	// EventTypeIBCTransfer defines the event type for the ICS20 IBCTransfer event.
+	EventTypeIBCTransfer = "IBCTransfer"
+	// EventTypeApproval defines the event type for the ICS20 Approval event.
+	EventTypeApproval = "Approval"
+	// EventTypeRevocation defines the event type for the ICS20 Revocation event.
+	EventTypeRevocation = "Revocation"
+)
+
+// EmitIBCTransferEvent emits the ICS20 IBCTransfer event.
+func (p Precompile) EmitIBCTransferEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	sender common.Address,
+	msg *transfertypes.MsgTransfer,
+	sequence uint64,
+) error {
+	return p.EmitEvent(
+		ctx, stateDB, EventTypeIBCTransfer,
+		sender, msg.Receiver, msg.SourcePort, msg.SourceChannel,
+		msg.Token.Denom, msg.Token.Amount.BigInt(), sequence, msg.Memo,
+	)
+}
+
+// EmitApprovalEvent emits the ICS20 Approval event.
+func (p Precompile) EmitApprovalEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	owner, grantee common.Address,
+	allocations []Allocation,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeApproval, owner, grantee, allocations)
+}
+
+// EmitRevocationEvent emits the ICS20 Revocation event.
+func (p Precompile) EmitRevocationEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	owner, grantee common.Address,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeRevocation, owner, grantee)
+}
@@ -0,0 +1,89 @@
+package ics20
+
+import (
+	"embed"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+	transferkeeper "github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/keeper"
+)
+
+// PrecompileAddress is the address of the ICS20 transfer precompile.
+const PrecompileAddress = "0x0000000000000000000000000000000000000802"
+
+var _ vm.PrecompiledContract = Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the ICS20 transfer precompiled contract. It sends fungible
+// token packets on behalf of the sender, which is either the caller or an
+// account that has approved the caller through an x/authz transfer grant.
+type Precompile struct {
+	cmn.Precompile
+
+	transferKeeper transferkeeper.Keeper
+	authzKeeper    cmn.AuthzKeeper
+}
+
+// LoadABI loads the ICS20 precompile ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates the ICS20 transfer precompile.
+func NewPrecompile(
+	transferKeeper transferkeeper.Keeper,
+	authzKeeper cmn.AuthzKeeper,
+) (Precompile, error) {
+	newABI, err := LoadABI()
+	if err != nil {
+		return Precompile{}, err
+	}
+
+	return Precompile{
+		Precompile:     cmn.NewPrecompile(common.HexToAddress(PrecompileAddress), newABI),
+		transferKeeper: transferKeeper,
+		authzKeeper:    authzKeeper,
+	}, nil
+}
+
+// Run executes the precompiled contract ICS20 methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.Execute(evm, contract, readOnly, p.handle)
+}
+
+// handle dispatches the call to the handler of the method.
+func (p Precompile) handle(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// Approval transactions
+	case ApproveMethod:
+		return p.Approve(ctx, stateDB, contract, method, args)
+	case RevokeMethod:
+		return p.Revoke(ctx, stateDB, contract, method, args)
+	// ICS20 transactions
+	case TransferMethod:
+		return p.Transfer(ctx, stateDB, contract, method, args)
+	// ICS20 queries
+	case AllowanceMethod:
+		return p.Allowance(ctx, method, args)
+	case PacketStatusMethod:
+		return p.PacketStatus(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
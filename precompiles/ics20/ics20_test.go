@@ -0,0 +1,282 @@
+package ics20_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/precompiles/ics20"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	packettypes "github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/types"
+)
+
+// transferArgs returns the arguments of a transfer of the EVM denomination
+// from the sender on the channel of the test path.
+func (suite *PrecompileTestSuite) transferArgs(
+	sender common.Address,
+	amount int64,
+	receiver string,
+	timeoutHeight ics20.Height,
+) []interface{} {
+	return []interface{}{
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		constants.BaseDenom, big.NewInt(amount), sender, receiver,
+		timeoutHeight, uint64(0), "memo",
+	}
+}
+
+// packet returns the packet sent by the transfer of the EVM denomination from
+// the sender with the given sequence.
+func (suite *PrecompileTestSuite) packet(
+	sequence uint64,
+	sender common.Address,
+	amount int64,
+	receiver string,
+	timeoutHeight ics20.Height,
+) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(
+		constants.BaseDenom, sdk.NewInt(amount).String(), sdk.AccAddress(sender.Bytes()).String(), receiver, "memo",
+	)
+
+	return channeltypes.NewPacket(
+		data.GetBytes(), sequence,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.NewHeight(timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight), 0,
+	)
+}
+
+// packetStatus returns the status of the packet sent on the test path.
+func (suite *PrecompileTestSuite) packetStatus(sequence uint64) packettypes.PacketStatus {
+	out := suite.call(
+		suite.address, ics20.PacketStatusMethod,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence,
+	)
+	return packettypes.PacketStatus(out[0].(uint8))
+}
+
+func (suite *PrecompileTestSuite) TestTransfer() {
+	var (
+		receiver      string
+		timeoutHeight ics20.Height
+	)
+
+	testCases := []struct {
+		name string
+		test func()
+	}{
+		{
+			"transfer is acknowledged on the counterparty chain",
+			func() {
+				res := suite.execute(suite.address, ics20.TransferMethod, suite.transferArgs(suite.address, 100, receiver, timeoutHeight)...)
+				suite.Require().False(res.Failed(), res.VmError)
+				suite.Require().Equal(int64(1_000_000-100), suite.balance(suite.address))
+
+				out, err := suite.precompile.Unpack(ics20.TransferMethod, res.Ret)
+				suite.Require().NoError(err)
+				sequence := out[0].(uint64)
+				suite.Require().Equal(uint64(1), sequence)
+				suite.Require().Equal(packettypes.PacketStatusPending, suite.packetStatus(sequence))
+
+				suite.Require().Len(res.Logs, 1)
+				suite.Require().Equal(suite.precompile.Events[ics20.EventTypeIBCTransfer].ID.String(), res.Logs[0].Topics[0])
+				suite.Require().Equal(common.BytesToHash(suite.address.Bytes()).String(), res.Logs[0].Topics[1])
+
+				suite.coordinator.CommitBlock(suite.chainA)
+				suite.Require().NoError(suite.path.RelayPacket(suite.packet(sequence, suite.address, 100, receiver, timeoutHeight)))
+
+				suite.Require().Equal(packettypes.PacketStatusAcknowledged, suite.packetStatus(sequence))
+				suite.Require().Equal(int64(1_000_000-100), suite.balance(suite.address))
+			},
+		},
+		{
+			"error acknowledgement refunds the sender",
+			func() {
+				receiver = "invalid"
+				out := suite.call(suite.address, ics20.TransferMethod, suite.transferArgs(suite.address, 100, receiver, timeoutHeight)...)
+				sequence := out[0].(uint64)
+
+				suite.coordinator.CommitBlock(suite.chainA)
+				suite.Require().NoError(suite.path.RelayPacket(suite.packet(sequence, suite.address, 100, receiver, timeoutHeight)))
+
+				suite.Require().Equal(packettypes.PacketStatusFailed, suite.packetStatus(sequence))
+				suite.Require().Equal(int64(1_000_000), suite.balance(suite.address))
+			},
+		},
+		{
+			"timeout refunds the sender",
+			func() {
+				timeoutHeight.RevisionHeight = uint64(suite.chainB.GetContext().BlockHeight()) + 1
+				out := suite.call(suite.address, ics20.TransferMethod, suite.transferArgs(suite.address, 100, receiver, timeoutHeight)...)
+				sequence := out[0].(uint64)
+				suite.Require().Equal(int64(1_000_000-100), suite.balance(suite.address))
+
+				suite.coordinator.CommitBlock(suite.chainA)
+				suite.coordinator.CommitNBlocks(suite.chainB, 2)
+				suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(suite.packet(sequence, suite.address, 100, receiver, timeoutHeight)))
+
+				suite.Require().Equal(packettypes.PacketStatusTimedOut, suite.packetStatus(sequence))
+				suite.Require().Equal(int64(1_000_000), suite.balance(suite.address))
+			},
+		},
+		{
+			"transfer fails on an unknown channel",
+			func() {
+				args := suite.transferArgs(suite.address, 100, receiver, timeoutHeight)
+				args[1] = "channel-10"
+				suite.callFails(suite.address, ics20.TransferMethod, args...)
+				suite.Require().Equal(int64(1_000_000), suite.balance(suite.address))
+			},
+		},
+		{
+			"transfer fails with insufficient balance",
+			func() {
+				suite.callFails(suite.address, ics20.TransferMethod, suite.transferArgs(suite.address, 1_000_001, receiver, timeoutHeight)...)
+			},
+		},
+		{
+			"transfer fails without timeout",
+			func() {
+				suite.callFails(suite.address, ics20.TransferMethod, suite.transferArgs(suite.address, 100, receiver, ics20.Height{})...)
+			},
+		},
+		{
+			"caller cannot transfer on behalf of the sender without approval",
+			func() {
+				suite.callFails(utiltx.GenerateAddress(), ics20.TransferMethod, suite.transferArgs(suite.address, 100, receiver, timeoutHeight)...)
+				suite.Require().Equal(int64(1_000_000), suite.balance(suite.address))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+			timeoutHeight = ics20.Height{RevisionNumber: 1, RevisionHeight: 1_000}
+
+			tc.test()
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestApprove() {
+	var grantee common.Address
+
+	allocations := func(amount *big.Int, allowList ...string) []ics20.Allocation {
+		if allowList == nil {
+			allowList = []string{}
+		}
+
+		return []ics20.Allocation{{
+			SourcePort:    suite.path.EndpointA.ChannelConfig.PortID,
+			SourceChannel: suite.path.EndpointA.ChannelID,
+			SpendLimit:    []cmn.Coin{{Denom: constants.BaseDenom, Amount: amount}},
+			AllowList:     allowList,
+		}}
+	}
+
+	allowance := func() []ics20.Allocation {
+		out := suite.call(suite.address, ics20.AllowanceMethod, suite.address, grantee)
+		return *abi.ConvertType(out[0], new([]ics20.Allocation)).(*[]ics20.Allocation)
+	}
+
+	testCases := []struct {
+		name string
+		test func()
+	}{
+		{
+			"approve sets the allocations and emits the Approval event",
+			func() {
+				res := suite.execute(suite.address, ics20.ApproveMethod, grantee, allocations(big.NewInt(100)))
+				suite.Require().False(res.Failed(), res.VmError)
+				suite.Require().Equal(allocations(big.NewInt(100)), allowance())
+
+				suite.Require().Len(res.Logs, 1)
+				suite.Require().Equal(suite.precompile.Events[ics20.EventTypeApproval].ID.String(), res.Logs[0].Topics[0])
+			},
+		},
+		{
+			"grantee transfers within the spend limit",
+			func() {
+				suite.call(suite.address, ics20.ApproveMethod, grantee, allocations(big.NewInt(100)))
+
+				receiver := suite.chainB.SenderAccount.GetAddress().String()
+				timeoutHeight := ics20.Height{RevisionNumber: 1, RevisionHeight: 1_000}
+				suite.call(grantee, ics20.TransferMethod, suite.transferArgs(suite.address, 60, receiver, timeoutHeight)...)
+				suite.Require().Equal(int64(1_000_000-60), suite.balance(suite.address))
+				suite.Require().Equal(allocations(big.NewInt(40)), allowance())
+
+				suite.callFails(grantee, ics20.TransferMethod, suite.transferArgs(suite.address, 41, receiver, timeoutHeight)...)
+
+				// spending the whole limit removes the grant
+				suite.call(grantee, ics20.TransferMethod, suite.transferArgs(suite.address, 40, receiver, timeoutHeight)...)
+				suite.Require().Empty(allowance())
+			},
+		},
+		{
+			"unlimited spend limit is not spent",
+			func() {
+				suite.call(suite.address, ics20.ApproveMethod, grantee, allocations(abi.MaxUint256))
+
+				receiver := suite.chainB.SenderAccount.GetAddress().String()
+				suite.call(grantee, ics20.TransferMethod, suite.transferArgs(suite.address, 60, receiver, ics20.Height{RevisionNumber: 1, RevisionHeight: 1_000})...)
+				suite.Require().Equal(allocations(abi.MaxUint256), allowance())
+			},
+		},
+		{
+			"grantee cannot transfer to a receiver outside of the allow list",
+			func() {
+				suite.call(suite.address, ics20.ApproveMethod, grantee, allocations(big.NewInt(100), "cosmos1allowed"))
+
+				receiver := suite.chainB.SenderAccount.GetAddress().String()
+				suite.callFails(grantee, ics20.TransferMethod, suite.transferArgs(suite.address, 60, receiver, ics20.Height{RevisionNumber: 1, RevisionHeight: 1_000})...)
+			},
+		},
+		{
+			"revoke removes the grant",
+			func() {
+				suite.call(suite.address, ics20.ApproveMethod, grantee, allocations(big.NewInt(100)))
+
+				res := suite.execute(suite.address, ics20.RevokeMethod, grantee)
+				suite.Require().False(res.Failed(), res.VmError)
+				suite.Require().Empty(allowance())
+				suite.Require().Equal(suite.precompile.Events[ics20.EventTypeRevocation].ID.String(), res.Logs[0].Topics[0])
+			},
+		},
+		{
+			"approve fails with invalid allocations",
+			func() {
+				suite.callFails(suite.address, ics20.ApproveMethod, grantee, []ics20.Allocation{})
+
+				invalid := allocations(big.NewInt(100))
+				invalid[0].SourceChannel = "invalid channel"
+				suite.callFails(suite.address, ics20.ApproveMethod, grantee, invalid)
+			},
+		},
+		{
+			"approve fails for the caller or the zero address",
+			func() {
+				suite.callFails(suite.address, ics20.ApproveMethod, suite.address, allocations(big.NewInt(100)))
+				suite.callFails(suite.address, ics20.ApproveMethod, common.Address{}, allocations(big.NewInt(100)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			grantee = utiltx.GenerateAddress()
+			tc.test()
+		})
+	}
+}
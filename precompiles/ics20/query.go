@@ -0,0 +1,28 @@
+package ics20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// PacketStatusMethod defines the ABI method name for the ICS20 packetStatus query.
+const PacketStatusMethod = "packetStatus"
+
+// PacketStatus returns the status of the packet sent through the precompile on
+// the given port and channel: 0 if the packet is unknown, 1 while it waits for
+// an acknowledgement or a timeout, 2 if it was successfully acknowledged, 3 if
+// it was acknowledged with an error and 4 if it timed out. The tokens of the
+// failed and timed out packets are refunded to the sender.
+func (p Precompile) PacketStatus(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sourcePort, sourceChannel, sequence, err := parsePacketStatusArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	status := p.transferKeeper.GetPacketStatus(ctx, sourcePort, sourceChannel, sequence)
+	return method.Outputs.Pack(uint8(status))
+}
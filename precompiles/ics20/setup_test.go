@@ -0,0 +1,122 @@
+package ics20_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/HarryBin2002/kairoschain/v12/app"
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	ibctesting "github.com/HarryBin2002/kairoschain/v12/ibc/testing"
+	"github.com/HarryBin2002/kairoschain/v12/precompiles/ics20"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *ibcgotesting.Coordinator
+	chainA      *ibcgotesting.TestChain
+	chainB      *ibcgotesting.TestChain
+	path        *ibctesting.Path
+
+	app        *app.Kairoschain
+	address    common.Address
+	precompile ics20.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	// chain A runs the EVM and chain B is a Cosmos chain
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 1)
+	suite.chainA = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	suite.app = suite.chainA.App.(*app.Kairoschain)
+
+	ctx := suite.chainA.GetContext()
+	evmParams := suite.app.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = constants.BaseDenom
+	evmParams.ActivePrecompiles = []string{ics20.PrecompileAddress}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(ctx, evmParams))
+
+	precompile, found := suite.app.EvmKeeper.GetPrecompile(common.HexToAddress(ics20.PrecompileAddress))
+	suite.Require().True(found)
+	suite.precompile = precompile.(ics20.Precompile)
+
+	// the block proposer is needed to set the EVM coinbase and is carried
+	// over by the testing chain
+	validators := suite.app.StakingKeeper.GetValidators(ctx, 1)
+	consAddress, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.chainA.CurrentHeader.ProposerAddress = consAddress.Bytes()
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(ctx, validators[0]))
+
+	// the relayer pays the fees of the IBC messages
+	suite.fund(common.BytesToAddress(suite.chainA.SenderAccount.GetAddress()), 1_000_000_000_000_000_000)
+
+	suite.address = utiltx.GenerateAddress()
+	suite.fund(suite.address, 1_000_000)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	ibctesting.SetupPath(suite.coordinator, suite.path)
+}
+
+// fund mints the given amount of the EVM denomination to the address.
+func (suite *PrecompileTestSuite) fund(address common.Address, amount int64) {
+	ctx := suite.chainA.GetContext()
+	coins := sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(amount)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, address.Bytes(), coins))
+}
+
+// balance returns the EVM denomination balance of the address.
+func (suite *PrecompileTestSuite) balance(address common.Address) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.chainA.GetContext(), address.Bytes(), constants.BaseDenom).Amount.Int64()
+}
+
+// call executes the precompile method from the given address and returns the
+// unpacked outputs. It fails the test if the call reverts.
+func (suite *PrecompileTestSuite) call(from common.Address, method string, args ...interface{}) []interface{} {
+	res := suite.execute(from, method, args...)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.precompile.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return out
+}
+
+// callFails executes the precompile method from the given address and checks
+// that the call reverts.
+func (suite *PrecompileTestSuite) callFails(from common.Address, method string, args ...interface{}) {
+	res := suite.execute(from, method, args...)
+	suite.Require().True(res.Failed())
+}
+
+// execute applies a message calling the precompile method from the given address.
+func (suite *PrecompileTestSuite) execute(from common.Address, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+	input, err := suite.precompile.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	to := suite.precompile.Address()
+	msg := ethtypes.NewMessage(
+		from, &to, suite.app.EvmKeeper.GetNonce(ctx, from),
+		big.NewInt(0), 1_000_000, big.NewInt(0), nil, nil, input, nil, true,
+	)
+
+	res, err := suite.app.EvmKeeper.ApplyMessage(ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	return res
+}
@@ -0,0 +1,67 @@
+package ics20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+	transfertypes "github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/types"
+)
+
+// TransferMethod defines the ABI method name for the ICS20 transfer transaction.
+const TransferMethod = "transfer"
+
+// Transfer sends a fungible token packet from the sender to the receiver on the
+// counterparty chain. Registered ERC20 tokens are converted to their Cosmos
+// coin before being sent. The packet is tracked so that its acknowledgement or
+// timeout can be queried with the packetStatus method. It returns the sequence
+// of the packet.
+func (p Precompile) Transfer(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender, msg, err := parseTransferArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := p.authorize(ctx, contract.CallerAddress, sender, msg); err != nil {
+		return nil, err
+	}
+
+	// the denomination of the message is updated by the keeper for ERC20 tokens
+	event := *msg
+
+	res, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.transferKeeper.SetPacketStatus(ctx, msg.SourcePort, msg.SourceChannel, res.Sequence, transfertypes.PacketStatusPending)
+
+	if err := p.EmitIBCTransferEvent(ctx, stateDB, sender, &event, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// authorize checks that the caller can send the message on behalf of the
+// sender, either because it is the sender or through an x/authz grant.
+func (p Precompile) authorize(ctx sdk.Context, caller, sender common.Address, msg sdk.Msg) error {
+	if caller == sender {
+		return nil
+	}
+
+	return cmn.AcceptGrant(ctx, p.authzKeeper, caller, sender, msg)
+}
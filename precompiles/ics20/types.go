@@ -0,0 +1,226 @@
+package ics20
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+)
+
+// Height is the ABI representation of an IBC client height.
+type Height struct {
+	RevisionNumber uint64
+	RevisionHeight uint64
+}
+
+// Allocation is the ABI representation of an ICS20 transfer authorization
+// allocation: the amounts that can be sent on a channel and the receivers
+// allowed on it. An empty allow list permits any receiver.
+type Allocation struct {
+	SourcePort    string
+	SourceChannel string
+	SpendLimit    []cmn.Coin
+	AllowList     []string
+}
+
+// NewAllocations returns the ABI representation of the transfer authorization
+// allocations.
+func NewAllocations(authorization *transfertypes.TransferAuthorization) []Allocation {
+	allocations := make([]Allocation, len(authorization.Allocations))
+	for i, allocation := range authorization.Allocations {
+		allowList := allocation.AllowList
+		if allowList == nil {
+			allowList = []string{}
+		}
+
+		allocations[i] = Allocation{
+			SourcePort:    allocation.SourcePort,
+			SourceChannel: allocation.SourceChannel,
+			SpendLimit:    cmn.NewCoins(allocation.SpendLimit),
+			AllowList:     allowList,
+		}
+	}
+	return allocations
+}
+
+// ToTransferAuthorization returns the transfer authorization with the given
+// allocations. The spend limits are sorted but not validated.
+func ToTransferAuthorization(allocations []Allocation) *transfertypes.TransferAuthorization {
+	authorization := &transfertypes.TransferAuthorization{
+		Allocations: make([]transfertypes.Allocation, len(allocations)),
+	}
+
+	for i, allocation := range allocations {
+		spendLimit := make(sdk.Coins, len(allocation.SpendLimit))
+		for j, coin := range allocation.SpendLimit {
+			spendLimit[j] = sdk.Coin{Denom: coin.Denom, Amount: sdkmath.NewIntFromBigInt(coin.Amount)}
+		}
+
+		authorization.Allocations[i] = transfertypes.Allocation{
+			SourcePort:    allocation.SourcePort,
+			SourceChannel: allocation.SourceChannel,
+			SpendLimit:    spendLimit.Sort(),
+			AllowList:     allocation.AllowList,
+		}
+	}
+
+	return authorization
+}
+
+// ApproveInput is the input of the approve method.
+type ApproveInput struct {
+	Grantee     common.Address
+	Allocations []Allocation
+}
+
+// parseAddress returns the address argument at the given position.
+func parseAddress(args []interface{}, i int, name string) (common.Address, error) {
+	address, ok := args[i].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, name, common.Address{}, args[i])
+	}
+	return address, nil
+}
+
+// parseString returns the string argument at the given position.
+func parseString(args []interface{}, i int, name string) (string, error) {
+	value, ok := args[i].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, name, "", args[i])
+	}
+	return value, nil
+}
+
+// parseUint64 returns the uint64 argument at the given position.
+func parseUint64(args []interface{}, i int, name string) (uint64, error) {
+	value, ok := args[i].(uint64)
+	if !ok {
+		return 0, fmt.Errorf(cmn.ErrInvalidType, name, uint64(0), args[i])
+	}
+	return value, nil
+}
+
+// parseHeight converts the tuple unpacked from the call input to a client height.
+func parseHeight(arg interface{}) (clienttypes.Height, error) {
+	value := reflect.ValueOf(arg)
+	heightType := reflect.TypeOf(Height{})
+	if !value.IsValid() || !value.Type().ConvertibleTo(heightType) {
+		return clienttypes.Height{}, fmt.Errorf(cmn.ErrInvalidType, "timeoutHeight", Height{}, arg)
+	}
+
+	height := value.Convert(heightType).Interface().(Height)
+	return clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight), nil
+}
+
+// parseTransferArgs parses the arguments of the transfer method and returns the
+// sender and the corresponding transfer message.
+func parseTransferArgs(args []interface{}) (common.Address, *transfertypes.MsgTransfer, error) {
+	if len(args) != 9 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 9, len(args))
+	}
+
+	sourcePort, err := parseString(args, 0, "sourcePort")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	sourceChannel, err := parseString(args, 1, "sourceChannel")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	denom, err := parseString(args, 2, "denom")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	amount, ok := args[3].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "amount", big.NewInt(0), args[3])
+	}
+	if amount.Sign() <= 0 {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidAmount, amount)
+	}
+
+	sender, err := parseAddress(args, 4, "sender")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	if sender == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidSender, sender)
+	}
+
+	receiver, err := parseString(args, 5, "receiver")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	timeoutHeight, err := parseHeight(args[6])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	timeoutTimestamp, err := parseUint64(args, 7, "timeoutTimestamp")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	memo, err := parseString(args, 8, "memo")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		sourcePort, sourceChannel,
+		sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)},
+		sdk.AccAddress(sender.Bytes()).String(), receiver,
+		timeoutHeight, timeoutTimestamp, memo,
+	)
+
+	return sender, msg, nil
+}
+
+// parseAllowanceArgs parses the arguments of the allowance method.
+func parseAllowanceArgs(args []interface{}) (granter, grantee common.Address, err error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	if granter, err = parseAddress(args, 0, "granter"); err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+
+	if grantee, err = parseAddress(args, 1, "grantee"); err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+
+	return granter, grantee, nil
+}
+
+// parsePacketStatusArgs parses the arguments of the packetStatus method.
+func parsePacketStatusArgs(args []interface{}) (sourcePort, sourceChannel string, sequence uint64, err error) {
+	if len(args) != 3 {
+		return "", "", 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	if sourcePort, err = parseString(args, 0, "sourcePort"); err != nil {
+		return "", "", 0, err
+	}
+
+	if sourceChannel, err = parseString(args, 1, "sourceChannel"); err != nil {
+		return "", "", 0, err
+	}
+
+	if sequence, err = parseUint64(args, 2, "sequence"); err != nil {
+		return "", "", 0, err
+	}
+
+	return sourcePort, sourceChannel, sequence, nil
+}
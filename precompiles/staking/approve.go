@@ -0,0 +1,184 @@
+package staking
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+const (
+	// ApproveMethod defines the ABI method name for the staking approve transaction.
+	ApproveMethod = "approve"
+	// RevokeMethod defines the ABI method name for the staking revoke transaction.
+	RevokeMethod = "revoke"
+	// AllowanceMethod defines the ABI method name for the staking allowance query.
+	AllowanceMethod = "allowance"
+)
+
+// authorizationTypes maps the methods that can be approved to the x/staking
+// authorization type of the x/authz grant.
+var authorizationTypes = map[string]stakingtypes.AuthorizationType{
+	DelegateMethod:   stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+	UndelegateMethod: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE,
+	RedelegateMethod: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+}
+
+// Approve grants the spender the authorization to execute the given methods on
+// behalf of the caller, up to the given amount of the bond denomination. The
+// maximum uint256 amount grants an unlimited authorization and a zero amount
+// removes the existing authorizations.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, amount, methods, err := parseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	if err := validateSpender(owner, spender); err != nil {
+		return nil, err
+	}
+
+	var maxTokens *sdk.Coin
+	if amount.Cmp(abi.MaxUint256) != 0 {
+		coin := sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), sdkmath.NewIntFromBigInt(amount))
+		maxTokens = &coin
+	}
+
+	for _, name := range methods {
+		authorizationType, ok := authorizationTypes[name]
+		if !ok {
+			return nil, fmt.Errorf(ErrUnknownApprovalMethod, name)
+		}
+
+		authorization := &stakingtypes.StakeAuthorization{
+			MaxTokens:         maxTokens,
+			AuthorizationType: authorizationType,
+		}
+
+		if amount.Sign() == 0 {
+			p.deleteGrant(ctx, owner, spender, authorization.MsgTypeURL())
+			continue
+		}
+
+		if err := p.authzKeeper.SaveGrant(ctx, spender.Bytes(), owner.Bytes(), authorization, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, methods, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the authorizations granted by the caller to the spender for
+// the given methods.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, methods, err := parseRevokeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	for _, name := range methods {
+		authorizationType, ok := authorizationTypes[name]
+		if !ok {
+			return nil, fmt.Errorf(ErrUnknownApprovalMethod, name)
+		}
+
+		msgType := (&stakingtypes.StakeAuthorization{AuthorizationType: authorizationType}).MsgTypeURL()
+		if err := p.authzKeeper.DeleteGrant(ctx, spender.Bytes(), owner.Bytes(), msgType); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.EmitRevocationEvent(ctx, stateDB, owner, spender, methods); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Allowance returns the amount of the bond denomination that the spender is
+// allowed to use on behalf of the owner with the given method. Unlimited
+// authorizations are returned as the maximum uint256 value.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, name, err := parseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationType, ok := authorizationTypes[name]
+	if !ok {
+		return nil, fmt.Errorf(ErrUnknownApprovalMethod, name)
+	}
+
+	msgType := (&stakingtypes.StakeAuthorization{AuthorizationType: authorizationType}).MsgTypeURL()
+	authorization, _ := p.authzKeeper.GetAuthorization(ctx, spender.Bytes(), owner.Bytes(), msgType)
+	if authorization == nil {
+		return method.Outputs.Pack(big.NewInt(0))
+	}
+
+	stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+	if !ok {
+		return nil, fmt.Errorf(ErrUnexpectedAuthorization, authorization, msgType)
+	}
+
+	if stakeAuthorization.MaxTokens == nil {
+		return method.Outputs.Pack(abi.MaxUint256)
+	}
+
+	return method.Outputs.Pack(stakeAuthorization.MaxTokens.Amount.BigInt())
+}
+
+// deleteGrant removes the grant, if any, of the owner to the spender for the
+// given message type.
+func (p Precompile) deleteGrant(ctx sdk.Context, owner, spender common.Address, msgType string) {
+	authorization, _ := p.authzKeeper.GetAuthorization(ctx, spender.Bytes(), owner.Bytes(), msgType)
+	if authorization == nil {
+		return
+	}
+
+	// the grant exists so it can always be deleted
+	_ = p.authzKeeper.DeleteGrant(ctx, spender.Bytes(), owner.Bytes(), msgType)
+}
+
+// validateSpender checks that the spender of an approval is neither the zero
+// address nor the owner.
+func validateSpender(owner, spender common.Address) error {
+	if spender == (common.Address{}) {
+		return errors.New(ErrApproveToZeroAddress)
+	}
+
+	if spender == owner {
+		return fmt.Errorf(ErrApproveSelf, owner)
+	}
+
+	return nil
+}
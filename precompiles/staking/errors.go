@@ -0,0 +1,22 @@
+package staking
+
+const (
+	// ErrInvalidDelegator is raised when the delegator address is the zero address.
+	ErrInvalidDelegator = "invalid delegator address: %s"
+	// ErrInvalidValidator is raised when the validator address is not a valid bech32 operator address.
+	ErrInvalidValidator = "invalid validator address %s: %s"
+	// ErrInvalidAmount is raised when the amount is not a positive integer.
+	ErrInvalidAmount = "invalid amount: %s"
+	// ErrInvalidCreationHeight is raised when the creation height of an unbonding entry does not fit in an int64.
+	ErrInvalidCreationHeight = "invalid creation height: %s"
+	// ErrApproveSelf is raised when the caller approves itself.
+	ErrApproveSelf = "cannot approve %s to act on its own behalf"
+	// ErrApproveToZeroAddress is raised when the spender of an approval is the zero address.
+	ErrApproveToZeroAddress = "staking: approve to the zero address"
+	// ErrUnknownApprovalMethod is raised when the method cannot be approved.
+	ErrUnknownApprovalMethod = "method %s cannot be approved, expected one of delegate, undelegate or redelegate"
+	// ErrUnexpectedAuthorization is raised when the grant of an approved method is not a staking authorization.
+	ErrUnexpectedAuthorization = "unexpected authorization type %T for %s"
+	// ErrValidatorNotFound is raised when the validator does not exist.
+	ErrValidatorNotFound = "validator %s does not exist"
+)
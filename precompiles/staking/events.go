@@ -0,0 +1,102 @@
+package staking
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+const (
+	// EventTypeDelegate defines the event type for the staking Delegate event.
+	EventTypeDelegate = "Delegate"
+	// EventTypeUnbond defines the event type for the staking Unbond event.
+	EventTypeUnbond = "Unbond"
+	// EventTypeRedelegate defines the event type for the staking Redelegate event.
+	EventTypeRedelegate = "Redelegate"
+	// EventTypeCancelUnbondingDelegation defines the event type for the staking CancelUnbondingDelegation event.
+	EventTypeCancelUnbondingDelegation = "CancelUnbondingDelegation"
+	// EventTypeApproval defines the event type for the staking Approval event.
+	EventTypeApproval = "Approval"
+	// EventTypeRevocation defines the event type for the staking Revocation event.
+	EventTypeRevocation = "Revocation"
+)
+
+// EmitDelegateEvent emits the staking Delegate event.
+func (p Precompile) EmitDelegateEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	delegator common.Address,
+	validator sdk.ValAddress,
+	amount *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeDelegate, delegator, common.BytesToAddress(validator), amount)
+}
+
+// EmitUnbondEvent emits the staking Unbond event.
+func (p Precompile) EmitUnbondEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	delegator common.Address,
+	validator sdk.ValAddress,
+	amount *big.Int,
+	completionTime int64,
+) error {
+	return p.EmitEvent(
+		ctx, stateDB, EventTypeUnbond,
+		delegator, common.BytesToAddress(validator), amount, big.NewInt(completionTime),
+	)
+}
+
+// EmitRedelegateEvent emits the staking Redelegate event.
+func (p Precompile) EmitRedelegateEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	delegator common.Address,
+	validatorSrc, validatorDst sdk.ValAddress,
+	amount *big.Int,
+	completionTime int64,
+) error {
+	return p.EmitEvent(
+		ctx, stateDB, EventTypeRedelegate,
+		delegator, common.BytesToAddress(validatorSrc), common.BytesToAddress(validatorDst), amount, big.NewInt(completionTime),
+	)
+}
+
+// EmitCancelUnbondingDelegationEvent emits the staking CancelUnbondingDelegation event.
+func (p Precompile) EmitCancelUnbondingDelegationEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	delegator common.Address,
+	validator sdk.ValAddress,
+	amount *big.Int,
+	creationHeight int64,
+) error {
+	return p.EmitEvent(
+		ctx, stateDB, EventTypeCancelUnbondingDelegation,
+		delegator, common.BytesToAddress(validator), amount, big.NewInt(creationHeight),
+	)
+}
+
+// EmitApprovalEvent emits the staking Approval event.
+func (p Precompile) EmitApprovalEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	owner, spender common.Address,
+	methods []string,
+	value *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeApproval, owner, spender, methods, value)
+}
+
+// EmitRevocationEvent emits the staking Revocation event.
+func (p Precompile) EmitRevocationEvent(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	owner, spender common.Address,
+	methods []string,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeRevocation, owner, spender, methods)
+}
@@ -0,0 +1,152 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+)
+
+const (
+	// DelegationMethod defines the ABI method name for the staking delegation query.
+	DelegationMethod = "delegation"
+	// UnbondingDelegationMethod defines the ABI method name for the staking unbondingDelegation query.
+	UnbondingDelegationMethod = "unbondingDelegation"
+	// RedelegationMethod defines the ABI method name for the staking redelegation query.
+	RedelegationMethod = "redelegation"
+	// ValidatorMethod defines the ABI method name for the staking validator query.
+	ValidatorMethod = "validator"
+	// ValidatorsMethod defines the ABI method name for the staking validators query.
+	ValidatorsMethod = "validators"
+)
+
+// Delegation returns the shares and the balance of the delegation from the
+// delegator to the validator. Shares are returned as an integer with 18
+// decimals and a missing delegation is returned with zero shares and balance.
+func (p Precompile) Delegation(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validatorAddress, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := p.stakingKeeper.GetValidator(ctx, validatorAddress)
+	if !found {
+		return nil, fmt.Errorf(ErrValidatorNotFound, validatorAddress)
+	}
+
+	balance := cmn.Coin{Denom: p.stakingKeeper.BondDenom(ctx), Amount: big.NewInt(0)}
+
+	delegation, found := p.stakingKeeper.GetDelegation(ctx, delegator.Bytes(), validatorAddress)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), balance)
+	}
+
+	balance.Amount = validator.TokensFromShares(delegation.Shares).TruncateInt().BigInt()
+	return method.Outputs.Pack(delegation.Shares.BigInt(), balance)
+}
+
+// UnbondingDelegation returns the unbonding entries of the delegator from the
+// validator.
+func (p Precompile) UnbondingDelegation(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validator, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ubd, found := p.stakingKeeper.GetUnbondingDelegation(ctx, delegator.Bytes(), validator)
+	if !found {
+		ubd = stakingtypes.UnbondingDelegation{
+			DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+			ValidatorAddress: validator.String(),
+		}
+	}
+
+	return method.Outputs.Pack(NewUnbondingDelegationOutput(ubd))
+}
+
+// Redelegation returns the redelegation entries of the delegator from the
+// source validator to the destination validator.
+func (p Precompile) Redelegation(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validatorSrc, validatorDst, err := parseRedelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	red, found := p.stakingKeeper.GetRedelegation(ctx, delegator.Bytes(), validatorSrc, validatorDst)
+	if !found {
+		red = stakingtypes.Redelegation{
+			DelegatorAddress:    sdk.AccAddress(delegator.Bytes()).String(),
+			ValidatorSrcAddress: validatorSrc.String(),
+			ValidatorDstAddress: validatorDst.String(),
+		}
+	}
+
+	return method.Outputs.Pack(NewRedelegationOutput(red))
+}
+
+// Validator returns the validator with the given operator address.
+func (p Precompile) Validator(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validatorAddress, err := parseValidatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := p.stakingKeeper.GetValidator(ctx, validatorAddress)
+	if !found {
+		return nil, fmt.Errorf(ErrValidatorNotFound, validatorAddress)
+	}
+
+	return method.Outputs.Pack(NewValidator(validator))
+}
+
+// Validators returns a page of the validators with the given bond status, or
+// of all the validators if the status is empty.
+func (p Precompile) Validators(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	status, pageRequest, err := parseValidatorsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := stakingkeeper.Querier{Keeper: p.stakingKeeper}.Validators(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.QueryValidatorsRequest{
+			Status:     status,
+			Pagination: pageRequest.ToQuery(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]Validator, len(res.Validators))
+	for i, validator := range res.Validators {
+		validators[i] = NewValidator(validator)
+	}
+
+	return method.Outputs.Pack(validators, cmn.NewPageResponse(res.Pagination))
+}
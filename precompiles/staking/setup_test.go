@@ -0,0 +1,138 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/HarryBin2002/kairoschain/v12/app"
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/precompiles/staking"
+	"github.com/HarryBin2002/kairoschain/v12/testutil"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	app        *app.Kairoschain
+	address    common.Address
+	validators []stakingtypes.Validator
+	precompile staking.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	chainID := constants.TestnetFullChainId
+	suite.app = app.Setup(false, nil, chainID)
+	suite.ctx = suite.app.BaseApp.NewContext(false, testutil.NewHeader(
+		1, time.Now().UTC(), chainID, nil, nil, nil,
+	))
+
+	stakingParams := suite.app.StakingKeeper.GetParams(suite.ctx)
+	stakingParams.BondDenom = constants.BaseDenom
+	suite.Require().NoError(suite.app.StakingKeeper.SetParams(suite.ctx, stakingParams))
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.EvmDenom = constants.BaseDenom
+	evmParams.ActivePrecompiles = []string{staking.PrecompileAddress}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+
+	precompile, found := suite.app.EvmKeeper.GetPrecompile(common.HexToAddress(staking.PrecompileAddress))
+	suite.Require().True(found)
+	suite.precompile = precompile.(staking.Precompile)
+
+	suite.address = utiltx.GenerateAddress()
+	suite.fund(suite.address, 1_000_000)
+
+	// two bonded validators to redelegate between
+	tokens := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 1)
+	suite.validators = make([]stakingtypes.Validator, 2)
+	for i := range suite.validators {
+		validator, err := stakingtypes.NewValidator(
+			sdk.ValAddress(utiltx.GenerateAddress().Bytes()), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{},
+		)
+		suite.Require().NoError(err)
+
+		// the tokens are moved from the not bonded pool when the validator is bonded
+		validator, _ = validator.AddTokensFromDel(tokens)
+		suite.Require().NoError(testutil.FundModuleAccount(
+			suite.ctx, suite.app.BankKeeper, stakingtypes.NotBondedPoolName,
+			sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, tokens)),
+		))
+
+		validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper, suite.ctx, validator, true)
+		suite.Require().NoError(suite.app.StakingKeeper.Hooks().AfterValidatorCreated(suite.ctx, validator.GetOperator()))
+		suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+		suite.validators[i] = validator
+	}
+
+	// the block proposer is needed to set the EVM coinbase
+	consAddress, err := suite.validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	header := suite.ctx.BlockHeader()
+	header.ProposerAddress = consAddress
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+}
+
+// fund mints the given amount of the bond denomination to the address.
+func (suite *PrecompileTestSuite) fund(address common.Address, amount int64) {
+	coins := sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(amount)))
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address.Bytes(), coins))
+}
+
+// call executes the precompile method from the given address and returns the
+// unpacked outputs. It fails the test if the call reverts.
+func (suite *PrecompileTestSuite) call(from common.Address, method string, args ...interface{}) []interface{} {
+	res := suite.execute(from, method, args...)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.precompile.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return out
+}
+
+// callFails executes the precompile method from the given address and checks
+// that the call reverts.
+func (suite *PrecompileTestSuite) callFails(from common.Address, method string, args ...interface{}) {
+	res := suite.execute(from, method, args...)
+	suite.Require().True(res.Failed())
+}
+
+// execute applies a message calling the precompile method from the given address.
+func (suite *PrecompileTestSuite) execute(from common.Address, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+	input, err := suite.precompile.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	to := suite.precompile.Address()
+	msg := ethtypes.NewMessage(
+		from, &to, suite.app.EvmKeeper.GetNonce(suite.ctx, from),
+		big.NewInt(0), 1_000_000, big.NewInt(0), nil, nil, input, nil, true,
+	)
+
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	return res
+}
+
+// delegationBalance returns the balance delegated by the address to the validator.
+func (suite *PrecompileTestSuite) delegationBalance(delegator common.Address, validator stakingtypes.Validator) *big.Int {
+	out := suite.call(suite.address, staking.DelegationMethod, delegator, validator.OperatorAddress)
+	return abi.ConvertType(out[1], new(cmn.Coin)).(*cmn.Coin).Amount
+}
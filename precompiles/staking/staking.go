@@ -0,0 +1,106 @@
+package staking
+
+import (
+	"embed"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+)
+
+// PrecompileAddress is the address of the staking precompile.
+const PrecompileAddress = "0x0000000000000000000000000000000000000800"
+
+var _ vm.PrecompiledContract = Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the staking precompiled contract. It executes the x/staking
+// messages on behalf of the delegator, which is either the caller or an account
+// that has approved the caller through an x/authz grant.
+type Precompile struct {
+	cmn.Precompile
+
+	accountKeeper AccountKeeper
+	stakingKeeper *stakingkeeper.Keeper
+	authzKeeper   cmn.AuthzKeeper
+}
+
+// LoadABI loads the staking precompile ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates the staking precompile.
+func NewPrecompile(
+	accountKeeper AccountKeeper,
+	stakingKeeper *stakingkeeper.Keeper,
+	authzKeeper cmn.AuthzKeeper,
+) (Precompile, error) {
+	newABI, err := LoadABI()
+	if err != nil {
+		return Precompile{}, err
+	}
+
+	return Precompile{
+		Precompile:    cmn.NewPrecompile(common.HexToAddress(PrecompileAddress), newABI),
+		accountKeeper: accountKeeper,
+		stakingKeeper: stakingKeeper,
+		authzKeeper:   authzKeeper,
+	}, nil
+}
+
+// Run executes the precompiled contract staking methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.Execute(evm, contract, readOnly, p.handle)
+}
+
+// handle dispatches the call to the handler of the method.
+func (p Precompile) handle(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// Approval transactions
+	case ApproveMethod:
+		return p.Approve(ctx, stateDB, contract, method, args)
+	case RevokeMethod:
+		return p.Revoke(ctx, stateDB, contract, method, args)
+	// Staking transactions
+	case DelegateMethod:
+		return p.Delegate(ctx, stateDB, contract, method, args)
+	case UndelegateMethod:
+		return p.Undelegate(ctx, stateDB, contract, method, args)
+	case RedelegateMethod:
+		return p.Redelegate(ctx, stateDB, contract, method, args)
+	case CancelUnbondingDelegationMethod:
+		return p.CancelUnbondingDelegation(ctx, stateDB, contract, method, args)
+	// Staking queries
+	case AllowanceMethod:
+		return p.Allowance(ctx, method, args)
+	case DelegationMethod:
+		return p.Delegation(ctx, method, args)
+	case UnbondingDelegationMethod:
+		return p.UnbondingDelegation(ctx, method, args)
+	case RedelegationMethod:
+		return p.Redelegation(ctx, method, args)
+	case ValidatorMethod:
+		return p.Validator(ctx, method, args)
+	case ValidatorsMethod:
+		return p.Validators(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
@@ -0,0 +1,193 @@
+package staking_test
+
+import (
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/precompiles/staking"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	vestingtypes "github.com/HarryBin2002/kairoschain/v12/x/vesting/types"
+)
+
+func (suite *PrecompileTestSuite) TestDelegate() {
+	var spender common.Address
+
+	testCases := []struct {
+		name string
+		test func()
+	}{
+		{
+			"delegator delegates and the Delegate event is emitted",
+			func() {
+				res := suite.execute(suite.address, staking.DelegateMethod, suite.address, suite.validators[0].OperatorAddress, big.NewInt(1_000))
+				suite.Require().False(res.Failed(), res.VmError)
+				suite.Require().Equal(big.NewInt(1_000), suite.delegationBalance(suite.address, suite.validators[0]))
+
+				suite.Require().Len(res.Logs, 1)
+				suite.Require().Equal(suite.precompile.Events[staking.EventTypeDelegate].ID.String(), res.Logs[0].Topics[0])
+				suite.Require().Equal(common.BytesToHash(suite.address.Bytes()).String(), res.Logs[0].Topics[1])
+				suite.Require().Equal(common.BytesToHash(suite.validators[0].GetOperator()).String(), res.Logs[0].Topics[2])
+			},
+		},
+		{
+			"delegation fails with insufficient balance",
+			func() {
+				suite.callFails(suite.address, staking.DelegateMethod, suite.address, suite.validators[0].OperatorAddress, big.NewInt(1_000_001))
+			},
+		},
+		{
+			"delegation fails with an invalid validator address",
+			func() {
+				suite.callFails(suite.address, staking.DelegateMethod, suite.address, "invalid", big.NewInt(1))
+			},
+		},
+		{
+			"spender cannot delegate without approval",
+			func() {
+				suite.callFails(spender, staking.DelegateMethod, suite.address, suite.validators[0].OperatorAddress, big.NewInt(1))
+			},
+		},
+		{
+			"spender delegates within the approved amount",
+			func() {
+				suite.call(suite.address, staking.ApproveMethod, spender, big.NewInt(100), []string{staking.DelegateMethod})
+				suite.Require().Equal(big.NewInt(100), suite.call(suite.address, staking.AllowanceMethod, suite.address, spender, staking.DelegateMethod)[0])
+
+				suite.call(spender, staking.DelegateMethod, suite.address, suite.validators[0].OperatorAddress, big.NewInt(60))
+				suite.Require().Equal(big.NewInt(60), suite.delegationBalance(suite.address, suite.validators[0]))
+				suite.Require().Equal(big.NewInt(40), suite.call(suite.address, staking.AllowanceMethod, suite.address, spender, staking.DelegateMethod)[0])
+
+				suite.callFails(spender, staking.DelegateMethod, suite.address, suite.validators[0].OperatorAddress, big.NewInt(41))
+				suite.call(spender, staking.DelegateMethod, suite.address, suite.validators[0].OperatorAddress, big.NewInt(40))
+				suite.Require().Equal(int64(0), suite.call(suite.address, staking.AllowanceMethod, suite.address, spender, staking.DelegateMethod)[0].(*big.Int).Int64())
+			},
+		},
+		{
+			"unlimited approval is not decreased and can be revoked",
+			func() {
+				suite.call(suite.address, staking.ApproveMethod, spender, abi.MaxUint256, []string{staking.DelegateMethod, staking.UndelegateMethod})
+				suite.call(spender, staking.DelegateMethod, suite.address, suite.validators[0].OperatorAddress, big.NewInt(60))
+				suite.Require().Equal(abi.MaxUint256, suite.call(suite.address, staking.AllowanceMethod, suite.address, spender, staking.DelegateMethod)[0])
+				suite.Require().Equal(abi.MaxUint256, suite.call(suite.address, staking.AllowanceMethod, suite.address, spender, staking.UndelegateMethod)[0])
+
+				suite.call(suite.address, staking.RevokeMethod, spender, []string{staking.DelegateMethod})
+				suite.Require().Equal(int64(0), suite.call(suite.address, staking.AllowanceMethod, suite.address, spender, staking.DelegateMethod)[0].(*big.Int).Int64())
+				suite.Require().Equal(abi.MaxUint256, suite.call(suite.address, staking.AllowanceMethod, suite.address, spender, staking.UndelegateMethod)[0])
+				suite.callFails(spender, staking.DelegateMethod, suite.address, suite.validators[0].OperatorAddress, big.NewInt(1))
+			},
+		},
+		{
+			"approval of an unknown method fails",
+			func() {
+				suite.callFails(suite.address, staking.ApproveMethod, spender, big.NewInt(1), []string{staking.CancelUnbondingDelegationMethod})
+			},
+		},
+		{
+			"clawback vesting account cannot delegate unvested coins",
+			func() {
+				vestingAddress := utiltx.GenerateAddress()
+				coins := sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(1_000)))
+				period := sdkvesting.Period{Length: int64(time.Hour.Seconds()), Amount: coins}
+
+				account := vestingtypes.NewClawbackVestingAccount(
+					authtypes.NewBaseAccountWithAddress(vestingAddress.Bytes()), suite.address.Bytes(), coins,
+					suite.ctx.BlockTime(), sdkvesting.Periods{period}, sdkvesting.Periods{period},
+				)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, account)
+				suite.fund(vestingAddress, 1_000)
+
+				suite.callFails(vestingAddress, staking.DelegateMethod, vestingAddress, suite.validators[0].OperatorAddress, big.NewInt(1))
+
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+				suite.call(vestingAddress, staking.DelegateMethod, vestingAddress, suite.validators[0].OperatorAddress, big.NewInt(1_000))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			spender = utiltx.GenerateAddress()
+			tc.test()
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestUndelegate() {
+	suite.SetupTest()
+	validator := suite.validators[0]
+	suite.call(suite.address, staking.DelegateMethod, suite.address, validator.OperatorAddress, big.NewInt(1_000))
+
+	out := suite.call(suite.address, staking.UndelegateMethod, suite.address, validator.OperatorAddress, big.NewInt(400))
+	unbondingTime := suite.app.StakingKeeper.UnbondingTime(suite.ctx)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(unbondingTime).Unix(), out[0])
+	suite.Require().Equal(big.NewInt(600), suite.delegationBalance(suite.address, validator))
+
+	out = suite.call(suite.address, staking.UnbondingDelegationMethod, suite.address, validator.OperatorAddress)
+	ubd := abi.ConvertType(out[0], new(staking.UnbondingDelegationOutput)).(*staking.UnbondingDelegationOutput)
+	suite.Require().Len(ubd.Entries, 1)
+	suite.Require().Equal(big.NewInt(400), ubd.Entries[0].Balance)
+
+	suite.call(
+		suite.address, staking.CancelUnbondingDelegationMethod,
+		suite.address, validator.OperatorAddress, big.NewInt(400), big.NewInt(ubd.Entries[0].CreationHeight),
+	)
+	suite.Require().Equal(big.NewInt(1_000), suite.delegationBalance(suite.address, validator))
+
+	out = suite.call(suite.address, staking.UnbondingDelegationMethod, suite.address, validator.OperatorAddress)
+	ubd = abi.ConvertType(out[0], new(staking.UnbondingDelegationOutput)).(*staking.UnbondingDelegationOutput)
+	suite.Require().Empty(ubd.Entries)
+}
+
+func (suite *PrecompileTestSuite) TestRedelegate() {
+	suite.SetupTest()
+	src, dst := suite.validators[0], suite.validators[1]
+	suite.call(suite.address, staking.DelegateMethod, suite.address, src.OperatorAddress, big.NewInt(1_000))
+
+	spender := utiltx.GenerateAddress()
+	suite.callFails(spender, staking.RedelegateMethod, suite.address, src.OperatorAddress, dst.OperatorAddress, big.NewInt(400))
+
+	suite.call(suite.address, staking.ApproveMethod, spender, abi.MaxUint256, []string{staking.RedelegateMethod})
+	suite.call(spender, staking.RedelegateMethod, suite.address, src.OperatorAddress, dst.OperatorAddress, big.NewInt(400))
+	suite.Require().Equal(big.NewInt(600), suite.delegationBalance(suite.address, src))
+	suite.Require().Equal(big.NewInt(400), suite.delegationBalance(suite.address, dst))
+
+	out := suite.call(suite.address, staking.RedelegationMethod, suite.address, src.OperatorAddress, dst.OperatorAddress)
+	red := abi.ConvertType(out[0], new(staking.RedelegationOutput)).(*staking.RedelegationOutput)
+	suite.Require().Equal(src.OperatorAddress, red.ValidatorSrcAddress)
+	suite.Require().Len(red.Entries, 1)
+	suite.Require().Equal(big.NewInt(400), red.Entries[0].InitialBalance)
+}
+
+func (suite *PrecompileTestSuite) TestValidators() {
+	suite.SetupTest()
+	validator := suite.validators[0]
+
+	out := suite.call(suite.address, staking.ValidatorMethod, validator.OperatorAddress)
+	res := abi.ConvertType(out[0], new(staking.Validator)).(*staking.Validator)
+	suite.Require().Equal(validator.OperatorAddress, res.OperatorAddress)
+	suite.Require().Equal(uint8(validator.Status), res.Status)
+	suite.Require().Equal(validator.Tokens.BigInt(), res.Tokens)
+	suite.Require().Equal(validator.DelegatorShares.BigInt(), res.DelegatorShares)
+
+	suite.callFails(suite.address, staking.ValidatorMethod, sdk.ValAddress(utiltx.GenerateAddress().Bytes()).String())
+
+	// genesis validator and the two validators of the suite
+	out = suite.call(suite.address, staking.ValidatorsMethod, "BOND_STATUS_BONDED", cmn.PageRequest{Limit: 2, CountTotal: true})
+	validators := abi.ConvertType(out[0], new([]staking.Validator)).(*[]staking.Validator)
+	pageResponse := abi.ConvertType(out[1], new(cmn.PageResponse)).(*cmn.PageResponse)
+	suite.Require().Len(*validators, 2)
+	suite.Require().Equal(uint64(3), pageResponse.Total)
+	suite.Require().NotEmpty(pageResponse.NextKey)
+
+	suite.callFails(suite.address, staking.ValidatorsMethod, "invalid", cmn.PageRequest{})
+}
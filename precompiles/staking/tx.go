@@ -0,0 +1,212 @@
+package staking
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+	vestingtypes "github.com/HarryBin2002/kairoschain/v12/x/vesting/types"
+)
+
+const (
+	// DelegateMethod defines the ABI method name for the staking delegate transaction.
+	DelegateMethod = "delegate"
+	// UndelegateMethod defines the ABI method name for the staking undelegate transaction.
+	UndelegateMethod = "undelegate"
+	// RedelegateMethod defines the ABI method name for the staking redelegate transaction.
+	RedelegateMethod = "redelegate"
+	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
+	// cancelUnbondingDelegation transaction.
+	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+)
+
+// Delegate delegates the given amount of the bond denomination from the
+// delegator to the validator.
+func (p Precompile) Delegate(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validator, amount, err := parseDelegateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator.String(),
+		Amount:           sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+	}
+
+	if err := p.authorize(ctx, contract.CallerAddress, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.validateVestedDelegation(ctx, delegator, amount); err != nil {
+		return nil, err
+	}
+
+	if _, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Delegate(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitDelegateEvent(ctx, stateDB, delegator, validator, amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Undelegate starts the unbonding of the given amount of the bond denomination
+// delegated by the delegator to the validator. It returns the unix time at
+// which the unbonding completes.
+func (p Precompile) Undelegate(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validator, amount, err := parseDelegateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator.String(),
+		Amount:           sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+	}
+
+	if err := p.authorize(ctx, contract.CallerAddress, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	res, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Undelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime := res.CompletionTime.Unix()
+	if err := p.EmitUnbondEvent(ctx, stateDB, delegator, validator, amount.BigInt(), completionTime); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// Redelegate moves the given amount of the bond denomination delegated by the
+// delegator from the source validator to the destination validator. It returns
+// the unix time at which the redelegation completes.
+func (p Precompile) Redelegate(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validatorSrc, validatorDst, amount, err := parseRedelegateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorSrcAddress: validatorSrc.String(),
+		ValidatorDstAddress: validatorDst.String(),
+		Amount:              sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+	}
+
+	if err := p.authorize(ctx, contract.CallerAddress, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	res, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime := res.CompletionTime.Unix()
+	if err := p.EmitRedelegateEvent(
+		ctx, stateDB, delegator, validatorSrc, validatorDst, amount.BigInt(), completionTime,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// CancelUnbondingDelegation delegates back to the validator the given amount
+// of the unbonding delegation entry created at the given height.
+//
+// As the coins are bonded again, a caller acting on behalf of the delegator is
+// authorized by the delegate approval instead of the undelegate one.
+func (p Precompile) CancelUnbondingDelegation(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegator, validator, amount, creationHeight, err := parseCancelUnbondingDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	amountCoin := sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount)
+	msg := &stakingtypes.MsgCancelUnbondingDelegation{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator.String(),
+		Amount:           amountCoin,
+		CreationHeight:   creationHeight,
+	}
+
+	if err := p.authorize(ctx, contract.CallerAddress, delegator, &stakingtypes.MsgDelegate{
+		DelegatorAddress: msg.DelegatorAddress,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           amountCoin,
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCancelUnbondingDelegationEvent(
+		ctx, stateDB, delegator, validator, amount.BigInt(), creationHeight,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// authorize checks that the caller is allowed to execute the message on behalf
+// of the delegator. No approval is needed if the caller is the delegator.
+func (p Precompile) authorize(ctx sdk.Context, caller, delegator common.Address, msg sdk.Msg) error {
+	if caller == delegator {
+		return nil
+	}
+
+	return cmn.AcceptGrant(ctx, p.authzKeeper, caller, delegator, msg)
+}
+
+// validateVestedDelegation checks that clawback vesting accounts only delegate
+// vested coins, following the rules of the VestingDelegationDecorator.
+func (p Precompile) validateVestedDelegation(ctx sdk.Context, delegator common.Address, amount sdkmath.Int) error {
+	clawbackAccount, isClawback := p.accountKeeper.GetAccount(ctx, delegator.Bytes()).(*vestingtypes.ClawbackVestingAccount)
+	if !isClawback {
+		return nil
+	}
+
+	return clawbackAccount.ValidateDelegation(ctx.BlockTime(), p.stakingKeeper.BondDenom(ctx), amount)
+}
@@ -0,0 +1,396 @@
+package staking
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+)
+
+// AccountKeeper defines the expected account keeper interface of the staking
+// precompile.
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// Validator is the ABI representation of a validator.
+type Validator struct {
+	OperatorAddress   string
+	ConsensusPubkey   string
+	Jailed            bool
+	Status            uint8
+	Tokens            *big.Int
+	DelegatorShares   *big.Int
+	Description       string
+	UnbondingHeight   int64
+	UnbondingTime     int64
+	Commission        *big.Int
+	MinSelfDelegation *big.Int
+}
+
+// NewValidator returns the ABI representation of the validator. Decimal values
+// are returned as integers with 18 decimals.
+func NewValidator(validator stakingtypes.Validator) Validator {
+	var consensusPubkey string
+	if pubKey, err := validator.ConsPubKey(); err == nil {
+		consensusPubkey = base64.StdEncoding.EncodeToString(pubKey.Bytes())
+	}
+
+	return Validator{
+		OperatorAddress:   validator.OperatorAddress,
+		ConsensusPubkey:   consensusPubkey,
+		Jailed:            validator.Jailed,
+		Status:            uint8(validator.Status),
+		Tokens:            validator.Tokens.BigInt(),
+		DelegatorShares:   validator.DelegatorShares.BigInt(),
+		Description:       validator.Description.Moniker,
+		UnbondingHeight:   validator.UnbondingHeight,
+		UnbondingTime:     validator.UnbondingTime.Unix(),
+		Commission:        validator.Commission.Rate.BigInt(),
+		MinSelfDelegation: validator.MinSelfDelegation.BigInt(),
+	}
+}
+
+// UnbondingDelegationEntry is the ABI representation of an unbonding delegation entry.
+type UnbondingDelegationEntry struct {
+	CreationHeight int64
+	CompletionTime int64
+	InitialBalance *big.Int
+	Balance        *big.Int
+}
+
+// UnbondingDelegationOutput is the ABI representation of an unbonding delegation.
+type UnbondingDelegationOutput struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Entries          []UnbondingDelegationEntry
+}
+
+// NewUnbondingDelegationOutput returns the ABI representation of the unbonding delegation.
+func NewUnbondingDelegationOutput(ubd stakingtypes.UnbondingDelegation) UnbondingDelegationOutput {
+	entries := make([]UnbondingDelegationEntry, len(ubd.Entries))
+	for i, entry := range ubd.Entries {
+		entries[i] = UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime.Unix(),
+			InitialBalance: entry.InitialBalance.BigInt(),
+			Balance:        entry.Balance.BigInt(),
+		}
+	}
+
+	return UnbondingDelegationOutput{
+		DelegatorAddress: ubd.DelegatorAddress,
+		ValidatorAddress: ubd.ValidatorAddress,
+		Entries:          entries,
+	}
+}
+
+// RedelegationEntry is the ABI representation of a redelegation entry.
+type RedelegationEntry struct {
+	CreationHeight int64
+	CompletionTime int64
+	InitialBalance *big.Int
+	SharesDst      *big.Int
+}
+
+// RedelegationOutput is the ABI representation of a redelegation.
+type RedelegationOutput struct {
+	DelegatorAddress    string
+	ValidatorSrcAddress string
+	ValidatorDstAddress string
+	Entries             []RedelegationEntry
+}
+
+// NewRedelegationOutput returns the ABI representation of the redelegation.
+func NewRedelegationOutput(red stakingtypes.Redelegation) RedelegationOutput {
+	entries := make([]RedelegationEntry, len(red.Entries))
+	for i, entry := range red.Entries {
+		entries[i] = RedelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime.Unix(),
+			InitialBalance: entry.InitialBalance.BigInt(),
+			SharesDst:      entry.SharesDst.BigInt(),
+		}
+	}
+
+	return RedelegationOutput{
+		DelegatorAddress:    red.DelegatorAddress,
+		ValidatorSrcAddress: red.ValidatorSrcAddress,
+		ValidatorDstAddress: red.ValidatorDstAddress,
+		Entries:             entries,
+	}
+}
+
+// parseAddress returns the address argument at the given position.
+func parseAddress(args []interface{}, i int, name string) (common.Address, error) {
+	address, ok := args[i].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, name, common.Address{}, args[i])
+	}
+	return address, nil
+}
+
+// parseDelegator returns the non-zero delegator address argument at the given position.
+func parseDelegator(args []interface{}, i int) (common.Address, error) {
+	delegator, err := parseAddress(args, i, "delegatorAddress")
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if delegator == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidDelegator, delegator)
+	}
+
+	return delegator, nil
+}
+
+// parseValidator returns the validator operator address argument at the given position.
+func parseValidator(args []interface{}, i int, name string) (sdk.ValAddress, error) {
+	validator, ok := args[i].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, name, "", args[i])
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidValidator, validator, err)
+	}
+
+	return valAddr, nil
+}
+
+// parseAmount returns the positive amount argument at the given position.
+func parseAmount(args []interface{}, i int) (sdkmath.Int, error) {
+	amount, ok := args[i].(*big.Int)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf(cmn.ErrInvalidType, "amount", big.NewInt(0), args[i])
+	}
+
+	if amount.Sign() <= 0 {
+		return sdkmath.Int{}, fmt.Errorf(ErrInvalidAmount, amount)
+	}
+
+	return sdkmath.NewIntFromBigInt(amount), nil
+}
+
+// parseMethods returns the string array of approval methods at the given position.
+func parseMethods(args []interface{}, i int) ([]string, error) {
+	methods, ok := args[i].([]string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "methods", []string{}, args[i])
+	}
+	return methods, nil
+}
+
+// parseDelegateArgs parses the arguments of the delegate and undelegate methods.
+func parseDelegateArgs(args []interface{}) (common.Address, sdk.ValAddress, sdkmath.Int, error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, sdkmath.Int{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	delegator, err := parseDelegator(args, 0)
+	if err != nil {
+		return common.Address{}, nil, sdkmath.Int{}, err
+	}
+
+	validator, err := parseValidator(args, 1, "validatorAddress")
+	if err != nil {
+		return common.Address{}, nil, sdkmath.Int{}, err
+	}
+
+	amount, err := parseAmount(args, 2)
+	if err != nil {
+		return common.Address{}, nil, sdkmath.Int{}, err
+	}
+
+	return delegator, validator, amount, nil
+}
+
+// parseRedelegateArgs parses the arguments of the redelegate method.
+func parseRedelegateArgs(args []interface{}) (
+	delegator common.Address, validatorSrc, validatorDst sdk.ValAddress, amount sdkmath.Int, err error,
+) {
+	if len(args) != 4 {
+		return common.Address{}, nil, nil, sdkmath.Int{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	if delegator, err = parseDelegator(args, 0); err != nil {
+		return common.Address{}, nil, nil, sdkmath.Int{}, err
+	}
+
+	if validatorSrc, err = parseValidator(args, 1, "validatorSrcAddress"); err != nil {
+		return common.Address{}, nil, nil, sdkmath.Int{}, err
+	}
+
+	if validatorDst, err = parseValidator(args, 2, "validatorDstAddress"); err != nil {
+		return common.Address{}, nil, nil, sdkmath.Int{}, err
+	}
+
+	if amount, err = parseAmount(args, 3); err != nil {
+		return common.Address{}, nil, nil, sdkmath.Int{}, err
+	}
+
+	return delegator, validatorSrc, validatorDst, amount, nil
+}
+
+// parseCancelUnbondingDelegationArgs parses the arguments of the cancelUnbondingDelegation method.
+func parseCancelUnbondingDelegationArgs(args []interface{}) (
+	delegator common.Address, validator sdk.ValAddress, amount sdkmath.Int, creationHeight int64, err error,
+) {
+	if len(args) != 4 {
+		return common.Address{}, nil, sdkmath.Int{}, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	if delegator, validator, amount, err = parseDelegateArgs(args[:3]); err != nil {
+		return common.Address{}, nil, sdkmath.Int{}, 0, err
+	}
+
+	height, ok := args[3].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, sdkmath.Int{}, 0, fmt.Errorf(cmn.ErrInvalidType, "creationHeight", big.NewInt(0), args[3])
+	}
+
+	if !height.IsInt64() || height.Int64() <= 0 {
+		return common.Address{}, nil, sdkmath.Int{}, 0, fmt.Errorf(ErrInvalidCreationHeight, height)
+	}
+
+	return delegator, validator, amount, height.Int64(), nil
+}
+
+// parseApproveArgs parses the arguments of the approve method.
+func parseApproveArgs(args []interface{}) (spender common.Address, amount *big.Int, methods []string, err error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	if spender, err = parseAddress(args, 0, "spender"); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "amount", big.NewInt(0), args[1])
+	}
+
+	if methods, err = parseMethods(args, 2); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	return spender, amount, methods, nil
+}
+
+// parseRevokeArgs parses the arguments of the revoke method.
+func parseRevokeArgs(args []interface{}) (spender common.Address, methods []string, err error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	if spender, err = parseAddress(args, 0, "spender"); err != nil {
+		return common.Address{}, nil, err
+	}
+
+	if methods, err = parseMethods(args, 1); err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return spender, methods, nil
+}
+
+// parseAllowanceArgs parses the arguments of the allowance method.
+func parseAllowanceArgs(args []interface{}) (owner, spender common.Address, method string, err error) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	if owner, err = parseAddress(args, 0, "owner"); err != nil {
+		return common.Address{}, common.Address{}, "", err
+	}
+
+	if spender, err = parseAddress(args, 1, "spender"); err != nil {
+		return common.Address{}, common.Address{}, "", err
+	}
+
+	method, ok := args[2].(string)
+	if !ok {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(cmn.ErrInvalidType, "method", "", args[2])
+	}
+
+	return owner, spender, method, nil
+}
+
+// parseDelegationArgs parses the arguments of the delegation and unbondingDelegation methods.
+func parseDelegationArgs(args []interface{}) (common.Address, sdk.ValAddress, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegator, err := parseAddress(args, 0, "delegatorAddress")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	validator, err := parseValidator(args, 1, "validatorAddress")
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return delegator, validator, nil
+}
+
+// parseRedelegationArgs parses the arguments of the redelegation method.
+func parseRedelegationArgs(args []interface{}) (
+	delegator common.Address, validatorSrc, validatorDst sdk.ValAddress, err error,
+) {
+	if len(args) != 3 {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	if delegator, err = parseAddress(args, 0, "delegatorAddress"); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	if validatorSrc, err = parseValidator(args, 1, "validatorSrcAddress"); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	if validatorDst, err = parseValidator(args, 2, "validatorDstAddress"); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	return delegator, validatorSrc, validatorDst, nil
+}
+
+// parseValidatorArgs parses the arguments of the validator method.
+func parseValidatorArgs(args []interface{}) (sdk.ValAddress, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return parseValidator(args, 0, "validatorAddress")
+}
+
+// parseValidatorsArgs parses the arguments of the validators method.
+func parseValidatorsArgs(args []interface{}) (string, cmn.PageRequest, error) {
+	if len(args) != 2 {
+		return "", cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	status, ok := args[0].(string)
+	if !ok {
+		return "", cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidType, "status", "", args[0])
+	}
+
+	pageRequest, err := cmn.ParsePageRequest(args[1])
+	if err != nil {
+		return "", cmn.PageRequest{}, err
+	}
+
+	return status, pageRequest, nil
+}
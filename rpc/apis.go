@@ -9,12 +9,15 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/HarryBin2002/kairoschain/v12/rpc/backend"
+	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/bundler"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/debug"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/eth"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/eth/filters"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/miner"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/net"
+	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/ots"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/personal"
+	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/trace"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/txpool"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/web3"
 	"github.com/HarryBin2002/kairoschain/v12/types"
@@ -37,6 +40,11 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	OtsNamespace      = "ots"
+	// BundlerNamespace enables the ERC-4337 bundler methods, served under the
+	// eth namespace
+	BundlerNamespace = "bundler"
 
 	apiVersion = "1.0"
 )
@@ -113,12 +121,18 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -139,6 +153,38 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		OtsNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		MinerNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
@@ -155,6 +201,22 @@ func init() {
 				},
 			}
 		},
+		BundlerNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   bundler.NewAPI(ctx, clientCtx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
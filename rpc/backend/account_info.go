@@ -3,7 +3,6 @@ package backend
 import (
 	"fmt"
 	"math"
-	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -69,20 +68,26 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	clientCtx := b.clientCtx.WithHeight(height)
 
-	// query storage proofs
-	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
+	// query the storage root and the storage proofs against it
+	storageRes, err := b.queryClient.AccountStorageRoot(ctx, &evmtypes.QueryAccountStorageRootRequest{
+		Address:     address.String(),
+		StorageKeys: storageKeys,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	for i, key := range storageKeys {
-		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
-		if err != nil {
-			return nil, err
+	storageProofs := make([]rpctypes.StorageResult, len(storageRes.StorageProofs))
+	for i, storageProof := range storageRes.StorageProofs {
+		proof := make([]string, len(storageProof.Proof))
+		for j, node := range storageProof.Proof {
+			proof[j] = hexutil.Encode(node)
 		}
 
 		storageProofs[i] = rpctypes.StorageResult{
-			Key:   key,
-			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
+			Key:   storageProof.Key,
+			Value: (*hexutil.Big)(common.HexToHash(storageProof.Value).Big()),
+			Proof: proof,
 		}
 	}
 
@@ -114,7 +119,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Kairoschain doesn't have a storage hash. TODO: implement?
+		StorageHash:  common.HexToHash(storageRes.StorageRoot),
 		StorageProof: storageProofs,
 	}, nil
 }
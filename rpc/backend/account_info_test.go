@@ -7,7 +7,6 @@ import (
 	"github.com/HarryBin2002/kairoschain/v12/rpc/backend/mocks"
 	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
 	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
-	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -98,6 +97,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 				_, err := RegisterBlock(client, bn.Int64(), nil)
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountStorageRoot(queryClient, addr, []string{}, blockNrInvalid.Int64())
 				RegisterAccount(queryClient, addr, blockNrInvalid.Int64())
 			},
 			false,
@@ -127,17 +127,11 @@ func (suite *BackendTestSuite) TestGetProof() {
 				_, err := RegisterBlock(client, bn.Int64(), nil)
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountStorageRoot(queryClient, addr, []string{"0x0"}, bn.Int64())
 				RegisterAccount(queryClient, addr, bn.Int64())
 
 				// Use the IAVL height if a valid tendermint height is passed in.
 				iavlHeight := bn.Int64()
-				RegisterABCIQueryWithOptions(
-					client,
-					bn.Int64(),
-					"store/evm/key",
-					evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
-					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
-				)
 				RegisterABCIQueryWithOptions(
 					client,
 					bn.Int64(),
@@ -153,12 +147,12 @@ func (suite *BackendTestSuite) TestGetProof() {
 				Balance:      (*hexutil.Big)(big.NewInt(0)),
 				CodeHash:     common.HexToHash(""),
 				Nonce:        0x0,
-				StorageHash:  common.Hash{},
+				StorageHash:  common.BigToHash(big.NewInt(1)),
 				StorageProof: []rpctypes.StorageResult{
 					{
 						Key:   "0x0",
 						Value: (*hexutil.Big)(big.NewInt(2)),
-						Proof: []string{""},
+						Proof: []string{"0x01"},
 					},
 				},
 			},
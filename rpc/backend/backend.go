@@ -8,6 +8,7 @@ import (
 	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
 	"github.com/HarryBin2002/kairoschain/v12/server/config"
 	evertypes "github.com/HarryBin2002/kairoschain/v12/types"
+	evmtracers "github.com/HarryBin2002/kairoschain/v12/x/evm/tracers"
 	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	"github.com/cometbft/cometbft/libs/log"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -74,6 +75,8 @@ type EVMBackend interface {
 	BlockNumberFromTendermintByHash(blockHash common.Hash) (*big.Int, error)
 	EthMsgsFromTendermintBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
 	BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error)
+	BlockRandom(blockRes *tmrpctypes.ResultBlockResults) (common.Hash, error)
+	BlockRoots(blockRes *tmrpctypes.ResultBlockResults) (txRoot, receiptRoot common.Hash, err error)
 	HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	RPCBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults, fullTx bool) (map[string]interface{}, error)
@@ -104,6 +107,10 @@ type EVMBackend interface {
 	GetTxByTxIndex(height int64, txIndex uint) (*evertypes.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (*rpctypes.RPCReceipt, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCReceipt, error)
+	ReceiptsFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) ([]*rpctypes.RPCReceipt, error)
+	GetTransactionProof(hash common.Hash) (*rpctypes.InclusionProof, error)
+	GetReceiptProof(hash common.Hash) (*rpctypes.InclusionProof, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
@@ -111,18 +118,38 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (*evmtypes.MsgEthereumTxResponse, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*rpctypes.AccessListResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetIndexedLogs(addresses []common.Address, topics [][]common.Hash, from, to int64, limit int) ([]*ethtypes.Log, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceCallConfig) (interface{}, error)
+	ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.TraceResults, error)
+	ReplayBlockTransactions(block *tmrpctypes.ResultBlock, traceTypes []string) ([]*rpctypes.TraceResults, error)
+	ReplayCall(args evmtypes.TransactionArgs, traceTypes []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.TraceResults, error)
+	TraceFilter(args rpctypes.TraceFilterArgs) ([]evmtracers.FlatCallFrame, error)
+
+	// Tx Pool
+	TxPoolContent() (pending, queued rpctypes.TxPoolTransactions, err error)
+
+	// Otterscan
+	GetInternalOperations(hash common.Hash) ([]*rpctypes.InternalOperation, error)
+	GetTraceEntries(hash common.Hash) ([]*rpctypes.TraceEntry, error)
+	GetTransactionError(hash common.Hash) (hexutil.Bytes, error)
+	GetBlockDetails(blockNum rpctypes.BlockNumber) (map[string]interface{}, error)
+	GetBlockTransactions(blockNum rpctypes.BlockNumber, pageNumber, pageSize uint8) (map[string]interface{}, error)
+	SearchTransactions(address common.Address, blockNumber uint64, pageSize uint16, before bool) (*rpctypes.TransactionsWithReceipts, error)
+	GetTransactionBySenderAndNonce(address common.Address, nonce uint64) (*common.Hash, error)
+	GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error)
 }
 
 var _ BackendI = (*Backend)(nil)
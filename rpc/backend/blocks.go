@@ -298,6 +298,10 @@ func (b *Backend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Heade
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	ethHeader.MixDigest, _ = b.BlockRandom(blockRes)
+	if txRoot, receiptRoot, err := b.BlockRoots(blockRes); err == nil {
+		ethHeader.TxHash, ethHeader.ReceiptHash = txRoot, receiptRoot
+	}
 	return ethHeader, nil
 }
 
@@ -328,6 +332,10 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	ethHeader.MixDigest, _ = b.BlockRandom(blockRes)
+	if txRoot, receiptRoot, err := b.BlockRoots(blockRes); err == nil {
+		ethHeader.TxHash, ethHeader.ReceiptHash = txRoot, receiptRoot
+	}
 	return ethHeader, nil
 }
 
@@ -347,6 +355,56 @@ func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.
 	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
 }
 
+// BlockRandom returns the random value of the block, which is used as the
+// block mix hash. It's queried from the state of the block, the value is
+// parsed from the block results if the state is pruned.
+func (b *Backend) BlockRandom(blockRes *tmrpctypes.ResultBlockResults) (common.Hash, error) {
+	res, err := b.queryClient.BlockRandom(rpctypes.ContextWithHeight(blockRes.Height), &evmtypes.QueryBlockRandomRequest{
+		Height: blockRes.Height,
+	})
+	if err == nil {
+		return common.HexToHash(res.Random), nil
+	}
+
+	for _, event := range blockRes.BeginBlockEvents {
+		if event.Type != evmtypes.EventTypeBlockRandom {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyBlockRandom {
+				return common.HexToHash(attr.Value), nil
+			}
+		}
+	}
+	return common.Hash{}, errors.New("block random event is not found")
+}
+
+// BlockRoots returns the transactions root and the receipts root committed by
+// the EVM module from the block results, they cover the transactions executed
+// by the EVM.
+func (b *Backend) BlockRoots(blockRes *tmrpctypes.ResultBlockResults) (txRoot, receiptRoot common.Hash, err error) {
+	for _, event := range blockRes.EndBlockEvents {
+		if event.Type != evmtypes.EventTypeBlockRoots {
+			continue
+		}
+
+		var txFound, receiptFound bool
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case evmtypes.AttributeKeyTransactionsRoot:
+				txRoot, txFound = common.HexToHash(attr.Value), true
+			case evmtypes.AttributeKeyReceiptsRoot:
+				receiptRoot, receiptFound = common.HexToHash(attr.Value), true
+			}
+		}
+		if txFound && receiptFound {
+			return txRoot, receiptRoot, nil
+		}
+	}
+	return common.Hash{}, common.Hash{}, errors.New("block roots event is not found")
+}
+
 // RPCBlockFromTendermintBlock returns a JSON-RPC compatible Ethereum block from a
 // given Tendermint block and its block result.
 func (b *Backend) RPCBlockFromTendermintBlock(
@@ -494,6 +552,16 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		b.logger,
 	)
 
+	// the mix hash is empty if the random value isn't emitted
+	if random, err := b.BlockRandom(blockRes); err == nil {
+		formattedBlock["mixHash"] = random
+	}
+	// the roots are derived from the block if they aren't committed
+	if txRoot, receiptRoot, err := b.BlockRoots(blockRes); err == nil {
+		formattedBlock["transactionsRoot"] = txRoot
+		formattedBlock["receiptsRoot"] = receiptRoot
+	}
+
 	return formattedBlock, nil
 }
 
@@ -536,6 +604,7 @@ func (b *Backend) EthBlockFromTendermintBlock(
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
+	ethHeader.MixDigest, _ = b.BlockRandom(blockRes)
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
@@ -543,7 +612,15 @@ func (b *Backend) EthBlockFromTendermintBlock(
 		txs[i] = ethMsg.AsTransaction()
 	}
 
-	// TODO: add tx receipts
-	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, nil, trie.NewStackTrie(nil))
-	return ethBlock, nil
+	txRoot, receiptRoot, err := b.BlockRoots(blockRes)
+	if err != nil {
+		// TODO: add tx receipts
+		ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, nil, trie.NewStackTrie(nil))
+		return ethBlock, nil
+	}
+
+	// keep the committed roots, the transactions which exceeded the block gas
+	// limit are not part of them
+	ethHeader.TxHash, ethHeader.ReceiptHash = txRoot, receiptRoot
+	return ethtypes.NewBlockWithHeader(ethHeader).WithBody(txs, nil), nil
 }
@@ -158,6 +158,7 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				RegisterParamsWithoutHeader(queryClient, height)
@@ -185,6 +186,7 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				RegisterParamsWithoutHeader(queryClient, height)
@@ -241,6 +243,7 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
@@ -369,6 +372,7 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				RegisterParamsWithoutHeader(queryClient, height)
@@ -397,6 +401,7 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				RegisterParamsWithoutHeader(queryClient, height)
@@ -424,6 +429,7 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
@@ -987,6 +993,94 @@ func (suite *BackendTestSuite) TestBlockBloom() {
 	}
 }
 
+func (suite *BackendTestSuite) TestBlockRandom() {
+	random := common.BigToHash(big.NewInt(42))
+	testCases := []struct {
+		name         string
+		blockRes     *tmrpctypes.ResultBlockResults
+		registerMock func()
+		expRandom    common.Hash
+		expPass      bool
+	}{
+		{
+			"pass - queried from the state of the block",
+			&tmrpctypes.ResultBlockResults{Height: 1},
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockRandom(queryClient, random)
+			},
+			random,
+			true,
+		},
+		{
+			"fail - pruned state, empty block result",
+			&tmrpctypes.ResultBlockResults{Height: 1},
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockRandomError(queryClient)
+			},
+			common.Hash{},
+			false,
+		},
+		{
+			"fail - pruned state, event emitted at end block",
+			&tmrpctypes.ResultBlockResults{
+				Height: 1,
+				EndBlockEvents: []types.Event{
+					{
+						Type: evmtypes.EventTypeBlockRandom,
+						Attributes: []types.EventAttribute{
+							{Key: evmtypes.AttributeKeyBlockRandom, Value: random.Hex()},
+						},
+					},
+				},
+			},
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockRandomError(queryClient)
+			},
+			common.Hash{},
+			false,
+		},
+		{
+			"pass - pruned state, block random attribute key",
+			&tmrpctypes.ResultBlockResults{
+				Height: 1,
+				BeginBlockEvents: []types.Event{
+					{Type: evmtypes.EventTypeEthereumTx},
+					{
+						Type: evmtypes.EventTypeBlockRandom,
+						Attributes: []types.EventAttribute{
+							{Key: evmtypes.AttributeKeyBlockRandom, Value: random.Hex()},
+						},
+					},
+				},
+			},
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockRandomError(queryClient)
+			},
+			random,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			blockRandom, err := suite.backend.BlockRandom(tc.blockRes)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRandom, blockRandom)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 	msgEthereumTx, _ := suite.buildEthereumTx()
 	msgEthereumTx, bz := suite.signMsgEthTx(msgEthereumTx)
@@ -1018,6 +1112,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			func(baseFee math.Int, validator sdk.AccAddress, height int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -1047,6 +1142,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			func(baseFee math.Int, validator sdk.AccAddress, height int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(queryClient)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -1077,6 +1173,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			func(baseFee math.Int, validator sdk.AccAddress, height int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccountError(queryClient)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -1107,6 +1204,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			func(baseFee math.Int, validator sdk.AccAddress, height int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -1143,6 +1241,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			func(baseFee math.Int, validator sdk.AccAddress, height int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -1172,6 +1271,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			func(baseFee math.Int, validator sdk.AccAddress, height int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -1202,6 +1302,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			func(baseFee math.Int, validator sdk.AccAddress, height int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -1232,6 +1333,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			registerMock: func(baseFee math.Int, validator sdk.AccAddress, height int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -1448,6 +1550,7 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(queryClient)
+				RegisterBlockRandomError(queryClient)
 			},
 			true,
 		},
@@ -1463,6 +1566,7 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 			},
 			true,
 		},
@@ -1478,6 +1582,7 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 			},
 			true,
 		},
@@ -1559,6 +1664,7 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(queryClient)
+				RegisterBlockRandomError(queryClient)
 			},
 			true,
 		},
@@ -1574,6 +1680,7 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 			},
 			true,
 		},
@@ -1589,6 +1696,7 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 			},
 			true,
 		},
@@ -1659,6 +1767,7 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				baseFee := sdk.NewInt(1)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 			},
 			ethtypes.NewBlock(
 				ethrpc.EthHeaderFromTendermint(
@@ -1686,6 +1795,7 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				baseFee := sdk.NewInt(1)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 			},
 			ethtypes.NewBlock(
 				ethrpc.EthHeaderFromTendermint(
@@ -1750,6 +1860,7 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 			func(baseFee math.Int, blockNum int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 			},
 			ethtypes.NewBlock(
 				ethrpc.EthHeaderFromTendermint(
@@ -1785,6 +1896,7 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 			func(baseFee math.Int, blockNum int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 			},
 			ethtypes.NewBlock(
 				ethrpc.EthHeaderFromTendermint(
@@ -1822,3 +1934,62 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestBlockRoots() {
+	txRoot := common.BigToHash(big.NewInt(1))
+	receiptRoot := common.BigToHash(big.NewInt(2))
+	testCases := []struct {
+		name     string
+		blockRes *tmrpctypes.ResultBlockResults
+		expPass  bool
+	}{
+		{
+			"fail - empty block result",
+			&tmrpctypes.ResultBlockResults{},
+			false,
+		},
+		{
+			"fail - missing receipts root",
+			&tmrpctypes.ResultBlockResults{
+				EndBlockEvents: []types.Event{
+					{
+						Type: evmtypes.EventTypeBlockRoots,
+						Attributes: []types.EventAttribute{
+							{Key: evmtypes.AttributeKeyTransactionsRoot, Value: txRoot.Hex()},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"pass - block roots attribute keys",
+			&tmrpctypes.ResultBlockResults{
+				EndBlockEvents: []types.Event{
+					{Type: evmtypes.EventTypeBlockBloom},
+					{
+						Type: evmtypes.EventTypeBlockRoots,
+						Attributes: []types.EventAttribute{
+							{Key: evmtypes.AttributeKeyTransactionsRoot, Value: txRoot.Hex()},
+							{Key: evmtypes.AttributeKeyReceiptsRoot, Value: receiptRoot.Hex()},
+						},
+					},
+				},
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			resTxRoot, resReceiptRoot, err := suite.backend.BlockRoots(tc.blockRes)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(txRoot, resTxRoot)
+				suite.Require().Equal(receiptRoot, resReceiptRoot)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
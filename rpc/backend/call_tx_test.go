@@ -67,6 +67,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFeeDisabled(queryClient)
+				RegisterBlockRandomError(queryClient)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
@@ -92,6 +93,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
@@ -114,6 +116,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFeeDisabled(queryClient)
+				RegisterBlockRandomError(queryClient)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
@@ -170,6 +173,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
@@ -196,6 +200,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
@@ -220,6 +225,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParamsWithoutHeader(queryClient, 1)
 				RegisterUnconfirmedTxsError(client, nil)
@@ -251,6 +257,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParamsWithoutHeader(queryClient, 1)
 				RegisterUnconfirmedTxsEmpty(client, nil)
@@ -432,13 +439,27 @@ func (suite *BackendTestSuite) TestDoCall() {
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
 
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	overrides := rpctypes.StateOverride{
+		toAddr: rpctypes.OverrideAccount{Balance: &balance},
+	}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+
+	blockTime := hexutil.Uint64(1000)
+	blockOverrides := rpctypes.BlockOverrides{Time: &blockTime}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	suite.Require().NoError(err)
+
 	testCases := []struct {
-		name         string
-		registerMock func()
-		blockNum     rpctypes.BlockNumber
-		callArgs     evmtypes.TransactionArgs
-		expEthTx     *evmtypes.MsgEthereumTxResponse
-		expPass      bool
+		name           string
+		registerMock   func()
+		blockNum       rpctypes.BlockNumber
+		callArgs       evmtypes.TransactionArgs
+		overrides      *rpctypes.StateOverride
+		blockOverrides *rpctypes.BlockOverrides
+		expEthTx       *evmtypes.MsgEthereumTxResponse
+		expPass        bool
 	}{
 		{
 			"fail - Invalid request",
@@ -451,6 +472,8 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			nil,
 			&evmtypes.MsgEthereumTxResponse{},
 			false,
 		},
@@ -465,6 +488,29 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - Returned transaction response with overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{
+					Args:           argsBz,
+					ChainId:        suite.backend.chainID.Int64(),
+					Overrides:      overridesBz,
+					BlockOverrides: blockOverridesBz,
+				})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			&overrides,
+			&blockOverrides,
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
@@ -475,7 +521,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides, tc.blockOverrides)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -486,6 +532,92 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	overrides := rpctypes.StateOverride{
+		toAddr: rpctypes.OverrideAccount{Balance: &balance},
+	}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+
+	slot := common.BigToHash(big.NewInt(1))
+	accessList := []evmtypes.AccessTuple{{Address: toAddr.Hex(), StorageKeys: []string{slot.Hex()}}}
+
+	testCases := []struct {
+		name          string
+		registerMock  func()
+		overrides     *rpctypes.StateOverride
+		expAccessList ethtypes.AccessList
+		expPass       bool
+	}{
+		{
+			"fail - invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessListError(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()}, nil)
+			},
+			nil,
+			ethtypes.AccessList{},
+			true,
+		},
+		{
+			"pass - access list with overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, &evmtypes.EthCallRequest{
+					Args:      argsBz,
+					ChainId:   suite.backend.chainID.Int64(),
+					Overrides: overridesBz,
+				}, accessList)
+			},
+			&overrides,
+			ethtypes.AccessList{{Address: toAddr, StorageKeys: []common.Hash{slot}}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.CreateAccessList(callArgs, rpctypes.BlockNumber(1), tc.overrides)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&tc.expAccessList, res.Accesslist)
+				suite.Require().Equal(hexutil.Uint64(21000), res.GasUsed)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -507,6 +639,7 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterBlockRandomError(queryClient)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
@@ -526,6 +659,7 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterBlockRandomError(queryClient)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
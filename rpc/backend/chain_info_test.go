@@ -404,6 +404,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFeeError(queryClient)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, 1)
 
@@ -430,6 +431,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
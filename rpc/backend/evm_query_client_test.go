@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/HarryBin2002/kairoschain/v12/constants"
+	"math/big"
 	"strconv"
 	"testing"
 
@@ -65,6 +66,17 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -152,6 +164,19 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Create Access List
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, accessList []evmtypes.AccessTuple) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("CreateAccessList", ctx, request).
+		Return(&evmtypes.QueryCreateAccessListResponse{AccessList: accessList, GasUsed: 21000}, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("CreateAccessList", ctx, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -202,6 +227,35 @@ func TestRegisterBaseFeeDisabled(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// BlockRandom
+func RegisterBlockRandom(queryClient *mocks.EVMQueryClient, random common.Hash) {
+	queryClient.On("BlockRandom", rpc.ContextWithHeight(1), &evmtypes.QueryBlockRandomRequest{Height: 1}).
+		Return(&evmtypes.QueryBlockRandomResponse{Random: random.Hex()}, nil)
+}
+
+// Block random returns error, the state is pruned
+func RegisterBlockRandomError(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("BlockRandom", rpc.ContextWithHeight(1), &evmtypes.QueryBlockRandomRequest{Height: 1}).
+		Return(nil, status.Error(codes.NotFound, "random value not found"))
+}
+
+func TestRegisterBlockRandom(t *testing.T) {
+	random := common.BigToHash(big.NewInt(1))
+	queryClient := mocks.NewEVMQueryClient(t)
+	RegisterBlockRandom(queryClient, random)
+	res, err := queryClient.BlockRandom(rpc.ContextWithHeight(1), &evmtypes.QueryBlockRandomRequest{Height: 1})
+	require.Equal(t, &evmtypes.QueryBlockRandomResponse{Random: random.Hex()}, res)
+	require.NoError(t, err)
+}
+
+func TestRegisterBlockRandomError(t *testing.T) {
+	queryClient := mocks.NewEVMQueryClient(t)
+	RegisterBlockRandomError(queryClient)
+	res, err := queryClient.BlockRandom(rpc.ContextWithHeight(1), &evmtypes.QueryBlockRandomRequest{Height: 1})
+	require.Nil(t, res)
+	require.Error(t, err)
+}
+
 // ValidatorAccount
 func RegisterValidatorAccount(queryClient *mocks.EVMQueryClient, validator sdk.AccAddress) {
 	queryClient.On("ValidatorAccount", rpc.ContextWithHeight(1), &evmtypes.QueryValidatorAccountRequest{}).
@@ -256,6 +310,25 @@ func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, hei
 		)
 }
 
+// AccountStorageRoot
+func RegisterAccountStorageRoot(queryClient *mocks.EVMQueryClient, addr common.Address, storageKeys []string, height int64) {
+	proofs := make([]evmtypes.StorageProof, len(storageKeys))
+	for i, key := range storageKeys {
+		proofs[i] = evmtypes.StorageProof{
+			Key:   key,
+			Value: common.HexToHash("0x2").Hex(),
+			Proof: [][]byte{{0x01}},
+		}
+	}
+	queryClient.On("AccountStorageRoot", rpc.ContextWithHeight(height), &evmtypes.QueryAccountStorageRootRequest{Address: addr.String(), StorageKeys: storageKeys}).
+		Return(&evmtypes.QueryAccountStorageRootResponse{
+			StorageRoot:   common.HexToHash("0x1").Hex(),
+			StorageProofs: proofs,
+		},
+			nil,
+		)
+}
+
 // Balance
 func RegisterBalance(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Balance", rpc.ContextWithHeight(height), &evmtypes.QueryBalanceRequest{Address: addr.String()}).
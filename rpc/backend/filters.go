@@ -29,6 +29,29 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetIndexedLogs returns the logs matching the addresses and the topics within the block range
+// from the indexer, it returns false if the logs of the block range are not indexed. The search
+// stops once more than limit logs are found.
+func (b *Backend) GetIndexedLogs(addresses []common.Address, topics [][]common.Hash, from, to int64, limit int) ([]*ethtypes.Log, bool, error) {
+	first, err := b.indexer.FirstLogIndexedBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	last, err := b.indexer.GetLastRequestIndexedBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	if first < 0 || from < first || to > last {
+		return nil, false, nil
+	}
+
+	logs, err := b.indexer.GetLogs(addresses, topics, from, to, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	return logs, true, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
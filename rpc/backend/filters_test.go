@@ -2,6 +2,7 @@ package backend
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/HarryBin2002/kairoschain/v12/rpc/backend/mocks"
 	ethrpc "github.com/HarryBin2002/kairoschain/v12/rpc/types"
@@ -92,6 +93,95 @@ func (suite *BackendTestSuite) TestGetLogs() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetIndexedLogs() {
+	addresses := []common.Address{common.BigToAddress(common.Big1)}
+	logs := []*ethtypes.Log{{Address: addresses[0], BlockNumber: 2}}
+	registerRange := func(first, last int64) {
+		indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+		indexer.On("FirstLogIndexedBlock").Return(first, nil)
+		indexer.On("GetLastRequestIndexedBlock").Return(last, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		from, to     int64
+		expLogs      []*ethtypes.Log
+		expIndexed   bool
+		expPass      bool
+	}{
+		{
+			"pass - no logs indexed",
+			func() { registerRange(-1, 10) },
+			1,
+			10,
+			nil,
+			false,
+			true,
+		},
+		{
+			"pass - range starts before the first indexed block",
+			func() { registerRange(2, 10) },
+			1,
+			10,
+			nil,
+			false,
+			true,
+		},
+		{
+			"pass - range ends after the last indexed block",
+			func() { registerRange(1, 9) },
+			1,
+			10,
+			nil,
+			false,
+			true,
+		},
+		{
+			"fail - indexer error",
+			func() {
+				registerRange(1, 10)
+				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+				indexer.On("GetLogs", addresses, [][]common.Hash(nil), int64(1), int64(10), 100).Return(nil, errors.New("db error"))
+			},
+			1,
+			10,
+			nil,
+			false,
+			false,
+		},
+		{
+			"pass - indexed logs",
+			func() {
+				registerRange(1, 10)
+				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+				indexer.On("GetLogs", addresses, [][]common.Hash(nil), int64(1), int64(10), 100).Return(logs, nil)
+			},
+			1,
+			10,
+			logs,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			res, indexed, err := suite.backend.GetIndexedLogs(addresses, nil, tc.from, tc.to, 100)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expIndexed, indexed)
+			suite.Require().Equal(tc.expLogs, res)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestBloomStatus() {
 	testCases := []struct {
 		name         string
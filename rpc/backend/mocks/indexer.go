@@ -8,6 +8,8 @@ import (
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/HarryBin2002/kairoschain/v12/types"
@@ -18,6 +20,34 @@ type EVMTxIndexer struct {
 	mock.Mock
 }
 
+// FirstLogIndexedBlock provides a mock function with given fields:
+func (_m *EVMTxIndexer) FirstLogIndexedBlock() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FirstLogIndexedBlock")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByBlockAndIndex provides a mock function with given fields: _a0, _a1
 func (_m *EVMTxIndexer) GetByBlockAndIndex(_a0 int64, _a1 int32) (*types.TxResult, error) {
 	ret := _m.Called(_a0, _a1)
@@ -78,6 +108,36 @@ func (_m *EVMTxIndexer) GetByTxHash(_a0 common.Hash) (*types.TxResult, error) {
 	return r0, r1
 }
 
+// GetContractCreator provides a mock function with given fields: _a0
+func (_m *EVMTxIndexer) GetContractCreator(_a0 common.Address) (*types.ContractCreator, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContractCreator")
+	}
+
+	var r0 *types.ContractCreator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Address) (*types.ContractCreator, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address) *types.ContractCreator); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ContractCreator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastIndexedBlock provides a mock function with given fields:
 func (_m *EVMTxIndexer) GetLastRequestIndexedBlock() (int64, error) {
 	ret := _m.Called()
@@ -106,6 +166,96 @@ func (_m *EVMTxIndexer) GetLastRequestIndexedBlock() (int64, error) {
 	return r0, r1
 }
 
+// GetLogs provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *EVMTxIndexer) GetLogs(_a0 []common.Address, _a1 [][]common.Hash, _a2 int64, _a3 int64, _a4 int) ([]*coretypes.Log, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLogs")
+	}
+
+	var r0 []*coretypes.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]common.Address, [][]common.Hash, int64, int64, int) ([]*coretypes.Log, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func([]common.Address, [][]common.Hash, int64, int64, int) []*coretypes.Log); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coretypes.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]common.Address, [][]common.Hash, int64, int64, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTxHashBySenderAndNonce provides a mock function with given fields: _a0, _a1
+func (_m *EVMTxIndexer) GetTxHashBySenderAndNonce(_a0 common.Address, _a1 uint64) (*common.Hash, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxHashBySenderAndNonce")
+	}
+
+	var r0 *common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Address, uint64) (*common.Hash, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address, uint64) *common.Hash); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTxHashesByAddresses provides a mock function with given fields: _a0, _a1, _a2
+func (_m *EVMTxIndexer) GetTxHashesByAddresses(_a0 []common.Address, _a1 int64, _a2 int64) ([]common.Hash, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxHashesByAddresses")
+	}
+
+	var r0 []common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]common.Address, int64, int64) ([]common.Hash, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func([]common.Address, int64, int64) []common.Hash); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]common.Address, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IndexBlock provides a mock function with given fields: _a0, _a1
 func (_m *EVMTxIndexer) IndexBlock(_a0 *cometbfttypes.Block, _a1 []*abcitypes.ResponseDeliverTx) error {
 	ret := _m.Called(_a0, _a1)
@@ -180,6 +330,43 @@ type mockConstructorTestingTNewEVMTxIndexer interface {
 	Cleanup(func())
 }
 
+// SearchTxHashesByAddress provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *EVMTxIndexer) SearchTxHashesByAddress(_a0 common.Address, _a1 int64, _a2 int, _a3 bool) ([]common.Hash, bool, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTxHashesByAddress")
+	}
+
+	var r0 []common.Hash
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(common.Address, int64, int, bool) ([]common.Hash, bool, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address, int64, int, bool) []common.Hash); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address, int64, int, bool) bool); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(common.Address, int64, int, bool) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewEVMTxIndexer creates a new instance of EVMTxIndexer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEVMTxIndexer(t mockConstructorTestingTNewEVMTxIndexer) *EVMTxIndexer {
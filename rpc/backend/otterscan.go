@@ -0,0 +1,373 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/pkg/errors"
+
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	evmtracers "github.com/HarryBin2002/kairoschain/v12/x/evm/tracers"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// callFrame is a call of the callTracer result.
+type callFrame struct {
+	Type   string          `json:"type"`
+	From   common.Address  `json:"from"`
+	To     *common.Address `json:"to"`
+	Input  hexutil.Bytes   `json:"input"`
+	Output hexutil.Bytes   `json:"output"`
+	Error  string          `json:"error"`
+	Value  *hexutil.Big    `json:"value"`
+	Calls  []callFrame     `json:"calls"`
+}
+
+// GetInternalOperations returns the value transfers, the self destructs and
+// the contract creations performed by the internal calls of the transaction.
+func (b *Backend) GetInternalOperations(hash common.Hash) ([]*rpctypes.InternalOperation, error) {
+	frame, err := b.callTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+	return internalOperations(frame), nil
+}
+
+// GetTraceEntries returns the calls of the transaction in execution order.
+func (b *Backend) GetTraceEntries(hash common.Hash) ([]*rpctypes.TraceEntry, error) {
+	frame, err := b.callTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+	return traceEntries(frame), nil
+}
+
+// GetTransactionError returns the revert data of the transaction, it's empty
+// if the transaction succeeded.
+func (b *Backend) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	frame, err := b.callTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// GetBlockDetails returns the block without its transactions, along with the
+// transaction count, the issuance and the total fees paid by the transactions.
+func (b *Backend) GetBlockDetails(blockNum rpctypes.BlockNumber) (map[string]interface{}, error) {
+	resBlock, blockRes, err := b.otsBlock(blockNum)
+	if err != nil || resBlock == nil {
+		return nil, err
+	}
+
+	block, err := b.RPCBlockFromTendermintBlock(resBlock, blockRes, false)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := b.ReceiptsFromTendermintBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	totalFees := new(big.Int)
+	for i, msg := range msgs {
+		receipt := receipts[i]
+		txData, err := evmtypes.UnpackTxData(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		price := txData.GetGasPrice()
+		if receipt.EffectiveGasPrice != nil {
+			price = receipt.EffectiveGasPrice.ToInt()
+		}
+		totalFees.Add(totalFees, new(big.Int).Mul(price, new(big.Int).SetUint64(uint64(receipt.GasUsed))))
+	}
+
+	delete(block, "transactions")
+	block["transactionCount"] = len(msgs)
+	block["logsBloom"] = nil
+
+	// there is no block reward nor uncles in Tendermint
+	return map[string]interface{}{
+		"block": block,
+		"issuance": map[string]interface{}{
+			"blockReward": (*hexutil.Big)(big.NewInt(0)),
+			"uncleReward": (*hexutil.Big)(big.NewInt(0)),
+			"issuance":    (*hexutil.Big)(big.NewInt(0)),
+		},
+		"totalFees": (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// GetBlockTransactions returns the block with a page of its transactions and
+// their receipts, the inputs of the transactions are truncated to the method
+// selector and the logs of the receipts are omitted.
+func (b *Backend) GetBlockTransactions(blockNum rpctypes.BlockNumber, pageNumber, pageSize uint8) (map[string]interface{}, error) {
+	resBlock, blockRes, err := b.otsBlock(blockNum)
+	if err != nil || resBlock == nil {
+		return nil, err
+	}
+
+	block, err := b.RPCBlockFromTendermintBlock(resBlock, blockRes, true)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	start := int(pageNumber) * int(pageSize)
+	end := start + int(pageSize)
+	if start > len(txs) {
+		start = len(txs)
+	}
+	if end > len(txs) {
+		end = len(txs)
+	}
+
+	receipts, err := b.ReceiptsFromTendermintBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) != len(txs) {
+		return nil, fmt.Errorf("invalid number of receipts, expected %d, got %d", len(txs), len(receipts))
+	}
+	receipts = receipts[start:end]
+
+	pageTxs := make([]interface{}, 0, end-start)
+	for i, tx := range txs[start:end] {
+		rpcTx, ok := tx.(*rpctypes.RPCTransaction)
+		if !ok {
+			return nil, fmt.Errorf("invalid transaction type %T", tx)
+		}
+		receipts[i].Logs = nil
+
+		if len(rpcTx.Input) > 4 {
+			rpcTx.Input = rpcTx.Input[:4]
+		}
+		pageTxs = append(pageTxs, rpcTx)
+	}
+
+	block["transactions"] = pageTxs
+	block["transactionCount"] = len(txs)
+	block["logsBloom"] = nil
+
+	return map[string]interface{}{
+		"fullblock": block,
+		"receipts":  receipts,
+	}, nil
+}
+
+// SearchTransactions returns a page of the transactions sent by or to the
+// address before the block number, or after it, newest first. A zero block
+// number searches from the latest block before it and from the genesis
+// after it.
+func (b *Backend) SearchTransactions(
+	address common.Address,
+	blockNumber uint64,
+	pageSize uint16,
+	before bool,
+) (*rpctypes.TransactionsWithReceipts, error) {
+	if blockNumber > math.MaxInt64 {
+		return nil, fmt.Errorf("block number %d is overflowing", blockNumber)
+	}
+
+	number := int64(blockNumber)
+	if before && blockNumber == 0 {
+		number = math.MaxInt64
+	}
+	hashes, hasMore, err := b.indexer.SearchTxHashesByAddress(address, number, int(pageSize), before)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.TransactionsWithReceipts{
+		Txs:      []*rpctypes.RPCTransaction{},
+		Receipts: []*rpctypes.OtsReceipt{},
+	}
+	if before {
+		result.FirstPage = blockNumber == 0
+		result.LastPage = !hasMore
+	} else {
+		result.FirstPage = !hasMore
+		result.LastPage = blockNumber == 0
+		for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+			hashes[i], hashes[j] = hashes[j], hashes[i]
+		}
+	}
+
+	timestamps := make(map[uint64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := b.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash)
+		}
+
+		height := uint64(receipt.BlockNumber)
+		timestamp, ok := timestamps[height]
+		if !ok {
+			resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil {
+				return nil, fmt.Errorf("block %d not found", height)
+			}
+			timestamp = hexutil.Uint64(resBlock.Block.Time.Unix())
+			timestamps[height] = timestamp
+		}
+
+		result.Txs = append(result.Txs, tx)
+		result.Receipts = append(result.Receipts, &rpctypes.OtsReceipt{RPCReceipt: receipt, Timestamp: timestamp})
+	}
+	return result, nil
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the address with the nonce, nil if not found.
+func (b *Backend) GetTransactionBySenderAndNonce(address common.Address, nonce uint64) (*common.Hash, error) {
+	return b.indexer.GetTxHashBySenderAndNonce(address, nonce)
+}
+
+// GetContractCreator returns the transaction which created the contract and
+// its sender, nil if the address is not a contract or its creation isn't
+// indexed. Only the contracts created by a transaction are indexed.
+func (b *Backend) GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error) {
+	latest := rpctypes.EthLatestBlockNumber
+	code, err := b.GetCode(address, rpctypes.BlockNumberOrHash{BlockNumber: &latest})
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, nil
+	}
+
+	creator, err := b.indexer.GetContractCreator(address)
+	if err != nil || creator == nil {
+		return nil, err
+	}
+	return &rpctypes.ContractCreator{
+		Hash:    creator.TxHash,
+		Creator: creator.Creator,
+	}, nil
+}
+
+// callTrace traces the transaction with the callTracer.
+func (b *Backend) callTrace(hash common.Hash) (*callFrame, error) {
+	res, err := b.traceTransaction(hash, &evmtypes.TraceConfig{Tracer: evmtracers.CallTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	var frame callFrame
+	if err := json.Unmarshal(res.Data, &frame); err != nil {
+		return nil, errors.Wrap(err, "failed to decode call trace")
+	}
+	return &frame, nil
+}
+
+// otsBlock returns the block and the block results of the block number, nil
+// if the block is not found.
+func (b *Backend) otsBlock(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, *tmrpctypes.ResultBlockResults, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, nil, nil
+	}
+	// return if requested block height is greater than the current one
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resBlock, blockRes, nil
+}
+
+// internalOperations collects the internal operations of the call tree, the
+// operations of the reverted calls are discarded.
+func internalOperations(frame *callFrame) []*rpctypes.InternalOperation {
+	ops := []*rpctypes.InternalOperation{}
+	if frame.Error != "" {
+		return ops
+	}
+
+	var collect func(calls []callFrame)
+	collect = func(calls []callFrame) {
+		for i := range calls {
+			call := &calls[i]
+			if call.Error != "" {
+				continue
+			}
+
+			op := &rpctypes.InternalOperation{From: call.From, Value: call.Value}
+			if call.To != nil {
+				op.To = *call.To
+			}
+			switch call.Type {
+			case vm.CALL.String():
+				if call.Value != nil && call.Value.ToInt().Sign() > 0 {
+					op.Type = rpctypes.OpTransfer
+					ops = append(ops, op)
+				}
+			case vm.SELFDESTRUCT.String():
+				op.Type = rpctypes.OpSelfDestruct
+				ops = append(ops, op)
+			case vm.CREATE.String():
+				op.Type = rpctypes.OpCreate
+				ops = append(ops, op)
+			case vm.CREATE2.String():
+				op.Type = rpctypes.OpCreate2
+				ops = append(ops, op)
+			}
+			collect(call.Calls)
+		}
+	}
+	collect(frame.Calls)
+	return ops
+}
+
+// traceEntries flattens the call tree in execution order.
+func traceEntries(frame *callFrame) []*rpctypes.TraceEntry {
+	entries := []*rpctypes.TraceEntry{}
+
+	var collect func(call *callFrame, depth int)
+	collect = func(call *callFrame, depth int) {
+		entry := &rpctypes.TraceEntry{
+			Type:   call.Type,
+			Depth:  depth,
+			From:   call.From,
+			Value:  call.Value,
+			Input:  call.Input,
+			Output: call.Output,
+		}
+		if call.To != nil {
+			entry.To = *call.To
+		}
+		entries = append(entries, entry)
+
+		for i := range call.Calls {
+			collect(&call.Calls[i], depth+1)
+		}
+	}
+	collect(frame, 0)
+	return entries
+}
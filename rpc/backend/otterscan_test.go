@@ -0,0 +1,166 @@
+package backend
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/HarryBin2002/kairoschain/v12/rpc/backend/mocks"
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	"github.com/HarryBin2002/kairoschain/v12/types"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+const callTraceResult = `{
+	"type": "CALL", "from": "0x0000000000000000000000000000000000000001", "to": "0x0000000000000000000000000000000000000002",
+	"input": "0x01", "output": "0x02", "value": "0x0",
+	"calls": [
+		{"type": "CALL", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000003", "input": "0x", "value": "0x5"},
+		{"type": "CALL", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000003", "input": "0x", "value": "0x0"},
+		{"type": "STATICCALL", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000004", "input": "0x03", "output": "0x04"},
+		{
+			"type": "CREATE2", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000005", "input": "0x60", "value": "0x1",
+			"calls": [
+				{"type": "SELFDESTRUCT", "from": "0x0000000000000000000000000000000000000005", "to": "0x0000000000000000000000000000000000000001", "input": "0x", "value": "0x1"}
+			]
+		},
+		{
+			"type": "CALL", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000006", "input": "0x", "value": "0x2", "error": "execution reverted",
+			"calls": [
+				{"type": "CREATE", "from": "0x0000000000000000000000000000000000000006", "to": "0x0000000000000000000000000000000000000007", "input": "0x60", "value": "0x0"}
+			]
+		}
+	]
+}`
+
+func (suite *BackendTestSuite) TestInternalOperations() {
+	var frame callFrame
+	suite.Require().NoError(json.Unmarshal([]byte(callTraceResult), &frame))
+
+	ops := internalOperations(&frame)
+	suite.Require().Equal([]*rpctypes.InternalOperation{
+		{Type: rpctypes.OpTransfer, From: common.BigToAddress(big.NewInt(2)), To: common.BigToAddress(big.NewInt(3)), Value: (*hexutil.Big)(big.NewInt(5))},
+		{Type: rpctypes.OpCreate2, From: common.BigToAddress(big.NewInt(2)), To: common.BigToAddress(big.NewInt(5)), Value: (*hexutil.Big)(big.NewInt(1))},
+		{Type: rpctypes.OpSelfDestruct, From: common.BigToAddress(big.NewInt(5)), To: common.BigToAddress(big.NewInt(1)), Value: (*hexutil.Big)(big.NewInt(1))},
+	}, ops)
+
+	// the operations of a reverted transaction are discarded
+	frame.Error = "execution reverted"
+	suite.Require().Empty(internalOperations(&frame))
+}
+
+func (suite *BackendTestSuite) TestTraceEntries() {
+	var frame callFrame
+	suite.Require().NoError(json.Unmarshal([]byte(callTraceResult), &frame))
+
+	entries := traceEntries(&frame)
+	suite.Require().Len(entries, 8)
+
+	var (
+		callTypes []string
+		depths    []int
+	)
+	for _, entry := range entries {
+		callTypes = append(callTypes, entry.Type)
+		depths = append(depths, entry.Depth)
+	}
+	suite.Require().Equal([]string{"CALL", "CALL", "CALL", "STATICCALL", "CREATE2", "SELFDESTRUCT", "CALL", "CREATE"}, callTypes)
+	suite.Require().Equal([]int{0, 1, 1, 1, 1, 2, 1, 2}, depths)
+
+	suite.Require().Equal(&rpctypes.TraceEntry{
+		Type:   "STATICCALL",
+		Depth:  1,
+		From:   common.BigToAddress(big.NewInt(2)),
+		To:     common.BigToAddress(big.NewInt(4)),
+		Input:  hexutil.Bytes{0x03},
+		Output: hexutil.Bytes{0x04},
+	}, entries[3])
+}
+
+func (suite *BackendTestSuite) TestGetContractCreator() {
+	contract := common.BigToAddress(big.NewInt(10))
+	creator := &types.ContractCreator{TxHash: common.BigToHash(big.NewInt(1)), Creator: common.BigToAddress(big.NewInt(1))}
+	registerCode := func(code []byte) {
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		queryClient.On("Code", rpctypes.ContextWithHeight(0), &evmtypes.QueryCodeRequest{Address: contract.String()}).
+			Return(&evmtypes.QueryCodeResponse{Code: code}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expCreator   *rpctypes.ContractCreator
+	}{
+		{
+			"pass - not a contract",
+			func() { registerCode(nil) },
+			nil,
+		},
+		{
+			"pass - contract creation not indexed",
+			func() {
+				registerCode([]byte{0x60})
+				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+				indexer.On("GetContractCreator", contract).Return(nil, nil)
+			},
+			nil,
+		},
+		{
+			"pass - contract creator",
+			func() {
+				registerCode([]byte{0x60})
+				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+				indexer.On("GetContractCreator", contract).Return(creator, nil)
+			},
+			&rpctypes.ContractCreator{Hash: creator.TxHash, Creator: creator.Creator},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			res, err := suite.backend.GetContractCreator(contract)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCreator, res)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSearchTransactionsPages() {
+	address := common.BigToAddress(big.NewInt(1))
+
+	testCases := []struct {
+		name         string
+		blockNumber  uint64
+		before       bool
+		searchNumber int64
+		hasMore      bool
+		expFirstPage bool
+		expLastPage  bool
+	}{
+		{"before latest block", 0, true, math.MaxInt64, true, true, false},
+		{"before block, exhausted", 10, true, 10, false, false, true},
+		{"after genesis", 0, false, 0, true, false, true},
+		{"after block, exhausted", 10, false, 10, false, true, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+			indexer.On("SearchTxHashesByAddress", address, tc.searchNumber, 25, tc.before).Return([]common.Hash{}, tc.hasMore, nil)
+
+			res, err := suite.backend.SearchTransactions(address, tc.blockNumber, 25, tc.before)
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.Txs)
+			suite.Require().Empty(res.Receipts)
+			suite.Require().Equal(tc.expFirstPage, res.FirstPage)
+			suite.Require().Equal(tc.expLastPage, res.LastPage)
+		})
+	}
+}
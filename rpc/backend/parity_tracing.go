@@ -0,0 +1,394 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	evmtracers "github.com/HarryBin2002/kairoschain/v12/x/evm/tracers"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// ReplayTransaction replays the transaction and returns the requested parity
+// trace types, the supported types are `trace` and `stateDiff`.
+func (b *Backend) ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.TraceResults, error) {
+	config, err := parityTraceConfig(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.traceTransaction(hash, config)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := parseTraceResults(res.Data, traceTypes)
+	if err != nil {
+		return nil, err
+	}
+	results.TransactionHash = &hash
+	return results, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the block and
+// returns the requested parity trace types of each one of them.
+func (b *Backend) ReplayBlockTransactions(block *tmrpctypes.ResultBlock, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	config, err := parityTraceConfig(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(block.Block.Txs) == 0 {
+		return []*rpctypes.TraceResults{}, nil
+	}
+
+	res, txs, err := b.traceBlock(rpctypes.BlockNumber(block.Block.Height), config, block)
+	if err != nil {
+		return nil, err
+	}
+
+	var txResults []struct {
+		Result json.RawMessage `json:"result"`
+		Error  string          `json:"error"`
+	}
+	if err := json.Unmarshal(res.Data, &txResults); err != nil {
+		return nil, err
+	}
+	if len(txResults) != len(txs) {
+		return nil, fmt.Errorf("invalid number of trace results, expected %d, got %d", len(txs), len(txResults))
+	}
+
+	results := make([]*rpctypes.TraceResults, len(txs))
+	for i, txResult := range txResults {
+		if txResult.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", txs[i].Hash, txResult.Error)
+		}
+		results[i], err = parseTraceResults(txResult.Result, traceTypes)
+		if err != nil {
+			return nil, err
+		}
+		txHash := common.HexToHash(txs[i].Hash)
+		results[i].TransactionHash = &txHash
+	}
+	return results, nil
+}
+
+// ReplayCall executes the call on top of the requested block and returns the
+// requested parity trace types.
+func (b *Backend) ReplayCall(
+	args evmtypes.TransactionArgs,
+	traceTypes []string,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.TraceResults, error) {
+	config, err := parityTraceConfig(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.traceCall(args, blockNrOrHash, &rpctypes.TraceCallConfig{TraceConfig: *config})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTraceResults(res.Data, traceTypes)
+}
+
+// TraceFilter returns the parity traces of the block range matching the
+// filter addresses. When the tx indexer is enabled, only the transactions
+// sent by or to one of the filter addresses are traced, otherwise every
+// transaction of the range is.
+func (b *Backend) TraceFilter(args rpctypes.TraceFilterArgs) ([]evmtracers.FlatCallFrame, error) {
+	fromBlock, toBlock, err := b.traceFilterRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		skip   uint64
+		traces = []evmtracers.FlatCallFrame{}
+	)
+	if args.After != nil {
+		skip = *args.After
+	}
+	// full returns true once the requested number of traces has been collected
+	full := func() bool {
+		return args.Count != nil && uint64(len(traces)) >= *args.Count
+	}
+	collect := func(results *rpctypes.TraceResults) {
+		for _, trace := range results.Trace {
+			if full() {
+				return
+			}
+			if !traceMatchesFilter(trace, args) {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			traces = append(traces, trace)
+		}
+	}
+
+	traceTypes := []string{rpctypes.TraceTypeTrace}
+	addresses := append(append([]common.Address{}, args.FromAddress...), args.ToAddress...)
+	if b.indexer != nil && len(addresses) > 0 {
+		hashes, err := b.indexer.GetTxHashesByAddresses(addresses, fromBlock, toBlock)
+		if err != nil {
+			return nil, err
+		}
+		for _, hash := range hashes {
+			if full() {
+				break
+			}
+			results, err := b.ReplayTransaction(hash, traceTypes)
+			if err != nil {
+				return nil, err
+			}
+			collect(results)
+		}
+		return traces, nil
+	}
+
+	for height := fromBlock; height <= toBlock && !full(); height++ {
+		block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+		blockResults, err := b.ReplayBlockTransactions(block, traceTypes)
+		if err != nil {
+			return nil, err
+		}
+		for _, results := range blockResults {
+			collect(results)
+		}
+	}
+	return traces, nil
+}
+
+// traceFilterRange returns the block range of the trace filter, the genesis
+// block is not traceable and the range is capped by the block range cap.
+func (b *Backend) traceFilterRange(args rpctypes.TraceFilterArgs) (int64, int64, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	resolve := func(blockNr *rpctypes.BlockNumber) int64 {
+		if blockNr == nil || *blockNr < 0 || blockNr.Int64() > int64(latest) {
+			// latest, pending or future blocks
+			return int64(latest)
+		}
+		return blockNr.Int64()
+	}
+
+	fromBlock, toBlock := resolve(args.FromBlock), resolve(args.ToBlock)
+	if fromBlock < 1 {
+		fromBlock = 1
+	}
+	if fromBlock > toBlock {
+		return 0, 0, fmt.Errorf("invalid block range, from block %d is greater than to block %d", fromBlock, toBlock)
+	}
+	if blockRange := toBlock - fromBlock; blockRange > int64(b.cfg.JSONRPC.BlockRangeCap) {
+		return 0, 0, fmt.Errorf("block range %d exceeds the maximum %d", blockRange, b.cfg.JSONRPC.BlockRangeCap)
+	}
+	return fromBlock, toBlock, nil
+}
+
+// traceMatchesFilter returns true if the sender of the call is one of the from
+// addresses and the recipient is one of the to addresses, an empty list of
+// addresses matches any address.
+func traceMatchesFilter(trace evmtracers.FlatCallFrame, args rpctypes.TraceFilterArgs) bool {
+	from, to := trace.Action.From, trace.Action.To
+	switch {
+	case trace.Action.SelfDestructed != nil:
+		from, to = trace.Action.SelfDestructed, trace.Action.RefundAddress
+	case trace.Result != nil && trace.Result.Address != nil:
+		to = trace.Result.Address
+	}
+	return containsAddress(args.FromAddress, from) && containsAddress(args.ToAddress, to)
+}
+
+func containsAddress(addresses []common.Address, address *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if address == nil {
+		return false
+	}
+	for _, a := range addresses {
+		if a == *address {
+			return true
+		}
+	}
+	return false
+}
+
+// parityTraceConfig returns the trace config of the requested parity trace
+// types, the flat call tracer is always run as it provides the call output.
+func parityTraceConfig(traceTypes []string) (*evmtypes.TraceConfig, error) {
+	tracers := map[string]json.RawMessage{
+		evmtracers.FlatCallTracer: json.RawMessage(`{"convertParityErrors":true}`),
+	}
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case rpctypes.TraceTypeTrace:
+		case rpctypes.TraceTypeStateDiff:
+			tracers[evmtracers.PrestateTracer] = json.RawMessage(`{"diffMode":true}`)
+		default:
+			return nil, fmt.Errorf("unsupported trace type: %s", traceType)
+		}
+	}
+
+	tracerConfig, err := json.Marshal(tracers)
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.TraceConfig{
+		Tracer:           evmtracers.MuxTracer,
+		TracerJsonConfig: string(tracerConfig),
+	}, nil
+}
+
+// prestateAccount is an account of the prestate tracer result.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// parseTraceResults converts the result of the tracers configured by
+// parityTraceConfig into the parity trace results.
+func parseTraceResults(data []byte, traceTypes []string) (*rpctypes.TraceResults, error) {
+	var res map[string]json.RawMessage
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	var trace []evmtracers.FlatCallFrame
+	if err := json.Unmarshal(res[evmtracers.FlatCallTracer], &trace); err != nil {
+		return nil, errors.Wrap(err, "failed to decode call traces")
+	}
+
+	results := &rpctypes.TraceResults{
+		Output: hexutil.Bytes{},
+		Trace:  []evmtracers.FlatCallFrame{},
+	}
+	if len(trace) > 0 && trace[0].Result != nil {
+		switch {
+		case trace[0].Result.Output != nil:
+			results.Output = *trace[0].Result.Output
+		case trace[0].Result.Code != nil:
+			results.Output = *trace[0].Result.Code
+		}
+	}
+
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case rpctypes.TraceTypeTrace:
+			results.Trace = trace
+		case rpctypes.TraceTypeStateDiff:
+			var prestate struct {
+				Pre  map[common.Address]*prestateAccount `json:"pre"`
+				Post map[common.Address]*prestateAccount `json:"post"`
+			}
+			if err := json.Unmarshal(res[evmtracers.PrestateTracer], &prestate); err != nil {
+				return nil, errors.Wrap(err, "failed to decode state diff")
+			}
+			results.StateDiff = newStateDiff(prestate.Pre, prestate.Post)
+		}
+	}
+	return results, nil
+}
+
+// newStateDiff converts the prestate tracer diff into the parity state diff.
+// The accounts only present in the post state are created, the ones only
+// present in the pre state are deleted. The post state of the modified
+// accounts only contains the modified fields and the non-empty slots.
+func newStateDiff(pre, post map[common.Address]*prestateAccount) rpctypes.StateDiff {
+	diff := make(rpctypes.StateDiff)
+	for addr, account := range post {
+		if _, ok := pre[addr]; !ok {
+			diff[addr] = newAccountDiff(nil, account)
+		}
+	}
+	for addr, account := range pre {
+		diff[addr] = newAccountDiff(account, post[addr])
+	}
+	return diff
+}
+
+func newAccountDiff(pre, post *prestateAccount) *rpctypes.AccountDiff {
+	switch {
+	case pre == nil:
+		account := &rpctypes.AccountDiff{
+			Balance: map[string]interface{}{"+": balanceOrZero(post.Balance)},
+			Code:    map[string]interface{}{"+": post.Code},
+			Nonce:   map[string]interface{}{"+": hexutil.Uint64(post.Nonce)},
+			Storage: make(map[common.Hash]interface{}),
+		}
+		for key, value := range post.Storage {
+			account.Storage[key] = map[string]interface{}{"+": value}
+		}
+		return account
+	case post == nil:
+		account := &rpctypes.AccountDiff{
+			Balance: map[string]interface{}{"-": balanceOrZero(pre.Balance)},
+			Code:    map[string]interface{}{"-": pre.Code},
+			Nonce:   map[string]interface{}{"-": hexutil.Uint64(pre.Nonce)},
+			Storage: make(map[common.Hash]interface{}),
+		}
+		for key, value := range pre.Storage {
+			account.Storage[key] = map[string]interface{}{"-": value}
+		}
+		return account
+	}
+
+	account := &rpctypes.AccountDiff{
+		Balance: "=",
+		Code:    "=",
+		Nonce:   "=",
+		Storage: make(map[common.Hash]interface{}),
+	}
+	if post.Balance != nil {
+		account.Balance = changedValue(balanceOrZero(pre.Balance), post.Balance)
+	}
+	if post.Code != nil {
+		account.Code = changedValue(pre.Code, post.Code)
+	}
+	if post.Nonce != 0 {
+		account.Nonce = changedValue(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+	}
+	// the modified slots set to zero are omitted from the post state
+	for key, value := range pre.Storage {
+		account.Storage[key] = changedValue(value, post.Storage[key])
+	}
+	// the modified slots that were zero are omitted from the pre state
+	for key, value := range post.Storage {
+		if _, ok := pre.Storage[key]; !ok {
+			account.Storage[key] = changedValue(common.Hash{}, value)
+		}
+	}
+	return account
+}
+
+func changedValue(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": map[string]interface{}{"from": from, "to": to}}
+}
+
+func balanceOrZero(balance *hexutil.Big) *hexutil.Big {
+	if balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return balance
+}
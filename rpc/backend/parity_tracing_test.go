@@ -0,0 +1,293 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	"github.com/HarryBin2002/kairoschain/v12/rpc/backend/mocks"
+	rpc "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	evmtracers "github.com/HarryBin2002/kairoschain/v12/x/evm/tracers"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+const muxTraceResult = `{
+	"flatCallTracer": [{
+		"action": {"callType": "call", "from": "0x0000000000000000000000000000000000000001", "gas": "0x5208", "input": "0x", "to": "0x0000000000000000000000000000000000000002", "value": "0x1"},
+		"blockHash": null, "blockNumber": 1, "result": {"gasUsed": "0x5208", "output": "0x01"},
+		"subtraces": 0, "traceAddress": [], "transactionHash": null, "transactionPosition": 0, "type": "call"
+	}],
+	"prestateTracer": {
+		"pre": {"0x0000000000000000000000000000000000000001": {"balance": "0x2", "nonce": 1}},
+		"post": {"0x0000000000000000000000000000000000000001": {"balance": "0x1"}, "0x0000000000000000000000000000000000000002": {"balance": "0x1"}}
+	}
+}`
+
+func (suite *BackendTestSuite) TestReplayBlockTransactions() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	emptyBlock := types.MakeBlock(1, []types.Tx{}, nil, nil)
+	filledBlock := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
+	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+
+	traceTypes := []string{rpc.TraceTypeTrace, rpc.TraceTypeStateDiff}
+	config, err := parityTraceConfig(traceTypes)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		resBlock     *tmrpctypes.ResultBlock
+		traceTypes   []string
+		expResults   int
+		expPass      bool
+	}{
+		{
+			"fail - unsupported trace type",
+			func() {},
+			&resBlockFilled,
+			[]string{rpc.TraceTypeVMTrace},
+			0,
+			false,
+		},
+		{
+			"pass - no transaction",
+			func() {},
+			&resBlockEmpty,
+			traceTypes,
+			0,
+			true,
+		},
+		{
+			"fail - trace error",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				data, err := json.Marshal([]*evmtypes.TxTraceResult{{Error: "trace failed"}})
+				suite.Require().NoError(err)
+				queryClient.On("TraceBlock", rpc.ContextWithHeight(1),
+					&evmtypes.QueryTraceBlockRequest{Txs: []*evmtypes.MsgEthereumTx{msgEthTx}, BlockNumber: 1, TraceConfig: config, ChainId: constants.TestnetEIP155ChainId, BlockMaxGas: -1}).
+					Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
+				RegisterConsensusParams(client, 1)
+			},
+			&resBlockFilled,
+			traceTypes,
+			0,
+			false,
+		},
+		{
+			"pass - traces and state diff",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				data := []byte(fmt.Sprintf(`[{"result":%s}]`, muxTraceResult))
+				queryClient.On("TraceBlock", rpc.ContextWithHeight(1),
+					&evmtypes.QueryTraceBlockRequest{Txs: []*evmtypes.MsgEthereumTx{msgEthTx}, BlockNumber: 1, TraceConfig: config, ChainId: constants.TestnetEIP155ChainId, BlockMaxGas: -1}).
+					Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
+				RegisterConsensusParams(client, 1)
+			},
+			&resBlockFilled,
+			traceTypes,
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			results, err := suite.backend.ReplayBlockTransactions(tc.resBlock, tc.traceTypes)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(results, tc.expResults)
+			if tc.expResults == 0 {
+				return
+			}
+
+			res := results[0]
+			suite.Require().Equal(common.HexToHash(msgEthTx.Hash), *res.TransactionHash)
+			suite.Require().Equal(hexutil.Bytes{0x01}, res.Output)
+			suite.Require().Len(res.Trace, 1)
+			suite.Require().Len(res.StateDiff, 2)
+			suite.Require().Nil(res.VMTrace)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceFilter() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	txHash := msgEthTx.AsTransaction().Hash()
+	from := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000003")
+
+	config, err := parityTraceConfig([]string{rpc.TraceTypeTrace})
+	suite.Require().NoError(err)
+
+	registerTrace := func(addresses ...common.Address) {
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+		RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
+		indexer.On("GetTxHashesByAddresses", addresses, int64(1), int64(1)).Return([]common.Hash{txHash}, nil)
+		RegisterIndexerGetByTxHash(indexer, txHash, 1)
+		_, err := RegisterBlock(client, 1, bz)
+		suite.Require().NoError(err)
+		RegisterConsensusParams(client, 1)
+		queryClient.On("TraceTx", rpc.ContextWithHeight(1),
+			&evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, TraceConfig: config, ChainId: constants.TestnetEIP155ChainId, BlockMaxGas: -1}).
+			Return(&evmtypes.QueryTraceTxResponse{Data: []byte(muxTraceResult)}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		args         rpc.TraceFilterArgs
+		expTraces    int
+		expPass      bool
+	}{
+		{
+			"fail - invalid block range",
+			func() {
+				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+				RegisterIndexerGetLastRequestIndexedBlock(indexer, 3)
+			},
+			rpc.TraceFilterArgs{FromBlock: blockNumberPtr(3), ToBlock: blockNumberPtr(2)},
+			0,
+			false,
+		},
+		{
+			"pass - matching from address",
+			func() { registerTrace(from) },
+			rpc.TraceFilterArgs{FromAddress: []common.Address{from}},
+			1,
+			true,
+		},
+		{
+			"pass - non matching to address",
+			func() { registerTrace(from, other) },
+			rpc.TraceFilterArgs{FromAddress: []common.Address{from}, ToAddress: []common.Address{other}},
+			0,
+			true,
+		},
+		{
+			"pass - skipped trace",
+			func() { registerTrace(from) },
+			rpc.TraceFilterArgs{FromAddress: []common.Address{from}, After: uint64Ptr(1)},
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traces, err := suite.backend.TraceFilter(tc.args)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(traces, tc.expTraces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestNewStateDiff() {
+	created := common.HexToAddress("0x1")
+	deleted := common.HexToAddress("0x2")
+	modified := common.HexToAddress("0x3")
+	slot1, slot2, slot3 := common.HexToHash("0x1"), common.HexToHash("0x2"), common.HexToHash("0x3")
+	value := common.HexToHash("0xff")
+
+	pre := map[common.Address]*prestateAccount{
+		deleted:  {Balance: (*hexutil.Big)(big.NewInt(1)), Code: hexutil.Bytes{0x60}, Storage: map[common.Hash]common.Hash{slot1: value}},
+		modified: {Balance: (*hexutil.Big)(big.NewInt(1)), Nonce: 1, Storage: map[common.Hash]common.Hash{slot1: value, slot2: value}},
+	}
+	post := map[common.Address]*prestateAccount{
+		created:  {Nonce: 1, Code: hexutil.Bytes{0x60}, Storage: map[common.Hash]common.Hash{slot1: value}},
+		modified: {Balance: (*hexutil.Big)(big.NewInt(2)), Storage: map[common.Hash]common.Hash{slot2: common.HexToHash("0x1"), slot3: value}},
+	}
+
+	diff := newStateDiff(pre, post)
+	suite.Require().Len(diff, 3)
+
+	bz, err := json.Marshal(diff)
+	suite.Require().NoError(err)
+
+	var decoded map[common.Address]map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(bz, &decoded))
+
+	suite.Require().Equal(map[string]interface{}{"+": "0x0"}, decoded[created]["balance"])
+	suite.Require().Equal(map[string]interface{}{"+": "0x60"}, decoded[created]["code"])
+	suite.Require().Equal(map[string]interface{}{"+": "0x1"}, decoded[created]["nonce"])
+	suite.Require().Equal(map[string]interface{}{slot1.Hex(): map[string]interface{}{"+": value.Hex()}}, decoded[created]["storage"])
+
+	suite.Require().Equal(map[string]interface{}{"-": "0x1"}, decoded[deleted]["balance"])
+	suite.Require().Equal(map[string]interface{}{"-": "0x0"}, decoded[deleted]["nonce"])
+
+	suite.Require().Equal(map[string]interface{}{"*": map[string]interface{}{"from": "0x1", "to": "0x2"}}, decoded[modified]["balance"])
+	suite.Require().Equal("=", decoded[modified]["code"])
+	suite.Require().Equal("=", decoded[modified]["nonce"])
+	suite.Require().Equal(map[string]interface{}{
+		slot1.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": value.Hex(), "to": common.Hash{}.Hex()}},
+		slot2.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": value.Hex(), "to": common.HexToHash("0x1").Hex()}},
+		slot3.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": common.Hash{}.Hex(), "to": value.Hex()}},
+	}, decoded[modified]["storage"])
+}
+
+func (suite *BackendTestSuite) TestTraceMatchesFilter() {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	created := common.HexToAddress("0x3")
+
+	call := evmtracers.FlatCallFrame{Action: evmtracers.FlatCallAction{From: &from, To: &to}}
+	create := evmtracers.FlatCallFrame{
+		Action: evmtracers.FlatCallAction{From: &from},
+		Result: &evmtracers.FlatCallResult{Address: &created},
+	}
+	suicide := evmtracers.FlatCallFrame{Action: evmtracers.FlatCallAction{SelfDestructed: &created, RefundAddress: &from}}
+
+	testCases := []struct {
+		name     string
+		trace    evmtracers.FlatCallFrame
+		args     rpc.TraceFilterArgs
+		expMatch bool
+	}{
+		{"empty filter", call, rpc.TraceFilterArgs{}, true},
+		{"from address", call, rpc.TraceFilterArgs{FromAddress: []common.Address{from}}, true},
+		{"to address", call, rpc.TraceFilterArgs{ToAddress: []common.Address{created, to}}, true},
+		{"from and to addresses", call, rpc.TraceFilterArgs{FromAddress: []common.Address{from}, ToAddress: []common.Address{created}}, false},
+		{"created contract", create, rpc.TraceFilterArgs{ToAddress: []common.Address{created}}, true},
+		{"self destruct", suicide, rpc.TraceFilterArgs{FromAddress: []common.Address{created}, ToAddress: []common.Address{from}}, true},
+		{"self destruct from refund address", suicide, rpc.TraceFilterArgs{FromAddress: []common.Address{from}}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expMatch, traceMatchesFilter(tc.trace, tc.args))
+		})
+	}
+}
+
+func blockNumberPtr(n int64) *rpc.BlockNumber {
+	blockNr := rpc.BlockNumber(n)
+	return &blockNr
+}
+
+func uint64Ptr(n uint64) *uint64 {
+	return &n
+}
@@ -78,6 +78,7 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterParamsWithoutHeader(queryClient, 1)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
@@ -105,6 +106,7 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterParamsWithoutHeader(queryClient, 1)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
@@ -129,6 +131,7 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBlockRandomError(queryClient)
 				RegisterParamsWithoutHeader(queryClient, 1)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
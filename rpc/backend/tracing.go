@@ -17,6 +17,25 @@ import (
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	traceResult, err := b.traceTransaction(hash, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	err = json.Unmarshal(traceResult.Data, &decodedResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// traceTransaction traces the transaction on top of its predecessors in the
+// block and returns the raw trace result.
+func (b *Backend) traceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (*evmtypes.QueryTraceTxResponse, error) {
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
@@ -114,20 +133,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
-	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	// Response format is unknown due to custom tracer config param
-	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
-	var decodedResult interface{}
-	err = json.Unmarshal(traceResult.Data, &decodedResult)
-	if err != nil {
-		return nil, err
-	}
-
-	return decodedResult, nil
+	return b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
 }
 
 // TraceBlock configures a new tracer according to the provided configuration, and
@@ -137,14 +143,33 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	config *evmtypes.TraceConfig,
 	block *tmrpctypes.ResultBlock,
 ) ([]*evmtypes.TxTraceResult, error) {
-	txs := block.Block.Txs
-	txsLength := len(txs)
+	txsLength := len(block.Block.Txs)
 
 	if txsLength == 0 {
 		// If there are no transactions return empty array
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
+	res, _, err := b.traceBlock(height, config, block)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
+	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+		return nil, err
+	}
+
+	return decodedResults, nil
+}
+
+// traceBlock traces the ethereum transactions of the block, it returns the raw
+// trace results along with the traced transactions.
+func (b *Backend) traceBlock(height rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+) (*evmtypes.QueryTraceBlockResponse, []*evmtypes.MsgEthereumTx, error) {
+	txs := block.Block.Txs
 	txDecoder := b.clientCtx.TxConfig.TxDecoder()
 
 	var txsMessages []*evmtypes.MsgEthereumTx
@@ -175,11 +200,11 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
 	if !ok {
-		return nil, errors.New("invalid rpc client")
+		return nil, nil, errors.New("invalid rpc client")
 	}
 	cp, err := nc.ConsensusParams(b.ctx, &block.Block.Height)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
@@ -194,14 +219,87 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	}
 
 	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, txsMessages, nil
+}
+
+// TraceCall executes the given call on top of the requested block with the
+// configured tracer, the state and block overrides of the config are applied
+// before the execution. The return value is dependent on the requested tracer.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	traceResult, err := b.traceCall(args, blockNrOrHash, config)
 	if err != nil {
 		return nil, err
 	}
 
-	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
-	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
 		return nil, err
 	}
 
-	return decodedResults, nil
+	return decodedResult, nil
+}
+
+// traceCall traces the call on top of the requested block and returns the raw
+// trace result.
+func (b *Backend) traceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (*evmtypes.QueryTraceCallResponse, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	argsBz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+	cp, err := nc.ConsensusParams(b.ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            argsBz,
+		GasCap:          b.RPCGasCap(),
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+		traceCallRequest.Overrides, traceCallRequest.BlockOverrides, err = marshalOverrides(config.StateOverrides, config.BlockOverrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the call is executed on top of the state of the requested block
+	return b.queryClient.TraceCall(rpctypes.ContextWithHeight(blk.Block.Height), &traceCallRequest)
 }
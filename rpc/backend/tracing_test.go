@@ -1,11 +1,15 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/HarryBin2002/kairoschain/v12/crypto/ethsecp256k1"
 	"github.com/HarryBin2002/kairoschain/v12/indexer"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/backend/mocks"
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
 	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -14,6 +18,7 @@ import (
 	"github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -261,3 +266,95 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	overrides := rpctypes.StateOverride{
+		toAddr: rpctypes.OverrideAccount{Balance: &balance},
+	}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}
+	request := &evmtypes.QueryTraceCallRequest{
+		Args:        argsBz,
+		BlockNumber: 1,
+		ChainId:     suite.backend.chainID.Int64(),
+		BlockMaxGas: -1,
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - trace call error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCallError(queryClient, request)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - trace call with overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				req := *request
+				req.TraceConfig = &evmtypes.TraceConfig{Tracer: "callTracer"}
+				req.Overrides = overridesBz
+				RegisterTraceCall(queryClient, &req)
+			},
+			&rpctypes.TraceCallConfig{
+				TraceConfig:    evmtypes.TraceConfig{Tracer: "callTracer"},
+				StateOverrides: &overrides,
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(callArgs, blockNrOrHash, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -13,7 +13,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
 )
 
@@ -175,7 +178,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (*rpctypes.RPCReceipt,
 		return nil, nil
 	}
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
-		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
+		cumulativeGasUsed += BlockGasUsed(txResult)
 	}
 	cumulativeGasUsed += res.CumulativeGasUsed
 
@@ -232,6 +235,225 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (*rpctypes.RPCReceipt,
 	)
 }
 
+// GetBlockReceipts returns the receipts of all the ethereum transactions of the block,
+// they are computed in a single pass over the block results and the tx indexer.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCReceipt, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNum, "error", err.Error())
+		return nil, nil
+	}
+	// return if requested block height is greater than the current one
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	return b.ReceiptsFromTendermintBlock(resBlock, blockRes)
+}
+
+// ReceiptsFromTendermintBlock returns the receipts of all the ethereum transactions of the
+// Tendermint block.
+func (b *Backend) ReceiptsFromTendermintBlock(
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) ([]*rpctypes.RPCReceipt, error) {
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		// tolerate the error for pruned node.
+		b.logger.Error("fetch basefee failed, node is pruned?", "height", blockRes.Height, "error", err)
+	}
+
+	// gas used by the txs preceding each tx of the block
+	precedingGasUsed := make([]uint64, len(blockRes.TxsResults)+1)
+	for i, txResult := range blockRes.TxsResults {
+		precedingGasUsed[i+1] = precedingGasUsed[i] + BlockGasUsed(txResult)
+	}
+
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash.Bytes())
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	receipts := make([]*rpctypes.RPCReceipt, 0, len(msgs))
+	for i, ethMsg := range msgs {
+		res, err := b.GetTxByEthHash(common.HexToHash(ethMsg.Hash))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get tx %s from indexer", ethMsg.Hash)
+		}
+		if int(res.TxIndex) >= len(blockRes.TxsResults) {
+			return nil, fmt.Errorf("invalid tx index %d of tx %s", res.TxIndex, ethMsg.Hash)
+		}
+
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			b.logger.Error("failed to unpack tx data", "error", err.Error())
+			return nil, err
+		}
+
+		// parse tx logs from events
+		msgIndex := int(res.MsgIndex) // #nosec G701 -- checked for int overflow already
+		logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
+		if err != nil {
+			b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
+		}
+
+		receipt, err := rpctypes.NewRPCReceipt(
+			ethMsg,
+			hexutil.Uint64(i),
+			!res.Failed,
+			hexutil.Uint64(b.GetGasUsed(res, txData.GetGasPrice(), txData.GetGas())),
+			hexutil.Uint64(precedingGasUsed[res.TxIndex]+res.CumulativeGasUsed),
+			baseFee,
+			logs,
+			blockHash,
+			hexutil.Uint64(res.Height),
+			chainID.ToInt(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// GetTransactionProof returns the proof of the transaction against the
+// transactions root of its block, nil if the transaction is not found.
+func (b *Backend) GetTransactionProof(hash common.Hash) (*rpctypes.InclusionProof, error) {
+	return b.inclusionProof(hash, false)
+}
+
+// GetReceiptProof returns the proof of the receipt of the transaction against
+// the receipts root of its block, nil if the transaction is not found.
+func (b *Backend) GetReceiptProof(hash common.Hash) (*rpctypes.InclusionProof, error) {
+	return b.inclusionProof(hash, true)
+}
+
+// inclusionProof rebuilds the transactions trie, or the receipts trie, of the
+// block of the transaction and returns the proof of the transaction or of its
+// receipt. The tries only contain the transactions executed by the EVM, so
+// the transactions which exceeded the block gas limit are skipped, and their
+// roots must match the roots committed by the EVM module.
+func (b *Backend) inclusionProof(hash common.Hash, receipt bool) (*rpctypes.InclusionProof, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block not found for height %d", res.Height)
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", res.Height)
+	}
+
+	root, receiptRoot, err := b.BlockRoots(blockRes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "roots of block %d are not committed", res.Height)
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	var receipts []*rpctypes.RPCReceipt
+	if receipt {
+		root = receiptRoot
+		receipts, err = b.ReceiptsFromTendermintBlock(resBlock, blockRes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tr := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+	var key []byte
+	count := uint(0)
+	for i, ethMsg := range msgs {
+		msgHash := common.HexToHash(ethMsg.Hash)
+		msgRes := res
+		if msgHash != hash {
+			if msgRes, err = b.GetTxByEthHash(msgHash); err != nil {
+				return nil, errors.Wrapf(err, "failed to get tx %s from indexer", ethMsg.Hash)
+			}
+		}
+		if int(msgRes.TxIndex) >= len(blockRes.TxsResults) {
+			return nil, fmt.Errorf("invalid tx index %d of tx %s", msgRes.TxIndex, ethMsg.Hash)
+		}
+		// the txs exceeding the block gas limit are reverted
+		if blockRes.TxsResults[msgRes.TxIndex].Code != 0 {
+			continue
+		}
+
+		var value []byte
+		if receipt {
+			value, err = receipts[i].AsEthReceipt().MarshalBinary()
+		} else {
+			value, err = ethMsg.AsTransaction().MarshalBinary()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		msgKey, err := rlp.EncodeToBytes(count)
+		if err != nil {
+			return nil, err
+		}
+		if err := tr.TryUpdate(msgKey, value); err != nil {
+			return nil, err
+		}
+		if msgHash == hash {
+			key = msgKey
+		}
+		count++
+	}
+
+	if trieRoot := tr.Hash(); trieRoot != root {
+		return nil, fmt.Errorf("trie root %s of block %d doesn't match the committed root %s", trieRoot, res.Height, root)
+	}
+	if key == nil {
+		return nil, fmt.Errorf("tx %s exceeded the block gas limit", hash)
+	}
+
+	var proof proofList
+	if err := tr.Prove(key, 0, &proof); err != nil {
+		return nil, err
+	}
+	value, err := tr.TryGet(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]string, len(proof))
+	for i, node := range proof {
+		nodes[i] = hexutil.Encode(node)
+	}
+	return &rpctypes.InclusionProof{
+		BlockHash:   common.BytesToHash(resBlock.BlockID.Hash.Bytes()),
+		BlockNumber: hexutil.Uint64(res.Height),
+		Root:        root,
+		Key:         key,
+		Value:       value,
+		Proof:       nodes,
+	}, nil
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	b.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
@@ -360,3 +582,17 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		b.chainID,
 	)
 }
+
+// proofList collects the trie nodes of a proof, from the root to the leaf.
+type proofList [][]byte
+
+// Put implements ethdb.KeyValueWriter.
+func (p *proofList) Put(_ []byte, value []byte) error {
+	*p = append(*p, common.CopyBytes(value))
+	return nil
+}
+
+// Delete implements ethdb.KeyValueWriter.
+func (p *proofList) Delete([]byte) error {
+	panic("not supported")
+}
@@ -17,6 +17,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/mock"
 )
 
 func (suite *BackendTestSuite) TestGetTransactionByHash() {
@@ -654,6 +659,210 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	blockNum := rpctypes.NewBlockNumber(big.NewInt(1))
+	blockResult := []*abci.ResponseDeliverTx{
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyTxLog, Value: `{"address":"0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7","topics":[],"data":null,"blockNumber":1,"transactionHash":"` + txHash.Hex() + `","transactionIndex":0,"blockHash":"0x0000000000000000000000000000000000000000000000000000000000000000","logIndex":0,"removed":false}`},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  int
+		expPass      bool
+	}{
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			0,
+			true,
+		},
+		{
+			"pass - block receipts",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&tmrpctypes.ResultBlockResults{Height: 1, TxsResults: blockResult}, nil)
+			},
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+			suite.Require().NoError(suite.backend.indexer.IndexBlock(block, blockResult))
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(receipts, tc.expReceipts)
+			if tc.expReceipts == 0 {
+				return
+			}
+
+			// the receipts match the ones of eth_getTransactionReceipt
+			receipt, err := suite.backend.GetTransactionReceipt(txHash)
+			suite.Require().NoError(err)
+			suite.Require().Equal(receipt, receipts[0])
+			suite.Require().Len(receipts[0].Logs, 1)
+			suite.Require().Equal(hexutil.Uint64(21000), receipts[0].CumulativeGasUsed)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetInclusionProof() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	tx := msgEthereumTx.AsTransaction()
+	txHash := tx.Hash()
+	logAddress := common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
+	blockResult := []*abci.ResponseDeliverTx{
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyTxLog, Value: `{"address":"` + logAddress.Hex() + `","topics":[],"data":null,"blockNumber":1,"transactionHash":"` + txHash.Hex() + `","transactionIndex":0,"blockHash":"0x0000000000000000000000000000000000000000000000000000000000000000","logIndex":0,"removed":false}`},
+				}},
+			},
+		},
+	}
+
+	// the roots committed by the EVM module
+	receipt := &ethtypes.Receipt{
+		Type:              tx.Type(),
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Logs:              []*ethtypes.Log{{Address: logAddress, Topics: []common.Hash{}}},
+	}
+	receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+	txRoot := ethtypes.DeriveSha(ethtypes.Transactions{tx}, trie.NewStackTrie(nil))
+	receiptRoot := ethtypes.DeriveSha(ethtypes.Receipts{receipt}, trie.NewStackTrie(nil))
+	rootsEvent := func(txRoot, receiptRoot common.Hash) []abci.Event {
+		return []abci.Event{{Type: evmtypes.EventTypeBlockRoots, Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeyTransactionsRoot, Value: txRoot.Hex()},
+			{Key: evmtypes.AttributeKeyReceiptsRoot, Value: receiptRoot.Hex()},
+		}}}
+	}
+
+	testCases := []struct {
+		name           string
+		endBlockEvents []abci.Event
+		receipt        bool
+		expRoot        common.Hash
+		expPass        bool
+	}{
+		{"fail - roots not committed", nil, false, common.Hash{}, false},
+		{"fail - transactions root mismatch", rootsEvent(receiptRoot, receiptRoot), false, common.Hash{}, false},
+		{"fail - receipts root mismatch", rootsEvent(txRoot, txRoot), true, common.Hash{}, false},
+		{"pass - transaction proof", rootsEvent(txRoot, receiptRoot), false, txRoot, true},
+		{"pass - receipt proof", rootsEvent(txRoot, receiptRoot), true, receiptRoot, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			if tc.receipt {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+			}
+			_, err := RegisterBlock(client, 1, txBz)
+			suite.Require().NoError(err)
+			client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+				Return(&tmrpctypes.ResultBlockResults{Height: 1, TxsResults: blockResult, EndBlockEvents: tc.endBlockEvents}, nil)
+
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+			suite.Require().NoError(suite.backend.indexer.IndexBlock(block, blockResult))
+
+			var proof *rpctypes.InclusionProof
+			if tc.receipt {
+				proof, err = suite.backend.GetReceiptProof(txHash)
+			} else {
+				proof, err = suite.backend.GetTransactionProof(txHash)
+			}
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRoot, proof.Root)
+			suite.Require().Equal(hexutil.Uint64(1), proof.BlockNumber)
+
+			// the proof verifies against the committed root
+			proofDB := memorydb.New()
+			for _, node := range proof.Proof {
+				bz, err := hexutil.Decode(node)
+				suite.Require().NoError(err)
+				suite.Require().NoError(proofDB.Put(crypto.Keccak256(bz), bz))
+			}
+			value, err := trie.VerifyProof(tc.expRoot, proof.Key, proofDB)
+			suite.Require().NoError(err)
+			suite.Require().Equal([]byte(proof.Value), value)
+
+			expValue, err := tx.MarshalBinary()
+			if tc.receipt {
+				expValue, err = receipt.MarshalBinary()
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expValue, value)
+		})
+	}
+
+	// the proof of an unknown transaction is empty
+	suite.SetupTest()
+	suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+	proof, err := suite.backend.GetReceiptProof(txHash)
+	suite.Require().NoError(err)
+	suite.Require().Nil(proof)
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
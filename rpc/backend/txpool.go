@@ -0,0 +1,73 @@
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// TxPoolContent returns the ethereum transactions of the mempool grouped by
+// sender. The transactions with a nonce following the committed account nonce
+// without gaps are pending, the rest of them are queued.
+func (b *Backend) TxPoolContent() (pending, queued rpctypes.TxPoolTransactions, err error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	senders := make(rpctypes.TxPoolTransactions)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			// use zero block values since it's not included in a block yet
+			rpctx, err := rpctypes.NewTransactionFromMsg(
+				ethMsg,
+				common.Hash{},
+				uint64(0),
+				uint64(0),
+				nil,
+				b.chainID,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			senders[rpctx.From] = append(senders[rpctx.From], rpctx)
+		}
+	}
+
+	pending = make(rpctypes.TxPoolTransactions)
+	queued = make(rpctypes.TxPoolTransactions)
+	for sender, senderTxs := range senders {
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].Nonce < senderTxs[j].Nonce
+		})
+
+		nonce, err := b.getAccountNonce(sender, false, 0, b.logger)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, tx := range senderTxs {
+			txNonce := uint64(tx.Nonce)
+			if txNonce > nonce {
+				// nonce gap, the tx can't be executed until the missing ones are received
+				queued[sender] = append(queued[sender], tx)
+				continue
+			}
+			if txNonce == nonce {
+				nonce++
+			}
+			pending[sender] = append(pending[sender], tx)
+		}
+	}
+
+	return pending, queued, nil
+}
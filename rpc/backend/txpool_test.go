@@ -0,0 +1,127 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/HarryBin2002/kairoschain/v12/app"
+	"github.com/HarryBin2002/kairoschain/v12/encoding"
+	"github.com/HarryBin2002/kairoschain/v12/rpc/backend/mocks"
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	// build the mempool txs of the sender with the given nonces
+	buildTxs := func(nonces ...uint64) types.Txs {
+		txs := types.Txs{}
+		for _, nonce := range nonces {
+			msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:  suite.backend.chainID,
+				Nonce:    nonce,
+				To:       &common.Address{},
+				Amount:   big.NewInt(0),
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+			})
+			_, bz := suite.signMsgEthTx(msg)
+			txs = append(txs, bz)
+		}
+		return txs
+	}
+	registerAccount := func(seq uint64) {
+		mockClient := suite.backend.clientCtx.Client.(*mocks.Client)
+		request := &authtypes.QueryAccountRequest{Address: sdk.AccAddress(suite.from.Bytes()).String()}
+		requestMarshal, _ := request.Marshal()
+		RegisterABCIQueryAccount(
+			mockClient,
+			requestMarshal,
+			tmrpcclient.ABCIQueryOptions{Height: int64(1), Prove: false},
+			client.TestAccount{Address: sdk.AccAddress(suite.from.Bytes()), Num: 1, Seq: seq},
+		)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   []uint64
+		expQueued    []uint64
+		expPass      bool
+	}{
+		{
+			"fail - mempool error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - txs following the account nonce are pending",
+			func() {
+				txs := buildTxs(3, 2)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, txs)
+				registerAccount(2)
+			},
+			[]uint64{2, 3},
+			nil,
+			true,
+		},
+		{
+			"pass - txs after a nonce gap are queued",
+			func() {
+				txs := buildTxs(1, 2, 4, 5)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, txs)
+				registerAccount(1)
+			},
+			[]uint64{1, 2},
+			[]uint64{4, 5},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			encCfg := encoding.MakeConfig(app.ModuleBasics)
+			suite.backend.clientCtx.InterfaceRegistry = encCfg.InterfaceRegistry
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			nonces := func(txs rpctypes.TxPoolTransactions) []uint64 {
+				var res []uint64
+				for _, tx := range txs[suite.from] {
+					res = append(res, uint64(tx.Nonce))
+				}
+				return res
+			}
+			suite.Require().Equal(tc.expPending, nonces(pending))
+			suite.Require().Equal(tc.expQueued, nonces(queued))
+		})
+	}
+}
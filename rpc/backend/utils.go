@@ -78,8 +78,10 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 		return nonce, nil
 	}
 
-	// add the uncommitted txs to the nonce counter
+	// add the uncommitted txs to the nonce counter, the mempool might contain txs
+	// with a nonce gap so only the consecutive nonces are counted
 	// only supports `MsgEthereumTx` style tx
+	pendingNonces := make(map[uint64]bool)
 	for _, tx := range pendingTxs {
 		for _, msg := range (*tx).GetMsgs() {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -93,11 +95,15 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 				continue
 			}
 			if sender == accAddr {
-				nonce++
+				pendingNonces[ethMsg.AsTransaction().Nonce()] = true
 			}
 		}
 	}
 
+	for pendingNonces[nonce] {
+		nonce++
+	}
+
 	return nonce, nil
 }
 
@@ -264,6 +270,16 @@ func ShouldIgnoreGasUsed(res *abci.ResponseDeliverTx) bool {
 	return res.GetCode() == 11 && strings.Contains(res.GetLog(), "no block gas left to run tx: out of gas")
 }
 
+// BlockGasUsed returns the gas consumed from the block gas meter by the tx, the
+// gas used is capped to the gas wanted because the gas meter of a tx running
+// out of gas records the gas consumed past its limit.
+func BlockGasUsed(res *abci.ResponseDeliverTx) uint64 {
+	if res.GasWanted > 0 && res.GasUsed > res.GasWanted {
+		return uint64(res.GasWanted)
+	}
+	return uint64(res.GasUsed) // #nosec G701 -- checked for int overflow already
+}
+
 // GetLogsFromBlockResults returns the list of event logs from the tendermint block result response
 func GetLogsFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
 	blockLogs := [][]*ethtypes.Log{}
@@ -0,0 +1,522 @@
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/HarryBin2002/kairoschain/v12/rpc/backend"
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	"github.com/HarryBin2002/kairoschain/v12/server/config"
+	evertypes "github.com/HarryBin2002/kairoschain/v12/types"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// ERC-4337 JSON-RPC error codes.
+const (
+	codeInvalidFields    = -32602
+	codeSimulationFailed = -32500
+	codeExpiringUserOp   = -32503
+	codeSignatureFailed  = -32507
+)
+
+const (
+	// minValidityPeriod is the minimum time a user operation must remain valid
+	// to be accepted in the mempool.
+	minValidityPeriod = 30 * time.Second
+	// estimationGasLimit is the verification gas limit used to estimate the
+	// verification gas of a user operation.
+	estimationGasLimit = 10_000_000
+	// verificationGasPercent is the margin applied to the estimated
+	// verification gas.
+	verificationGasPercent = 110
+)
+
+// preVerificationGas overheads, the ones of the reference bundler.
+const (
+	fixedGas       = 21000
+	perUserOpGas   = 18300
+	perUserOpWord  = 4
+	zeroByteGas    = 4
+	nonZeroByteGas = 16
+)
+
+// rpcError is an error with an ERC-4337 JSON-RPC error code.
+type rpcError struct {
+	code int
+	msg  string
+}
+
+func (e *rpcError) Error() string  { return e.msg }
+func (e *rpcError) ErrorCode() int { return e.code }
+
+// API is the ERC-4337 bundler API. It keeps the user operations in a local
+// mempool and periodically bundles them into handleOps transactions signed by
+// the bundler key of the node's keyring.
+type API struct {
+	logger        log.Logger
+	backend       backend.EVMBackend
+	chainID       *big.Int
+	entryPoints   []common.Address
+	bundler       common.Address
+	beneficiary   common.Address
+	maxBundleSize int
+	mempool       *mempool
+}
+
+// NewAPI creates a new bundler API and starts bundling the user operations if
+// the bundler key is configured.
+func NewAPI(ctx *server.Context, clientCtx client.Context, backend backend.EVMBackend) *API {
+	chainID, err := evertypes.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		panic(err)
+	}
+
+	cfg, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		panic(err)
+	}
+
+	api := &API{
+		logger:        ctx.Logger.With("module", "bundler"),
+		backend:       backend,
+		chainID:       chainID,
+		beneficiary:   common.HexToAddress(cfg.Bundler.Beneficiary),
+		maxBundleSize: cfg.Bundler.MaxBundleSize,
+		mempool:       newMempool(cfg.Bundler.MempoolSize),
+	}
+	for _, entryPoint := range cfg.Bundler.EntryPoints {
+		api.entryPoints = append(api.entryPoints, common.HexToAddress(entryPoint))
+	}
+
+	if cfg.Bundler.Key == "" {
+		api.logger.Error("no bundler key configured, user operations will not be bundled")
+		return api
+	}
+
+	record, err := clientCtx.Keyring.Key(cfg.Bundler.Key)
+	if err != nil {
+		panic(fmt.Errorf("failed to find bundler key %s in the node's keyring: %w", cfg.Bundler.Key, err))
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		panic(err)
+	}
+
+	api.bundler = common.BytesToAddress(addr)
+	if cfg.Bundler.Beneficiary == "" {
+		api.beneficiary = api.bundler
+	}
+
+	go api.bundleLoop(cfg.Bundler.BundleInterval)
+	return api
+}
+
+// SupportedEntryPoints returns the EntryPoint contracts supported by the bundler.
+func (a *API) SupportedEntryPoints() []common.Address {
+	a.logger.Debug("eth_supportedEntryPoints")
+	return a.entryPoints
+}
+
+// SendUserOperation validates the user operation against the EntryPoint and
+// adds it to the mempool of the bundler. It returns the user operation hash.
+func (a *API) SendUserOperation(op rpctypes.UserOperation, entryPoint common.Address) (common.Hash, error) {
+	a.logger.Debug("eth_sendUserOperation", "sender", op.Sender, "entry point", entryPoint)
+
+	if a.bundler == (common.Address{}) {
+		return common.Hash{}, errors.New("no bundler key configured")
+	}
+	if err := a.checkUserOperation(op, entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+
+	var baseFee *big.Int
+	if header := a.backend.CurrentHeader(); header != nil {
+		baseFee = header.BaseFee
+	}
+	if err := checkGasAndFees(op, baseFee); err != nil {
+		return common.Hash{}, err
+	}
+
+	if _, err := a.simulateValidation(op, entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+
+	hash := op.Hash(entryPoint, a.chainID)
+	if err := a.mempool.add(hash, op, entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// EstimateUserOperationGas estimates the gas limits of the user operation. The
+// gas limits and fees of the user operation are ignored.
+func (a *API) EstimateUserOperationGas(op rpctypes.UserOperation, entryPoint common.Address) (*rpctypes.UserOperationGasEstimate, error) {
+	a.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender, "entry point", entryPoint)
+
+	// no fees are set so that the sender doesn't need to prefund the simulation
+	zero := (*hexutil.Big)(new(big.Int))
+	if op.Nonce == nil {
+		op.Nonce = zero
+	}
+	op.MaxFeePerGas, op.MaxPriorityFeePerGas = zero, zero
+	op.CallGasLimit, op.PreVerificationGas = zero, zero
+	op.VerificationGasLimit = (*hexutil.Big)(big.NewInt(estimationGasLimit))
+	op.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(preVerificationGas(withMaxGasFields(op))))
+
+	if err := a.checkUserOperation(op, entryPoint); err != nil {
+		return nil, err
+	}
+
+	res, err := a.simulateValidation(op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationGas := new(big.Int).Sub(res.PreOpGas, op.PreVerificationGas.ToInt())
+	verificationGas.Mul(verificationGas, big.NewInt(verificationGasPercent))
+	verificationGas.Div(verificationGas, big.NewInt(100))
+
+	callGas, err := a.backend.EstimateGas(evmtypes.TransactionArgs{
+		From:  &entryPoint,
+		To:    &op.Sender,
+		Input: &op.CallData,
+	}, nil, nil, nil)
+	if err != nil {
+		return nil, &rpcError{codeSimulationFailed, fmt.Sprintf("failed to estimate the call gas: %s", err)}
+	}
+
+	return &rpctypes.UserOperationGasEstimate{
+		PreVerificationGas:   op.PreVerificationGas,
+		VerificationGasLimit: (*hexutil.Big)(verificationGas),
+		CallGasLimit:         (*hexutil.Big)(new(big.Int).SetUint64(uint64(callGas))),
+	}, nil
+}
+
+// GetUserOperationByHash returns the user operation with the given hash once
+// included in a block. Only the user operations received by this node are
+// known.
+func (a *API) GetUserOperationByHash(hash common.Hash) (*rpctypes.UserOperationResult, error) {
+	a.logger.Debug("eth_getUserOperationByHash", "hash", hash)
+
+	entry, receipt, err := a.bundledUserOperation(hash)
+	if entry == nil || err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.UserOperationResult{
+		UserOperation:   &entry.op,
+		EntryPoint:      entry.entryPoint,
+		TransactionHash: receipt.TransactionHash,
+		BlockHash:       receipt.BlockHash,
+		BlockNumber:     (*hexutil.Big)(new(big.Int).SetUint64(uint64(receipt.BlockNumber))),
+	}, nil
+}
+
+// GetUserOperationReceipt returns the receipt of the user operation with the
+// given hash once included in a block, along with the logs it emitted.
+func (a *API) GetUserOperationReceipt(hash common.Hash) (*rpctypes.UserOperationReceipt, error) {
+	a.logger.Debug("eth_getUserOperationReceipt", "hash", hash)
+
+	entry, receipt, err := a.bundledUserOperation(hash)
+	if entry == nil || err != nil {
+		return nil, err
+	}
+
+	// the logs of a user operation are the ones preceding its UserOperationEvent,
+	// up to the previous one or the BeforeExecution event
+	start := 0
+	for i, log := range receipt.Logs {
+		if log.Address != entry.entryPoint {
+			continue
+		}
+		if isBeforeExecution(log) {
+			start = i + 1
+			continue
+		}
+
+		event, err := unpackUserOperationEvent(log)
+		if err != nil {
+			continue
+		}
+		if event.UserOpHash != hash {
+			start = i + 1
+			continue
+		}
+
+		res := &rpctypes.UserOperationReceipt{
+			UserOpHash:    hash,
+			EntryPoint:    entry.entryPoint,
+			Sender:        event.Sender,
+			Nonce:         (*hexutil.Big)(event.Nonce),
+			Paymaster:     event.Paymaster,
+			ActualGasCost: (*hexutil.Big)(event.ActualGasCost),
+			ActualGasUsed: (*hexutil.Big)(event.ActualGasUsed),
+			Success:       event.Success,
+			Logs:          receipt.Logs[start:i],
+			Receipt:       receipt,
+		}
+		for _, opLog := range res.Logs {
+			if reason, ok := unpackRevertReason(opLog); ok && opLog.Topics[1] == hash {
+				res.Reason = hexutil.Encode(reason)
+			}
+		}
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("no UserOperationEvent for %s in transaction %s", hash, receipt.TransactionHash)
+}
+
+// bundledUserOperation returns the user operation with the given hash and the
+// receipt of its bundle, if it was bundled and included in a block.
+func (a *API) bundledUserOperation(hash common.Hash) (*mempoolEntry, *rpctypes.RPCReceipt, error) {
+	entry, found := a.mempool.get(hash)
+	if !found || entry.txHash == (common.Hash{}) {
+		return nil, nil, nil
+	}
+
+	receipt, err := a.backend.GetTransactionReceipt(entry.txHash)
+	if err != nil || receipt == nil {
+		return nil, nil, err
+	}
+	return entry, receipt, nil
+}
+
+// checkUserOperation checks the fields of the user operation and that the
+// EntryPoint is supported.
+func (a *API) checkUserOperation(op rpctypes.UserOperation, entryPoint common.Address) error {
+	supported := false
+	for _, supportedEntryPoint := range a.entryPoints {
+		supported = supported || supportedEntryPoint == entryPoint
+	}
+	if !supported {
+		return &rpcError{codeInvalidFields, fmt.Sprintf("unsupported entry point %s", entryPoint)}
+	}
+
+	if err := op.Validate(); err != nil {
+		return &rpcError{codeInvalidFields, err.Error()}
+	}
+	return nil
+}
+
+// checkGasAndFees checks that the user operation pays for the gas of the
+// bundle that is not metered by the EntryPoint, and that its fee cap covers
+// the base fee paid by the bundler.
+func checkGasAndFees(op rpctypes.UserOperation, baseFee *big.Int) error {
+	if minGas := preVerificationGas(op); op.PreVerificationGas.ToInt().Cmp(new(big.Int).SetUint64(minGas)) < 0 {
+		return &rpcError{codeInvalidFields, fmt.Sprintf("preVerificationGas too low: %s < %d", op.PreVerificationGas.ToInt(), minGas)}
+	}
+	if baseFee != nil && op.MaxFeePerGas.ToInt().Cmp(baseFee) < 0 {
+		return &rpcError{codeInvalidFields, fmt.Sprintf("maxFeePerGas too low: %s < base fee %s", op.MaxFeePerGas.ToInt(), baseFee)}
+	}
+	return nil
+}
+
+// simulateValidation runs the validation of the user operation by the
+// EntryPoint against the latest state through eth_call.
+func (a *API) simulateValidation(op rpctypes.UserOperation, entryPoint common.Address) (*validationResult, error) {
+	data, err := packSimulateValidation(op)
+	if err != nil {
+		return nil, &rpcError{codeInvalidFields, err.Error()}
+	}
+
+	revertData, err := a.revertData(evmtypes.TransactionArgs{
+		To:    &entryPoint,
+		Input: (*hexutil.Bytes)(&data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := unpackValidationResult(revertData)
+	if err != nil {
+		return nil, &rpcError{codeSimulationFailed, err.Error()}
+	}
+
+	if res.SigFailed {
+		return nil, &rpcError{codeSignatureFailed, "invalid user operation signature"}
+	}
+	if validUntil := res.ValidUntil.Uint64(); validUntil != 0 && time.Unix(int64(validUntil), 0).Before(time.Now().Add(minValidityPeriod)) {
+		return nil, &rpcError{codeExpiringUserOp, "user operation expires too soon"}
+	}
+	return res, nil
+}
+
+// revertData executes the call and returns its revert data, it fails if the
+// call doesn't revert.
+func (a *API) revertData(args evmtypes.TransactionArgs) ([]byte, error) {
+	_, err := a.backend.DoCall(args, rpctypes.EthLatestBlockNumber, nil, nil)
+	if err == nil {
+		return nil, errors.New("call did not revert")
+	}
+
+	data, ok := revertDataFromError(err)
+	if !ok {
+		return nil, err
+	}
+	return data, nil
+}
+
+// revertDataFromError returns the revert data of a reverted call error.
+func revertDataFromError(err error) ([]byte, bool) {
+	var revertErr *evmtypes.RevertError
+	if !errors.As(err, &revertErr) {
+		return nil, false
+	}
+
+	reason, ok := revertErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+
+	data, err := hexutil.Decode(reason)
+	return data, err == nil
+}
+
+// bundleLoop checks the sent bundles and bundles the pending user operations
+// of each EntryPoint at every interval.
+func (a *API) bundleLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		a.checkSentBundles()
+		for _, entryPoint := range a.entryPoints {
+			if err := a.bundle(entryPoint); err != nil {
+				a.logger.Error("failed to send bundle", "entry point", entryPoint, "error", err.Error())
+			}
+		}
+	}
+}
+
+// checkSentBundles confirms the user operations of the sent bundles included
+// in a block. The ones of the bundles dropped from the mempool or reverted are
+// queued again, to be simulated and bundled anew.
+func (a *API) checkSentBundles() {
+	for _, txHash := range a.mempool.sentBundles() {
+		receipt, err := a.backend.GetTransactionReceipt(txHash)
+		if err != nil {
+			a.logger.Debug("failed to get the bundle receipt", "hash", txHash, "error", err.Error())
+			continue
+		}
+
+		if receipt == nil {
+			if tx, err := a.backend.GetTransactionByHash(txHash); err != nil || tx != nil {
+				// still pending
+				continue
+			}
+			// the bundle might have been included meanwhile
+			if receipt, err = a.backend.GetTransactionReceipt(txHash); err != nil {
+				continue
+			}
+			if receipt == nil {
+				a.logger.Debug("bundle dropped, queueing its user operations again", "hash", txHash)
+				a.mempool.requeue(txHash)
+				continue
+			}
+		}
+
+		if receipt.Status != hexutil.Uint(ethtypes.ReceiptStatusSuccessful) {
+			a.logger.Debug("bundle reverted, queueing its user operations again", "hash", txHash)
+			a.mempool.requeue(txHash)
+			continue
+		}
+		a.mempool.confirmBundle(txHash)
+	}
+}
+
+// bundle sends a handleOps transaction with the pending user operations of
+// the EntryPoint. The user operations failing in the bundle are dropped.
+func (a *API) bundle(entryPoint common.Address) error {
+	entries := a.mempool.batch(entryPoint, a.maxBundleSize)
+
+	for len(entries) > 0 {
+		ops := make([]rpctypes.UserOperation, len(entries))
+		hashes := make([]common.Hash, len(entries))
+		for i, entry := range entries {
+			ops[i] = entry.op
+			hashes[i] = entry.hash
+		}
+
+		data, err := packHandleOps(ops, a.beneficiary)
+		if err != nil {
+			return err
+		}
+		args := evmtypes.TransactionArgs{
+			From:  &a.bundler,
+			To:    &entryPoint,
+			Input: (*hexutil.Bytes)(&data),
+		}
+
+		// drop the failing user operation and retry with the other ones
+		if _, err := a.backend.DoCall(args, rpctypes.EthLatestBlockNumber, nil, nil); err != nil {
+			revertData, _ := revertDataFromError(err)
+			index, reason, ok := unpackFailedOp(revertData)
+			if !ok || index >= len(entries) {
+				return err
+			}
+
+			a.logger.Debug("dropping user operation", "hash", entries[index].hash, "reason", reason)
+			a.mempool.remove(entries[index].hash)
+			entries = append(entries[:index], entries[index+1:]...)
+			continue
+		}
+
+		gas, err := a.backend.EstimateGas(args, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		args.Gas = &gas
+
+		txHash, err := a.backend.SendTransaction(args)
+		if err != nil {
+			return err
+		}
+
+		a.logger.Debug("sent bundle", "entry point", entryPoint, "hash", txHash, "user operations", len(hashes))
+		a.mempool.markSent(hashes, txHash)
+		return nil
+	}
+
+	return nil
+}
+
+// withMaxGasFields returns a copy of the user operation whose gas and fee
+// fields are set to the maximum uint64, so that the preVerificationGas
+// estimated before they're known covers the final user operation.
+func withMaxGasFields(op rpctypes.UserOperation) rpctypes.UserOperation {
+	maxUint64 := (*hexutil.Big)(new(big.Int).SetUint64(math.MaxUint64))
+	op.CallGasLimit, op.VerificationGasLimit, op.PreVerificationGas = maxUint64, maxUint64, maxUint64
+	op.MaxFeePerGas, op.MaxPriorityFeePerGas = maxUint64, maxUint64
+	return op
+}
+
+// preVerificationGas returns the gas paid for the user operation that is not
+// metered by the EntryPoint: its share of the intrinsic gas and call data of
+// the bundle.
+func preVerificationGas(op rpctypes.UserOperation) uint64 {
+	packed, err := entryPointABI.Methods["simulateValidation"].Inputs.Pack(newEntryPointUserOp(op))
+	if err != nil {
+		return fixedGas + perUserOpGas
+	}
+
+	gas := uint64(fixedGas + perUserOpGas)
+	gas += perUserOpWord * uint64((len(packed)+31)/32)
+	for _, b := range packed {
+		if b == 0 {
+			gas += zeroByteGas
+		} else {
+			gas += nonZeroByteGas
+		}
+	}
+	return gas
+}
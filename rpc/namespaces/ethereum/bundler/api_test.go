@@ -0,0 +1,57 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+)
+
+func TestCheckGasAndFees(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(op *rpctypes.UserOperation)
+		baseFee  *big.Int
+		expPass  bool
+	}{
+		{"pass", func(*rpctypes.UserOperation) {}, big.NewInt(100), true},
+		{"pass - no base fee", func(*rpctypes.UserOperation) {}, nil, true},
+		{
+			"fail - preVerificationGas too low",
+			func(op *rpctypes.UserOperation) {
+				op.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(preVerificationGas(*op) - 1))
+			},
+			big.NewInt(100),
+			false,
+		},
+		{"fail - maxFeePerGas below the base fee", func(*rpctypes.UserOperation) {}, big.NewInt(101), false},
+	}
+
+	for _, tc := range testCases {
+		op := newTestUserOp(common.HexToAddress("0x1"), 0, 10)
+		tc.malleate(&op)
+
+		err := checkGasAndFees(op, tc.baseFee)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestEstimatedPreVerificationGas(t *testing.T) {
+	op := newTestUserOp(common.HexToAddress("0x1"), 0, 10)
+	op.Signature = make([]byte, 65)
+
+	// the estimate covers the user operation once its gas fields are set
+	estimate := preVerificationGas(withMaxGasFields(op))
+	require.GreaterOrEqual(t, estimate, preVerificationGas(op))
+
+	op.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(estimate))
+	require.NoError(t, checkGasAndFees(op, nil))
+}
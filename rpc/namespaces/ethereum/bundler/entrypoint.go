@@ -0,0 +1,191 @@
+package bundler
+
+import (
+	"bytes"
+	_ "embed" // embed the EntryPoint ABI
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+)
+
+//go:embed entrypoint_abi.json
+var entryPointABIJSON []byte
+
+// entryPointABI is the subset of the EntryPoint v0.6 ABI used by the bundler.
+var entryPointABI abi.ABI
+
+func init() {
+	var err error
+	entryPointABI, err = abi.JSON(bytes.NewReader(entryPointABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// entryPointUserOp is the ABI representation of a user operation.
+type entryPointUserOp struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+func newEntryPointUserOp(op rpctypes.UserOperation) entryPointUserOp {
+	return entryPointUserOp{
+		Sender:               op.Sender,
+		Nonce:                op.Nonce.ToInt(),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         op.CallGasLimit.ToInt(),
+		VerificationGasLimit: op.VerificationGasLimit.ToInt(),
+		PreVerificationGas:   op.PreVerificationGas.ToInt(),
+		MaxFeePerGas:         op.MaxFeePerGas.ToInt(),
+		MaxPriorityFeePerGas: op.MaxPriorityFeePerGas.ToInt(),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+// packHandleOps returns the call data executing the user operations and
+// paying their fees to the beneficiary.
+func packHandleOps(ops []rpctypes.UserOperation, beneficiary common.Address) ([]byte, error) {
+	entryPointOps := make([]entryPointUserOp, len(ops))
+	for i, op := range ops {
+		entryPointOps[i] = newEntryPointUserOp(op)
+	}
+	return entryPointABI.Pack("handleOps", entryPointOps, beneficiary)
+}
+
+// packSimulateValidation returns the call data simulating the validation of
+// the user operation.
+func packSimulateValidation(op rpctypes.UserOperation) ([]byte, error) {
+	return entryPointABI.Pack("simulateValidation", newEntryPointUserOp(op))
+}
+
+// validationResult is the outcome of a successful validation simulation.
+type validationResult struct {
+	PreOpGas         *big.Int
+	Prefund          *big.Int
+	SigFailed        bool
+	ValidAfter       *big.Int
+	ValidUntil       *big.Int
+	PaymasterContext []byte
+}
+
+// unpackValidationResult decodes the revert data of simulateValidation, which
+// always reverts: with ValidationResult if the user operation is valid and
+// with FailedOp otherwise.
+func unpackValidationResult(data []byte) (*validationResult, error) {
+	for _, name := range []string{"ValidationResult", "ValidationResultWithAggregation"} {
+		abiErr := entryPointABI.Errors[name]
+		if len(data) < 4 || !bytes.Equal(data[:4], abiErr.ID[:4]) {
+			continue
+		}
+
+		values, err := abiErr.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, err
+		}
+
+		// only the return info is used, the stake infos are ignored
+		var res struct{ ReturnInfo validationResult }
+		if err := abiErr.Inputs[:1].Copy(&res, values[:1]); err != nil {
+			return nil, err
+		}
+		return &res.ReturnInfo, nil
+	}
+
+	if _, reason, ok := unpackFailedOp(data); ok {
+		return nil, errors.New(reason)
+	}
+
+	return nil, fmt.Errorf("unexpected simulation result %s", hexutil.Encode(data))
+}
+
+// unpackFailedOp decodes the FailedOp revert data of the EntryPoint, returning
+// the index of the failing user operation and the reason.
+func unpackFailedOp(data []byte) (int, string, bool) {
+	failedOp := entryPointABI.Errors["FailedOp"]
+	if len(data) < 4 || !bytes.Equal(data[:4], failedOp.ID[:4]) {
+		return 0, "", false
+	}
+
+	values, err := failedOp.Inputs.Unpack(data[4:])
+	if err != nil {
+		return 0, "", false
+	}
+
+	index, ok := values[0].(*big.Int)
+	if !ok || !index.IsInt64() {
+		return 0, "", false
+	}
+	reason, ok := values[1].(string)
+	return int(index.Int64()), reason, ok
+}
+
+// userOperationEvent is the event emitted by the EntryPoint after the
+// execution of a user operation.
+type userOperationEvent struct {
+	UserOpHash    common.Hash
+	Sender        common.Address
+	Paymaster     common.Address
+	Nonce         *big.Int
+	Success       bool
+	ActualGasCost *big.Int
+	ActualGasUsed *big.Int
+}
+
+// isBeforeExecution returns true if the log is the BeforeExecution event,
+// emitted before the execution of the user operations of a bundle.
+func isBeforeExecution(log *ethtypes.Log) bool {
+	return len(log.Topics) == 1 && log.Topics[0] == entryPointABI.Events["BeforeExecution"].ID
+}
+
+// unpackUserOperationEvent decodes the UserOperationEvent log.
+func unpackUserOperationEvent(log *ethtypes.Log) (*userOperationEvent, error) {
+	event := entryPointABI.Events["UserOperationEvent"]
+	if len(log.Topics) != 4 || log.Topics[0] != event.ID {
+		return nil, errors.New("not a UserOperationEvent log")
+	}
+
+	var res userOperationEvent
+	if err := entryPointABI.UnpackIntoInterface(&res, event.Name, log.Data); err != nil {
+		return nil, err
+	}
+
+	res.UserOpHash = log.Topics[1]
+	res.Sender = common.BytesToAddress(log.Topics[2].Bytes())
+	res.Paymaster = common.BytesToAddress(log.Topics[3].Bytes())
+	return &res, nil
+}
+
+// unpackRevertReason decodes the revert reason of the UserOperationRevertReason
+// log, if the log is one.
+func unpackRevertReason(log *ethtypes.Log) ([]byte, bool) {
+	event := entryPointABI.Events["UserOperationRevertReason"]
+	if len(log.Topics) != 3 || log.Topics[0] != event.ID {
+		return nil, false
+	}
+
+	values, err := event.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil || len(values) != 2 {
+		return nil, false
+	}
+
+	reason, ok := values[1].([]byte)
+	return reason, ok
+}
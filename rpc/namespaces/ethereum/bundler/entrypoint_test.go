@@ -0,0 +1,90 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+)
+
+func TestUnpackValidationResult(t *testing.T) {
+	returnInfo := validationResult{
+		PreOpGas:         big.NewInt(60000),
+		Prefund:          big.NewInt(1000),
+		SigFailed:        true,
+		ValidAfter:       big.NewInt(0),
+		ValidUntil:       big.NewInt(100),
+		PaymasterContext: []byte{},
+	}
+	stake := struct {
+		Stake           *big.Int
+		UnstakeDelaySec *big.Int
+	}{big.NewInt(0), big.NewInt(0)}
+
+	validationErr := entryPointABI.Errors["ValidationResult"]
+	packed, err := validationErr.Inputs.Pack(returnInfo, stake, stake, stake)
+	require.NoError(t, err)
+
+	res, err := unpackValidationResult(append(validationErr.ID[:4:4], packed...))
+	require.NoError(t, err)
+	require.Equal(t, int64(60000), res.PreOpGas.Int64())
+	require.True(t, res.SigFailed)
+	require.Equal(t, int64(100), res.ValidUntil.Int64())
+
+	failedOp := entryPointABI.Errors["FailedOp"]
+	packed, err = failedOp.Inputs.Pack(big.NewInt(1), "AA21 didn't pay prefund")
+	require.NoError(t, err)
+	data := append(failedOp.ID[:4:4], packed...)
+
+	_, err = unpackValidationResult(data)
+	require.EqualError(t, err, "AA21 didn't pay prefund")
+
+	index, reason, ok := unpackFailedOp(data)
+	require.True(t, ok)
+	require.Equal(t, 1, index)
+	require.Equal(t, "AA21 didn't pay prefund", reason)
+
+	_, err = unpackValidationResult([]byte{0x01})
+	require.Error(t, err)
+}
+
+func TestUnpackUserOperationEvent(t *testing.T) {
+	event := entryPointABI.Events["UserOperationEvent"]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(1), true, big.NewInt(1000), big.NewInt(100))
+	require.NoError(t, err)
+
+	hash := common.HexToHash("0x1234")
+	sender, paymaster := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	log := &ethtypes.Log{
+		Address: testEntryPoint,
+		Topics:  []common.Hash{event.ID, hash, common.BytesToHash(sender.Bytes()), common.BytesToHash(paymaster.Bytes())},
+		Data:    data,
+	}
+
+	res, err := unpackUserOperationEvent(log)
+	require.NoError(t, err)
+	require.Equal(t, hash, res.UserOpHash)
+	require.Equal(t, sender, res.Sender)
+	require.Equal(t, paymaster, res.Paymaster)
+	require.Equal(t, int64(1), res.Nonce.Int64())
+	require.True(t, res.Success)
+	require.Equal(t, int64(1000), res.ActualGasCost.Int64())
+
+	_, err = unpackUserOperationEvent(&ethtypes.Log{Topics: []common.Hash{hash}})
+	require.Error(t, err)
+	require.False(t, isBeforeExecution(log))
+	require.True(t, isBeforeExecution(&ethtypes.Log{Topics: []common.Hash{entryPointABI.Events["BeforeExecution"].ID}}))
+}
+
+func TestPackHandleOps(t *testing.T) {
+	op := newTestUserOp(common.HexToAddress("0x1"), 0, 1)
+	data, err := packHandleOps([]rpctypes.UserOperation{op}, common.HexToAddress("0x2"))
+	require.NoError(t, err)
+	require.Equal(t, entryPointABI.Methods["handleOps"].ID, data[:4])
+
+	require.Greater(t, preVerificationGas(op), uint64(fixedGas+perUserOpGas))
+}
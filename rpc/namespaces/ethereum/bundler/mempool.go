@@ -0,0 +1,258 @@
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+)
+
+const (
+	// priceBump is the minimum fee bump percentage to replace a pending user
+	// operation with the same sender and nonce.
+	priceBump = 10
+	// maxBundledUserOps is the number of bundled user operations kept to
+	// serve their receipts.
+	maxBundledUserOps = 10000
+)
+
+var (
+	errUserOpKnown = errors.New("user operation already known")
+	errMempoolFull = errors.New("user operation mempool is full")
+	errUnderpriced = fmt.Errorf("replacement user operation underpriced, fees must be bumped by %d%%", priceBump)
+)
+
+// mempoolEntry is a user operation received by the bundler.
+type mempoolEntry struct {
+	hash       common.Hash
+	op         rpctypes.UserOperation
+	entryPoint common.Address
+	seq        uint64
+	// sentTx is the hash of the bundle transaction sent with the pending user
+	// operation, waiting for its receipt.
+	sentTx common.Hash
+	// txHash is the hash of the bundle transaction including the user
+	// operation, set once its receipt confirms it.
+	txHash common.Hash
+}
+
+// senderNonce identifies the user operations replacing each other.
+type senderNonce struct {
+	entryPoint common.Address
+	sender     common.Address
+	nonce      string
+}
+
+func newSenderNonce(entry *mempoolEntry) senderNonce {
+	return senderNonce{
+		entryPoint: entry.entryPoint,
+		sender:     entry.op.Sender,
+		nonce:      entry.op.Nonce.String(),
+	}
+}
+
+// mempool holds the user operations waiting to be bundled or confirmed, along
+// with the latest bundled ones.
+type mempool struct {
+	mu      sync.Mutex
+	maxSize int
+	seq     uint64
+
+	pending  map[common.Hash]*mempoolEntry
+	bySender map[senderNonce]*mempoolEntry
+
+	bundled      map[common.Hash]*mempoolEntry
+	bundledOrder []common.Hash
+}
+
+func newMempool(maxSize int) *mempool {
+	return &mempool{
+		maxSize:  maxSize,
+		pending:  make(map[common.Hash]*mempoolEntry),
+		bySender: make(map[senderNonce]*mempoolEntry),
+		bundled:  make(map[common.Hash]*mempoolEntry),
+	}
+}
+
+// add adds a user operation to the pending ones. A pending user operation with
+// the same sender and nonce is replaced if the fees are bumped enough.
+func (mp *mempool) add(hash common.Hash, op rpctypes.UserOperation, entryPoint common.Address) error {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	if mp.pending[hash] != nil || mp.bundled[hash] != nil {
+		return errUserOpKnown
+	}
+
+	entry := &mempoolEntry{
+		hash:       hash,
+		op:         op,
+		entryPoint: entryPoint,
+		seq:        mp.seq,
+	}
+	key := newSenderNonce(entry)
+
+	if existing := mp.bySender[key]; existing != nil {
+		if !isBumped(existing.op.MaxFeePerGas.ToInt(), op.MaxFeePerGas.ToInt()) ||
+			!isBumped(existing.op.MaxPriorityFeePerGas.ToInt(), op.MaxPriorityFeePerGas.ToInt()) {
+			return errUnderpriced
+		}
+		delete(mp.pending, existing.hash)
+	} else if len(mp.pending) >= mp.maxSize {
+		return errMempoolFull
+	}
+
+	mp.seq++
+	mp.pending[hash] = entry
+	mp.bySender[key] = entry
+	return nil
+}
+
+// isBumped returns true if the new fee exceeds the old one by the price bump.
+func isBumped(oldFee, newFee *big.Int) bool {
+	threshold := new(big.Int).Mul(oldFee, big.NewInt(100+priceBump))
+	return new(big.Int).Mul(newFee, big.NewInt(100)).Cmp(threshold) >= 0
+}
+
+// batch returns at most size pending user operations of the EntryPoint that
+// are not in a sent bundle, the highest priority fees first and a single one
+// per sender.
+func (mp *mempool) batch(entryPoint common.Address, size int) []*mempoolEntry {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	entries := make([]*mempoolEntry, 0, len(mp.pending))
+	for _, entry := range mp.pending {
+		if entry.entryPoint == entryPoint && entry.sentTx == (common.Hash{}) {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		cmp := entries[i].op.MaxPriorityFeePerGas.ToInt().Cmp(entries[j].op.MaxPriorityFeePerGas.ToInt())
+		if cmp != 0 {
+			return cmp > 0
+		}
+		return entries[i].seq < entries[j].seq
+	})
+
+	senders := make(map[common.Address]bool)
+	batch := make([]*mempoolEntry, 0, size)
+	for _, entry := range entries {
+		if len(batch) == size {
+			break
+		}
+		if senders[entry.op.Sender] {
+			continue
+		}
+		senders[entry.op.Sender] = true
+		batch = append(batch, entry)
+	}
+	return batch
+}
+
+// remove drops a pending user operation.
+func (mp *mempool) remove(hash common.Hash) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	mp.removePending(hash)
+}
+
+func (mp *mempool) removePending(hash common.Hash) {
+	entry := mp.pending[hash]
+	if entry == nil {
+		return
+	}
+
+	delete(mp.pending, hash)
+	if key := newSenderNonce(entry); mp.bySender[key] == entry {
+		delete(mp.bySender, key)
+	}
+}
+
+// markSent marks the pending user operations as sent in the given bundle
+// transaction, they're kept pending until the transaction is confirmed.
+func (mp *mempool) markSent(hashes []common.Hash, txHash common.Hash) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	for _, hash := range hashes {
+		if entry := mp.pending[hash]; entry != nil {
+			entry.sentTx = txHash
+		}
+	}
+}
+
+// sentBundles returns the hashes of the bundle transactions waiting for their
+// receipt.
+func (mp *mempool) sentBundles() []common.Hash {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	seen := make(map[common.Hash]bool)
+	var txHashes []common.Hash
+	for _, entry := range mp.pending {
+		if entry.sentTx != (common.Hash{}) && !seen[entry.sentTx] {
+			seen[entry.sentTx] = true
+			txHashes = append(txHashes, entry.sentTx)
+		}
+	}
+	return txHashes
+}
+
+// requeue makes the user operations of a dropped or reverted bundle
+// transaction available to the next bundles.
+func (mp *mempool) requeue(txHash common.Hash) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	for _, entry := range mp.pending {
+		if entry.sentTx == txHash {
+			entry.sentTx = common.Hash{}
+		}
+	}
+}
+
+// confirmBundle moves the pending user operations of the bundle transaction
+// to the bundled ones, once its receipt confirms it. The oldest bundled user
+// operations are forgotten past maxBundledUserOps.
+func (mp *mempool) confirmBundle(txHash common.Hash) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	for hash, entry := range mp.pending {
+		if entry.sentTx != txHash {
+			continue
+		}
+
+		mp.removePending(hash)
+		entry.txHash = txHash
+		mp.bundled[hash] = entry
+		mp.bundledOrder = append(mp.bundledOrder, hash)
+	}
+
+	if excess := len(mp.bundledOrder) - maxBundledUserOps; excess > 0 {
+		for _, hash := range mp.bundledOrder[:excess] {
+			delete(mp.bundled, hash)
+		}
+		mp.bundledOrder = mp.bundledOrder[excess:]
+	}
+}
+
+// get returns the user operation with the given hash, pending or bundled.
+func (mp *mempool) get(hash common.Hash) (*mempoolEntry, bool) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	if entry := mp.pending[hash]; entry != nil {
+		return entry, true
+	}
+	entry, found := mp.bundled[hash]
+	return entry, found
+}
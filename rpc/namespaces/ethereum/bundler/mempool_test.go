@@ -0,0 +1,113 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+)
+
+var testEntryPoint = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+
+func newTestUserOp(sender common.Address, nonce, priorityFee int64) rpctypes.UserOperation {
+	return rpctypes.UserOperation{
+		Sender:               sender,
+		Nonce:                (*hexutil.Big)(big.NewInt(nonce)),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(priorityFee * 10)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(priorityFee)),
+	}
+}
+
+func addTestUserOp(t *testing.T, mp *mempool, op rpctypes.UserOperation) common.Hash {
+	hash := op.Hash(testEntryPoint, big.NewInt(9000))
+	require.NoError(t, mp.add(hash, op, testEntryPoint))
+	return hash
+}
+
+func TestMempoolAdd(t *testing.T) {
+	mp := newMempool(2)
+	sender := common.HexToAddress("0x1")
+
+	op := newTestUserOp(sender, 0, 10)
+	hash := addTestUserOp(t, mp, op)
+	require.ErrorIs(t, mp.add(hash, op, testEntryPoint), errUserOpKnown)
+
+	// the replacement must bump the fees
+	require.ErrorIs(t, mp.add(common.HexToHash("0x1"), newTestUserOp(sender, 0, 10), testEntryPoint), errUnderpriced)
+	replacement := addTestUserOp(t, mp, newTestUserOp(sender, 0, 11))
+
+	_, found := mp.get(hash)
+	require.False(t, found)
+	entry, found := mp.get(replacement)
+	require.True(t, found)
+	require.Equal(t, int64(11), entry.op.MaxPriorityFeePerGas.ToInt().Int64())
+
+	addTestUserOp(t, mp, newTestUserOp(sender, 1, 10))
+	require.ErrorIs(t, mp.add(common.HexToHash("0x2"), newTestUserOp(sender, 2, 10), testEntryPoint), errMempoolFull)
+}
+
+func TestMempoolBatch(t *testing.T) {
+	mp := newMempool(10)
+	sender1, sender2, sender3 := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+
+	hash1 := addTestUserOp(t, mp, newTestUserOp(sender1, 0, 1))
+	addTestUserOp(t, mp, newTestUserOp(sender1, 1, 5))
+	hash2 := addTestUserOp(t, mp, newTestUserOp(sender2, 0, 3))
+	hash3 := addTestUserOp(t, mp, newTestUserOp(sender3, 0, 3))
+
+	require.Empty(t, mp.batch(common.HexToAddress("0x4"), 10))
+
+	// highest priority fee first, a single user operation per sender
+	batch := mp.batch(testEntryPoint, 10)
+	require.Len(t, batch, 3)
+	require.Equal(t, sender1, batch[0].op.Sender)
+	require.Equal(t, hash2, batch[1].hash)
+	require.Equal(t, hash3, batch[2].hash)
+
+	require.Len(t, mp.batch(testEntryPoint, 2), 2)
+
+	mp.remove(hash1)
+	_, found := mp.get(hash1)
+	require.False(t, found)
+}
+
+func TestMempoolSentBundles(t *testing.T) {
+	mp := newMempool(10)
+
+	hash1 := addTestUserOp(t, mp, newTestUserOp(common.HexToAddress("0x1"), 0, 1))
+	hash2 := addTestUserOp(t, mp, newTestUserOp(common.HexToAddress("0x2"), 0, 1))
+	hash3 := addTestUserOp(t, mp, newTestUserOp(common.HexToAddress("0x3"), 0, 1))
+
+	// the sent user operations stay pending but aren't bundled again
+	txHash := common.HexToHash("0xabcd")
+	mp.markSent([]common.Hash{hash1, hash2}, txHash)
+	require.Equal(t, []common.Hash{txHash}, mp.sentBundles())
+	batch := mp.batch(testEntryPoint, 10)
+	require.Len(t, batch, 1)
+	require.Equal(t, hash3, batch[0].hash)
+
+	// a dropped or reverted bundle is queued again
+	mp.requeue(txHash)
+	require.Empty(t, mp.sentBundles())
+	require.Len(t, mp.batch(testEntryPoint, 10), 3)
+
+	// a confirmed bundle moves its user operations to the bundled ones
+	mp.markSent([]common.Hash{hash1, hash2}, txHash)
+	entry, found := mp.get(hash1)
+	require.True(t, found)
+	require.Equal(t, common.Hash{}, entry.txHash)
+
+	mp.confirmBundle(txHash)
+	require.Empty(t, mp.sentBundles())
+	entry, found = mp.get(hash1)
+	require.True(t, found)
+	require.Equal(t, txHash, entry.txHash)
+	require.Len(t, mp.batch(testEntryPoint, 10), 1)
+}
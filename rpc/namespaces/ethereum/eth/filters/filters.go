@@ -154,6 +154,22 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	// use the log index of the indexer when it covers the block range
+	indexedTo := to
+	if indexedTo > head {
+		indexedTo = head
+	}
+	indexed, ok, err := f.backend.GetIndexedLogs(f.criteria.Addresses, f.criteria.Topics, from, indexedTo, logLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch indexed logs")
+	}
+	if ok {
+		if len(indexed) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		return indexed, nil
+	}
+
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
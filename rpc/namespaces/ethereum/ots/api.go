@@ -0,0 +1,108 @@
+package ots
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/HarryBin2002/kairoschain/v12/rpc/backend"
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+)
+
+// apiLevel is the version of the Otterscan API implemented by the namespace.
+const apiLevel = 8
+
+// API is the Otterscan API, it provides the methods required by the
+// Otterscan block explorer on top of the standard eth namespace.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Otterscan methods.
+func NewAPI(ctx *server.Context, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  ctx.Logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the version of the Otterscan API.
+func (a *API) GetApiLevel() uint64 { //nolint: golint, stylecheck, revive
+	a.logger.Debug("ots_getApiLevel")
+	return apiLevel
+}
+
+// GetInternalOperations returns the value transfers, the self destructs and
+// the contract creations performed by the internal calls of the transaction.
+func (a *API) GetInternalOperations(hash common.Hash) ([]*rpctypes.InternalOperation, error) {
+	a.logger.Debug("ots_getInternalOperations", "hash", hash)
+	return a.backend.GetInternalOperations(hash)
+}
+
+// HasCode returns true if the address has code at the given block.
+func (a *API) HasCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (bool, error) {
+	a.logger.Debug("ots_hasCode", "address", address, "block number or hash", blockNrOrHash)
+	code, err := a.backend.GetCode(address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetTransactionError returns the revert data of the transaction.
+func (a *API) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("ots_getTransactionError", "hash", hash)
+	return a.backend.GetTransactionError(hash)
+}
+
+// TraceTransaction returns the calls of the transaction in execution order.
+func (a *API) TraceTransaction(hash common.Hash) ([]*rpctypes.TraceEntry, error) {
+	a.logger.Debug("ots_traceTransaction", "hash", hash)
+	return a.backend.GetTraceEntries(hash)
+}
+
+// GetBlockDetails returns the block without its transactions, along with the
+// transaction count, the issuance and the total fees.
+func (a *API) GetBlockDetails(blockNr rpctypes.BlockNumber) (map[string]interface{}, error) {
+	a.logger.Debug("ots_getBlockDetails", "number", blockNr)
+	return a.backend.GetBlockDetails(blockNr)
+}
+
+// GetBlockTransactions returns the block with a page of its transactions and
+// their receipts.
+func (a *API) GetBlockTransactions(blockNr rpctypes.BlockNumber, pageNumber, pageSize uint8) (map[string]interface{}, error) {
+	a.logger.Debug("ots_getBlockTransactions", "number", blockNr, "page", pageNumber, "size", pageSize)
+	return a.backend.GetBlockTransactions(blockNr, pageNumber, pageSize)
+}
+
+// SearchTransactionsBefore returns a page of the transactions sent by or to
+// the address before the block number, newest first. A zero block number
+// searches from the latest block.
+func (a *API) SearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize uint16) (*rpctypes.TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", address, "number", blockNumber, "size", pageSize)
+	return a.backend.SearchTransactions(address, blockNumber, pageSize, true)
+}
+
+// SearchTransactionsAfter returns a page of the transactions sent by or to
+// the address after the block number, newest first. A zero block number
+// searches from the genesis block.
+func (a *API) SearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize uint16) (*rpctypes.TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", address, "number", blockNumber, "size", pageSize)
+	return a.backend.SearchTransactions(address, blockNumber, pageSize, false)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the address with the nonce.
+func (a *API) GetTransactionBySenderAndNonce(address common.Address, nonce uint64) (*common.Hash, error) {
+	a.logger.Debug("ots_getTransactionBySenderAndNonce", "address", address, "nonce", nonce)
+	return a.backend.GetTransactionBySenderAndNonce(address, nonce)
+}
+
+// GetContractCreator returns the transaction which created the contract and
+// its sender.
+func (a *API) GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error) {
+	a.logger.Debug("ots_getContractCreator", "address", address)
+	return a.backend.GetContractCreator(address)
+}
@@ -0,0 +1,110 @@
+package trace
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/rpc/backend"
+	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
+	evmtracers "github.com/HarryBin2002/kairoschain/v12/x/evm/tracers"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// API is the Parity/OpenEthereum style tracing API, the calls of the
+// transactions are reported as flat lists of traces.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the trace methods.
+func NewAPI(ctx *server.Context, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the traces of all the transactions of the block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]evmtracers.FlatCallFrame, error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+	results, err := a.replayBlock(blockNr, []string{rpctypes.TraceTypeTrace})
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []evmtracers.FlatCallFrame{}
+	for _, res := range results {
+		traces = append(traces, res.Trace...)
+	}
+	return traces, nil
+}
+
+// Transaction returns the traces of the transaction.
+func (a *API) Transaction(hash common.Hash) ([]evmtracers.FlatCallFrame, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	res, err := a.backend.ReplayTransaction(hash, []string{rpctypes.TraceTypeTrace})
+	if err != nil {
+		return nil, err
+	}
+	return res.Trace, nil
+}
+
+// ReplayTransaction replays the transaction and returns the requested trace
+// types, `trace` and `stateDiff` are supported.
+func (a *API) ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.TraceResults, error) {
+	a.logger.Debug("trace_replayTransaction", "hash", hash, "types", traceTypes)
+	return a.backend.ReplayTransaction(hash, traceTypes)
+}
+
+// ReplayBlockTransactions replays all the transactions of the block and
+// returns the requested trace types of each one of them.
+func (a *API) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	a.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+	return a.replayBlock(blockNr, traceTypes)
+}
+
+// Call executes the call on top of the given block, the latest one by
+// default, and returns the requested trace types.
+func (a *API) Call(
+	args evmtypes.TransactionArgs,
+	traceTypes []string,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.TraceResults, error) {
+	a.logger.Debug("trace_call", "args", args.String(), "types", traceTypes)
+	if blockNrOrHash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		blockNrOrHash = &rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	}
+	return a.backend.ReplayCall(args, traceTypes, *blockNrOrHash)
+}
+
+// Filter returns the traces of the block range matching the filter.
+//
+// NOTE: with the tx indexer enabled, only the transactions sent by or to the
+// filter addresses are traced, so the internal calls of other transactions
+// aren't returned.
+func (a *API) Filter(args rpctypes.TraceFilterArgs) ([]evmtracers.FlatCallFrame, error) {
+	a.logger.Debug("trace_filter", "from", args.FromBlock, "to", args.ToBlock)
+	return a.backend.TraceFilter(args)
+}
+
+func (a *API) replayBlock(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	if blockNr == rpctypes.EthEarliestBlockNumber {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		a.logger.Debug("get block failed", "number", blockNr, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	return a.backend.ReplayBlockTransactions(resBlock, traceTypes)
+}
@@ -1,51 +1,135 @@
 package txpool
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/HarryBin2002/kairoschain/v12/rpc/backend"
 	"github.com/HarryBin2002/kairoschain/v12/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The transactions are read from the CometBFT mempool, the ones with a nonce gap
+// with respect to the committed account nonce are reported as queued.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
-func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
+func (api *PublicAPI) Content() (map[string]map[common.Address]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
-	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[common.Address]map[string]*types.RPCTransaction{
+		"pending": make(map[common.Address]map[string]*types.RPCTransaction),
+		"queued":  make(map[common.Address]map[string]*types.RPCTransaction),
+	}
+	for sender, txs := range pending {
+		content["pending"][sender] = txsByNonce(txs)
+	}
+	for sender, txs := range queued {
+		content["queued"][sender] = txsByNonce(txs)
 	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// sent by the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": txsByNonce(pending[address]),
+		"queued":  txsByNonce(queued[address]),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
-func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
+// easily inspectable list.
+func (api *PublicAPI) Inspect() (map[string]map[common.Address]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
-	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[common.Address]map[string]string{
+		"pending": make(map[common.Address]map[string]string),
+		"queued":  make(map[common.Address]map[string]string),
+	}
+	for sender, txs := range pending {
+		content["pending"][sender] = txsSummary(txs)
+	}
+	for sender, txs := range queued {
+		content["queued"][sender] = txsSummary(txs)
 	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pending)),
+		"queued":  hexutil.Uint(countTxs(queued)),
+	}, nil
+}
+
+// txsByNonce indexes the transactions by their decimal nonce.
+func txsByNonce(txs []*types.RPCTransaction) map[string]*types.RPCTransaction {
+	res := make(map[string]*types.RPCTransaction, len(txs))
+	for _, tx := range txs {
+		res[fmt.Sprintf("%d", tx.Nonce)] = tx
+	}
+	return res
+}
+
+// txsSummary indexes the transactions summaries by their decimal nonce.
+func txsSummary(txs []*types.RPCTransaction) map[string]string {
+	res := make(map[string]string, len(txs))
+	for _, tx := range txs {
+		res[fmt.Sprintf("%d", tx.Nonce)] = format(tx)
+	}
+	return res
+}
+
+// format returns the go-ethereum textual summary of the transaction.
+func format(tx *types.RPCTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
+
+func countTxs(txs types.TxPoolTransactions) int {
+	count := 0
+	for _, senderTxs := range txs {
+		count += len(senderTxs)
 	}
+	return count
 }
@@ -1,11 +1,15 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtracers "github.com/HarryBin2002/kairoschain/v12/x/evm/tracers"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -29,6 +33,19 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
+// InclusionProof defines the format of the Merkle-Patricia proof of a
+// transaction or a receipt against the transactions root or the receipts root
+// of its block. The key is the RLP encoded index of the transaction in the
+// trie, and the value is the consensus encoding of the transaction or receipt.
+type InclusionProof struct {
+	BlockHash   common.Hash    `json:"blockHash"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	Root        common.Hash    `json:"root"`
+	Key         hexutil.Bytes  `json:"key"`
+	Value       hexutil.Bytes  `json:"value"`
+	Proof       []string       `json:"proof"`
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash        *common.Hash         `json:"blockHash"`
@@ -82,20 +99,40 @@ type RPCReceipt struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override during the execution of
+// a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config for the `debug_traceCall` api, it extends the
+// TraceConfig with the state and block overrides of the call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// UnmarshalJSON decodes the trace config and the overrides, it's required as
+// the embedded TraceConfig implements json.Unmarshaler.
+func (c *TraceCallConfig) UnmarshalJSON(input []byte) error {
+	var overrides struct {
+		StateOverrides *StateOverride  `json:"stateOverrides"`
+		BlockOverrides *BlockOverrides `json:"blockOverrides"`
+	}
+	if err := json.Unmarshal(input, &overrides); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(input, &c.TraceConfig); err != nil {
+		return err
+	}
+	c.StateOverrides = overrides.StateOverrides
+	c.BlockOverrides = overrides.BlockOverrides
+	return nil
 }
 
 type FeeHistoryResult struct {
@@ -111,8 +148,112 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// AccessListResult returns an optional accesslist
+// It's the result of the `eth_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee *big.Int   // base fee for each block
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// Parity trace types of the `trace_replay*` and `trace_call` methods.
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+// TraceResults is the result of the replay of a transaction or a call by the
+// `trace` namespace.
+type TraceResults struct {
+	Output          hexutil.Bytes              `json:"output"`
+	StateDiff       StateDiff                  `json:"stateDiff"`
+	Trace           []evmtracers.FlatCallFrame `json:"trace"`
+	VMTrace         json.RawMessage            `json:"vmTrace"`
+	TransactionHash *common.Hash               `json:"transactionHash,omitempty"`
+}
+
+// StateDiff is the parity style diff of the accounts modified by a transaction.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff is the diff of the fields of an account, each field is either
+// "=" if unchanged, {"+": value} if created, {"-": value} if deleted or
+// {"*": {"from": value, "to": value}} if modified.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// TraceFilterArgs is the filter of the `trace_filter` method, the traces
+// matching any of the from addresses and any of the to addresses are returned.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// TxPoolTransactions are the transactions of the mempool grouped by sender,
+// the transactions of each sender are sorted by nonce.
+type TxPoolTransactions map[common.Address][]*RPCTransaction
+
+// Otterscan internal operation types of the `ots_getInternalOperations` method.
+const (
+	OpTransfer     = 0
+	OpSelfDestruct = 1
+	OpCreate       = 2
+	OpCreate2      = 3
+)
+
+// InternalOperation is a value transfer, a self destruct or a contract
+// creation performed by a call within a transaction.
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceEntry is a call of a transaction reported by `ots_traceTransaction`,
+// the calls are listed in execution order along with their depth.
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// OtsReceipt is a transaction receipt along with the timestamp of its block.
+type OtsReceipt struct {
+	*RPCReceipt
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+}
+
+// TransactionsWithReceipts is a page of the transactions of an address
+// returned by the `ots_searchTransactions*` methods, newest first.
+type TransactionsWithReceipts struct {
+	Txs       []*RPCTransaction `json:"txs"`
+	Receipts  []*OtsReceipt     `json:"receipts"`
+	FirstPage bool              `json:"firstPage"`
+	LastPage  bool              `json:"lastPage"`
+}
+
+// ContractCreator is the transaction which created a contract and its sender.
+type ContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceCallConfigUnmarshalJSON(t *testing.T) {
+	input := `{
+		"tracer": "callTracer",
+		"tracerConfig": {"withLog": true},
+		"stateOverrides": {"0x0000000000000000000000000000000000000001": {"nonce": "0x1"}},
+		"blockOverrides": {"number": "0x2"}
+	}`
+
+	var cfg TraceCallConfig
+	require.NoError(t, json.Unmarshal([]byte(input), &cfg))
+	require.Equal(t, "callTracer", cfg.Tracer)
+	require.Equal(t, `{"withLog": true}`, cfg.TracerJsonConfig)
+	require.NotNil(t, cfg.StateOverrides)
+	require.Equal(t, uint64(1), uint64(*(*cfg.StateOverrides)[common.HexToAddress("0x1")].Nonce))
+	require.NotNil(t, cfg.BlockOverrides)
+	require.Equal(t, int64(2), cfg.BlockOverrides.Number.ToInt().Int64())
+
+	require.Error(t, json.Unmarshal([]byte(`{"stateOverrides": 1}`), &cfg))
+}
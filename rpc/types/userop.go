@@ -0,0 +1,108 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserOperation is an ERC-4337 user operation, as defined by the EntryPoint v0.6.
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+// Validate checks that the numeric fields of the user operation are set and
+// that the priority fee doesn't exceed the max fee.
+func (op UserOperation) Validate() error {
+	if op.Nonce == nil {
+		return errors.New("nonce is required")
+	}
+	if op.CallGasLimit == nil || op.VerificationGasLimit == nil || op.PreVerificationGas == nil {
+		return errors.New("callGasLimit, verificationGasLimit and preVerificationGas are required")
+	}
+	if op.MaxFeePerGas == nil || op.MaxPriorityFeePerGas == nil {
+		return errors.New("maxFeePerGas and maxPriorityFeePerGas are required")
+	}
+	if op.MaxPriorityFeePerGas.ToInt().Cmp(op.MaxFeePerGas.ToInt()) > 0 {
+		return errors.New("maxPriorityFeePerGas cannot be higher than maxFeePerGas")
+	}
+	return nil
+}
+
+// Paymaster returns the paymaster of the user operation, or the zero address
+// if the gas is paid by the sender.
+func (op UserOperation) Paymaster() common.Address {
+	if len(op.PaymasterAndData) < common.AddressLength {
+		return common.Address{}
+	}
+	return common.BytesToAddress(op.PaymasterAndData[:common.AddressLength])
+}
+
+// Hash returns the hash of the user operation for the given EntryPoint and
+// chain id, as computed by the getUserOpHash function of the EntryPoint.
+func (op UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	packed := crypto.Keccak256(
+		common.LeftPadBytes(op.Sender.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(op.Nonce.ToInt())),
+		crypto.Keccak256(op.InitCode),
+		crypto.Keccak256(op.CallData),
+		math.U256Bytes(new(big.Int).Set(op.CallGasLimit.ToInt())),
+		math.U256Bytes(new(big.Int).Set(op.VerificationGasLimit.ToInt())),
+		math.U256Bytes(new(big.Int).Set(op.PreVerificationGas.ToInt())),
+		math.U256Bytes(new(big.Int).Set(op.MaxFeePerGas.ToInt())),
+		math.U256Bytes(new(big.Int).Set(op.MaxPriorityFeePerGas.ToInt())),
+		crypto.Keccak256(op.PaymasterAndData),
+	)
+
+	return crypto.Keccak256Hash(
+		packed,
+		common.LeftPadBytes(entryPoint.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(chainID)),
+	)
+}
+
+// UserOperationGasEstimate is the result of eth_estimateUserOperationGas.
+type UserOperationGasEstimate struct {
+	PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+}
+
+// UserOperationResult is the result of eth_getUserOperationByHash.
+type UserOperationResult struct {
+	UserOperation   *UserOperation `json:"userOperation"`
+	EntryPoint      common.Address `json:"entryPoint"`
+	TransactionHash common.Hash    `json:"transactionHash"`
+	BlockHash       common.Hash    `json:"blockHash"`
+	BlockNumber     *hexutil.Big   `json:"blockNumber"`
+}
+
+// UserOperationReceipt is the result of eth_getUserOperationReceipt.
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash     `json:"userOpHash"`
+	EntryPoint    common.Address  `json:"entryPoint"`
+	Sender        common.Address  `json:"sender"`
+	Nonce         *hexutil.Big    `json:"nonce"`
+	Paymaster     common.Address  `json:"paymaster"`
+	ActualGasCost *hexutil.Big    `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big    `json:"actualGasUsed"`
+	Success       bool            `json:"success"`
+	Reason        string          `json:"reason,omitempty"`
+	Logs          []*ethtypes.Log `json:"logs"`
+	Receipt       *RPCReceipt     `json:"receipt"`
+}
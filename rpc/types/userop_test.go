@@ -0,0 +1,95 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUserOperation() UserOperation {
+	return UserOperation{
+		Sender:               common.HexToAddress("0x1"),
+		Nonce:                (*hexutil.Big)(big.NewInt(1)),
+		InitCode:             hexutil.Bytes{},
+		CallData:             hexutil.Bytes{0x01, 0x02},
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(200000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(10)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+		PaymasterAndData:     common.HexToAddress("0x2").Bytes(),
+		Signature:            hexutil.Bytes{0x03},
+	}
+}
+
+func TestUserOperationValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(op *UserOperation)
+		expPass  bool
+	}{
+		{"valid", func(op *UserOperation) {}, true},
+		{"missing nonce", func(op *UserOperation) { op.Nonce = nil }, false},
+		{"missing gas limit", func(op *UserOperation) { op.CallGasLimit = nil }, false},
+		{"missing fee", func(op *UserOperation) { op.MaxFeePerGas = nil }, false},
+		{"priority fee higher than max fee", func(op *UserOperation) { op.MaxPriorityFeePerGas = (*hexutil.Big)(big.NewInt(11)) }, false},
+	}
+
+	for _, tc := range testCases {
+		op := newTestUserOperation()
+		tc.malleate(&op)
+		err := op.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestUserOperationPaymaster(t *testing.T) {
+	op := newTestUserOperation()
+	require.Equal(t, common.HexToAddress("0x2"), op.Paymaster())
+
+	op.PaymasterAndData = nil
+	require.Equal(t, common.Address{}, op.Paymaster())
+}
+
+func TestUserOperationHash(t *testing.T) {
+	op := newTestUserOperation()
+	entryPoint := common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	chainID := big.NewInt(9000)
+
+	// compute the hash with the ABI encoding used by the EntryPoint
+	addressType, _ := abi.NewType("address", "", nil)
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	packed, err := abi.Arguments{
+		{Type: addressType}, {Type: uint256Type}, {Type: bytes32Type}, {Type: bytes32Type}, {Type: uint256Type},
+		{Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type}, {Type: bytes32Type},
+	}.Pack(
+		op.Sender, op.Nonce.ToInt(), crypto.Keccak256Hash(op.InitCode), crypto.Keccak256Hash(op.CallData), op.CallGasLimit.ToInt(),
+		op.VerificationGasLimit.ToInt(), op.PreVerificationGas.ToInt(), op.MaxFeePerGas.ToInt(), op.MaxPriorityFeePerGas.ToInt(),
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	require.NoError(t, err)
+	encoded, err := abi.Arguments{{Type: bytes32Type}, {Type: addressType}, {Type: uint256Type}}.Pack(
+		crypto.Keccak256Hash(packed), entryPoint, chainID,
+	)
+	require.NoError(t, err)
+
+	hash := op.Hash(entryPoint, chainID)
+	require.Equal(t, crypto.Keccak256Hash(encoded), hash)
+
+	// the signature is not part of the hash
+	op.Signature = hexutil.Bytes{0x04}
+	require.Equal(t, hash, op.Hash(entryPoint, chainID))
+
+	require.NotEqual(t, hash, op.Hash(common.HexToAddress("0x3"), chainID))
+	require.NotEqual(t, hash, op.Hash(entryPoint, big.NewInt(9001)))
+}
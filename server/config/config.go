@@ -13,6 +13,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -37,6 +38,19 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolPriceBump is the default minimum fee bump percentage to replace an eth tx in the mempool
+	DefaultMempoolPriceBump = 10
+
+	// DefaultMempoolMaxTxs is the default maximum number of txs of the app-side mempool, used when
+	// the mempool max-txs is not positive
+	DefaultMempoolMaxTxs = 5000
+
+	// DefaultMempoolMaxTxsPerSender is the default maximum number of eth txs of a sender in the app-side mempool
+	DefaultMempoolMaxTxsPerSender = 64
+
+	// DefaultMempoolQueueLifetime is the default number of blocks a queued eth tx is kept in the app-side mempool
+	DefaultMempoolQueueLifetime = 1800
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -69,6 +83,19 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultEntryPoint is the canonical address of the ERC-4337 EntryPoint v0.6 contract, installed
+	// by the entrypoint_v06 predeploy
+	DefaultEntryPoint = "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789"
+
+	// DefaultBundleInterval is the default interval between two bundles of user operations
+	DefaultBundleInterval = 2 * time.Second
+
+	// DefaultMaxBundleSize is the default maximum number of user operations in a bundle
+	DefaultMaxBundleSize = 10
+
+	// DefaultUserOpMempoolSize is the default maximum number of pending user operations
+	DefaultUserOpMempoolSize = 1000
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -81,6 +108,7 @@ type Config struct {
 	EVM     EVMConfig     `mapstructure:"evm"`
 	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
 	TLS     TLSConfig     `mapstructure:"tls"`
+	Bundler BundlerConfig `mapstructure:"bundler"`
 }
 
 // EVMConfig defines the application configuration values for the EVM.
@@ -90,6 +118,18 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// MempoolEnable enables the app-side mempool queueing the eth txs by nonce,
+	// which accepts the eth txs with a future nonce in CheckTx.
+	MempoolEnable bool `mapstructure:"mempool-enable"`
+	// MempoolPriceBump defines the minimum fee bump percentage required to replace
+	// an eth tx with the same nonce in the app-side mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolMaxTxsPerSender defines the maximum number of eth txs of a sender in
+	// the app-side mempool.
+	MempoolMaxTxsPerSender uint64 `mapstructure:"mempool-max-txs-per-sender"`
+	// MempoolQueueLifetime defines the number of blocks an eth tx with a future
+	// nonce is kept in the app-side mempool, 0 to keep it until it's included.
+	MempoolQueueLifetime uint64 `mapstructure:"mempool-queue-lifetime"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -140,6 +180,24 @@ type TLSConfig struct {
 	KeyPath string `mapstructure:"key-path"`
 }
 
+// BundlerConfig defines the configuration of the ERC-4337 bundler served by the
+// bundler JSON-RPC namespace.
+type BundlerConfig struct {
+	// Key is the name of the key of the node's keyring signing the bundles.
+	Key string `mapstructure:"key"`
+	// EntryPoints defines the addresses of the supported EntryPoint contracts.
+	EntryPoints []string `mapstructure:"entry-points"`
+	// Beneficiary is the address receiving the fees of the bundles. Defaults
+	// to the bundler address.
+	Beneficiary string `mapstructure:"beneficiary"`
+	// BundleInterval is the interval between two bundles.
+	BundleInterval time.Duration `mapstructure:"bundle-interval"`
+	// MaxBundleSize is the maximum number of user operations in a bundle.
+	MaxBundleSize int `mapstructure:"max-bundle-size"`
+	// MempoolSize is the maximum number of pending user operations.
+	MempoolSize int `mapstructure:"mempool-size"`
+}
+
 // AppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func AppConfig(denom string) (string, interface{}) {
@@ -168,6 +226,7 @@ func AppConfig(denom string) (string, interface{}) {
 		EVM:     *DefaultEVMConfig(),
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
+		Bundler: *DefaultBundlerConfig(),
 	}
 
 	customAppTemplate := config.DefaultConfigTemplate + DefaultConfigTemplate
@@ -182,14 +241,19 @@ func DefaultConfig() *Config {
 		EVM:     *DefaultEVMConfig(),
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
+		Bundler: *DefaultBundlerConfig(),
 	}
 }
 
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:                 DefaultEVMTracer,
+		MaxTxGasWanted:         DefaultMaxTxGasWanted,
+		MempoolEnable:          false,
+		MempoolPriceBump:       DefaultMempoolPriceBump,
+		MempoolMaxTxsPerSender: DefaultMempoolMaxTxsPerSender,
+		MempoolQueueLifetime:   DefaultMempoolQueueLifetime,
 	}
 }
 
@@ -199,6 +263,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.MempoolEnable && c.MempoolMaxTxsPerSender == 0 {
+		return errors.New("mempool max txs per sender cannot be 0")
+	}
+
 	return nil
 }
 
@@ -209,7 +277,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots", "bundler"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -311,6 +379,49 @@ func (c TLSConfig) Validate() error {
 	return nil
 }
 
+// DefaultBundlerConfig returns the default bundler configuration
+func DefaultBundlerConfig() *BundlerConfig {
+	return &BundlerConfig{
+		Key:            "",
+		EntryPoints:    []string{DefaultEntryPoint},
+		Beneficiary:    "",
+		BundleInterval: DefaultBundleInterval,
+		MaxBundleSize:  DefaultMaxBundleSize,
+		MempoolSize:    DefaultUserOpMempoolSize,
+	}
+}
+
+// Validate returns an error if the bundler configuration fields are invalid.
+func (c BundlerConfig) Validate() error {
+	if len(c.EntryPoints) == 0 {
+		return errors.New("bundler entry points cannot be empty")
+	}
+
+	for _, entryPoint := range c.EntryPoints {
+		if !common.IsHexAddress(entryPoint) {
+			return fmt.Errorf("invalid bundler entry point address %s", entryPoint)
+		}
+	}
+
+	if c.Beneficiary != "" && !common.IsHexAddress(c.Beneficiary) {
+		return fmt.Errorf("invalid bundler beneficiary address %s", c.Beneficiary)
+	}
+
+	if c.BundleInterval <= 0 {
+		return errors.New("bundler bundle interval must be positive")
+	}
+
+	if c.MaxBundleSize <= 0 {
+		return errors.New("bundler max bundle size must be positive")
+	}
+
+	if c.MempoolSize <= 0 {
+		return errors.New("bundler mempool size must be positive")
+	}
+
+	return nil
+}
+
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	cfg, err := config.GetConfig(v)
@@ -321,8 +432,12 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:                 v.GetString("evm.tracer"),
+			MaxTxGasWanted:         v.GetUint64("evm.max-tx-gas-wanted"),
+			MempoolEnable:          v.GetBool("evm.mempool-enable"),
+			MempoolPriceBump:       v.GetUint64("evm.mempool-price-bump"),
+			MempoolMaxTxsPerSender: v.GetUint64("evm.mempool-max-txs-per-sender"),
+			MempoolQueueLifetime:   v.GetUint64("evm.mempool-queue-lifetime"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -346,6 +461,14 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			CertificatePath: v.GetString("tls.certificate-path"),
 			KeyPath:         v.GetString("tls.key-path"),
 		},
+		Bundler: BundlerConfig{
+			Key:            v.GetString("bundler.key"),
+			EntryPoints:    v.GetStringSlice("bundler.entry-points"),
+			Beneficiary:    v.GetString("bundler.beneficiary"),
+			BundleInterval: v.GetDuration("bundler.bundle-interval"),
+			MaxBundleSize:  v.GetInt("bundler.max-bundle-size"),
+			MempoolSize:    v.GetInt("bundler.mempool-size"),
+		},
 	}, nil
 }
 
@@ -372,5 +495,9 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid tls config value: %s", err.Error())
 	}
 
+	if err := c.Bundler.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid bundler config value: %s", err.Error())
+	}
+
 	return c.Config.ValidateBasic()
 }
@@ -11,4 +11,59 @@ func TestDefaultConfig(t *testing.T) {
 	require.True(t, cfg.JSONRPC.Enable)
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
+	require.False(t, cfg.EVM.MempoolEnable)
+}
+
+func TestEVMConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *EVMConfig)
+		expPass  bool
+	}{
+		{"default", func(cfg *EVMConfig) {}, true},
+		{"mempool enabled", func(cfg *EVMConfig) { cfg.MempoolEnable = true }, true},
+		{"invalid tracer", func(cfg *EVMConfig) { cfg.Tracer = "tracer" }, false},
+		{"zero max txs per sender", func(cfg *EVMConfig) {
+			cfg.MempoolEnable = true
+			cfg.MempoolMaxTxsPerSender = 0
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		cfg := DefaultEVMConfig()
+		tc.malleate(cfg)
+		err := cfg.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestBundlerConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *BundlerConfig)
+		expPass  bool
+	}{
+		{"default", func(cfg *BundlerConfig) {}, true},
+		{"no entry point", func(cfg *BundlerConfig) { cfg.EntryPoints = nil }, false},
+		{"invalid entry point", func(cfg *BundlerConfig) { cfg.EntryPoints = []string{"0x123"} }, false},
+		{"invalid beneficiary", func(cfg *BundlerConfig) { cfg.Beneficiary = "beneficiary" }, false},
+		{"zero bundle interval", func(cfg *BundlerConfig) { cfg.BundleInterval = 0 }, false},
+		{"zero bundle size", func(cfg *BundlerConfig) { cfg.MaxBundleSize = 0 }, false},
+		{"zero mempool size", func(cfg *BundlerConfig) { cfg.MempoolSize = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		cfg := DefaultBundlerConfig()
+		tc.malleate(cfg)
+		err := cfg.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
 }
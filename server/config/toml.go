@@ -16,6 +16,23 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# MempoolEnable enables the app-side mempool queueing the eth txs by nonce. The eth txs with
+# a future nonce are then accepted in CheckTx. The mempool is bounded by the mempool max-txs,
+# or by 5000 txs if it's not positive.
+mempool-enable = {{ .EVM.MempoolEnable }}
+
+# MempoolPriceBump defines the minimum fee bump percentage required to replace an eth tx
+# with the same nonce in the app-side mempool.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolMaxTxsPerSender defines the maximum number of eth txs of a sender in the app-side mempool.
+mempool-max-txs-per-sender = {{ .EVM.MempoolMaxTxsPerSender }}
+
+# MempoolQueueLifetime defines the number of blocks an eth tx with a future nonce is kept in the
+# app-side mempool, 0 to keep it until it's included. When the mempool is full, these txs are
+# evicted to make room for the executable ones.
+mempool-queue-lifetime = {{ .EVM.MempoolQueueLifetime }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -88,4 +105,31 @@ certificate-path = "{{ .TLS.CertificatePath }}"
 
 # Key path defines the key.pem file path for the TLS configuration.
 key-path = "{{ .TLS.KeyPath }}"
+
+###############################################################################
+###                           Bundler Configuration                         ###
+###############################################################################
+
+[bundler]
+
+# Key is the name of the key of the node's keyring signing the bundles of user operations.
+# The bundler namespace must be enabled in the JSON-RPC API list.
+key = "{{ .Bundler.Key }}"
+
+# EntryPoints defines the addresses of the supported ERC-4337 EntryPoint contracts.
+# The canonical EntryPoint v0.6 is installed by the entrypoint_v06 predeploy of the EVM module,
+# from the genesis or through a governance proposal.
+entry-points = [{{range $index, $elmt := .Bundler.EntryPoints}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# Beneficiary is the address receiving the fees of the bundles. Defaults to the bundler address.
+beneficiary = "{{ .Bundler.Beneficiary }}"
+
+# BundleInterval is the interval between two bundles.
+bundle-interval = "{{ .Bundler.BundleInterval }}"
+
+# MaxBundleSize is the maximum number of user operations in a bundle.
+max-bundle-size = {{ .Bundler.MaxBundleSize }}
+
+# MempoolSize is the maximum number of pending user operations.
+mempool-size = {{ .Bundler.MempoolSize }}
 `
@@ -60,8 +60,12 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer                 = "evm.tracer"
+	EVMMaxTxGasWanted         = "evm.max-tx-gas-wanted"
+	EVMMempoolEnable          = "evm.mempool-enable"
+	EVMMempoolPriceBump       = "evm.mempool-price-bump"
+	EVMMempoolMaxTxsPerSender = "evm.mempool-max-txs-per-sender"
+	EVMMempoolQueueLifetime   = "evm.mempool-queue-lifetime"
 )
 
 // TLS flags
@@ -70,6 +74,16 @@ const (
 	TLSKeyPath  = "tls.key-path"
 )
 
+// Bundler flags
+const (
+	BundlerKey            = "bundler.key"
+	BundlerEntryPoints    = "bundler.entry-points"
+	BundlerBeneficiary    = "bundler.beneficiary"
+	BundlerBundleInterval = "bundler.bundle-interval"
+	BundlerMaxBundleSize  = "bundler.max-bundle-size"
+	BundlerMempoolSize    = "bundler.mempool-size"
+)
+
 // AddTxFlags adds common flags for commands to post tx
 func AddTxFlags(cmd *cobra.Command) (*cobra.Command, error) {
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "Specify Chain ID for sending Tx")
@@ -48,6 +48,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 
 	ethdebug "github.com/HarryBin2002/kairoschain/v12/rpc/namespaces/ethereum/debug"
 	"github.com/HarryBin2002/kairoschain/v12/server/config"
@@ -169,6 +170,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(server.FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')") //nolint:lll
 	cmd.Flags().Uint(server.FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(server.FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().Int(server.FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool (the EVM mempool uses 5000 if it's not positive)")
 	cmd.Flags().String(srvflags.AppDBBackend, "", "The type of database for application and snapshots databases")
 
 	cmd.Flags().Bool(srvflags.GRPCOnly, false, "Start the node in gRPC query only mode without Tendermint process")
@@ -198,10 +200,21 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMMempoolEnable, false, "enable the app-side mempool queueing the eth txs by nonce")                                                                          //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum fee bump percentage to replace an eth tx with the same nonce in the app-side mempool")     //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolMaxTxsPerSender, config.DefaultMempoolMaxTxsPerSender, "the maximum number of eth txs of a sender in the app-side mempool")                        //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolQueueLifetime, config.DefaultMempoolQueueLifetime, "the number of blocks a queued eth tx is kept in the app-side mempool (0 = unlimited)")         //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
 
+	cmd.Flags().String(srvflags.BundlerKey, "", "the name of the key of the node's keyring signing the bundles of user operations")
+	cmd.Flags().StringSlice(srvflags.BundlerEntryPoints, []string{config.DefaultEntryPoint}, "the addresses of the supported ERC-4337 EntryPoint contracts")
+	cmd.Flags().String(srvflags.BundlerBeneficiary, "", "the address receiving the fees of the bundles (defaults to the bundler address)")
+	cmd.Flags().Duration(srvflags.BundlerBundleInterval, config.DefaultBundleInterval, "the interval between two bundles of user operations")
+	cmd.Flags().Int(srvflags.BundlerMaxBundleSize, config.DefaultMaxBundleSize, "the maximum number of user operations in a bundle")
+	cmd.Flags().Int(srvflags.BundlerMempoolSize, config.DefaultUserOpMempoolSize, "the maximum number of pending user operations")
+
 	cmd.Flags().Uint64(server.FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(server.FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
@@ -6,6 +6,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of the custom ETH-Tx indexer.
@@ -30,6 +31,36 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 
+	// GetTxHashesByAddresses returns the hashes of the txs sent by or to any of the addresses
+	// within the block range, ordered by block number and index.
+	GetTxHashesByAddresses([]common.Address, int64, int64) ([]common.Hash, error)
+
+	// FirstLogIndexedBlock returns the first block number whose logs are indexed.
+	// Returns -1 if no logs are indexed.
+	FirstLogIndexedBlock() (int64, error)
+
+	// GetLogs returns the logs emitted by any of the addresses and matching the topics
+	// within the block range, ordered by block number and log index. The search stops
+	// once more than the given limit of logs are found.
+	GetLogs([]common.Address, [][]common.Hash, int64, int64, int) ([]*ethtypes.Log, error)
+
+	// SearchTxHashesByAddress returns the hashes of the txs sent by or to the address in the blocks
+	// before the block number, newest first, or after it, oldest first, and whether there are more.
+	// At least the page size of txs are returned unless the search is exhausted.
+	SearchTxHashesByAddress(common.Address, int64, int, bool) ([]common.Hash, bool, error)
+
+	// GetTxHashBySenderAndNonce returns nil if tx not found.
+	GetTxHashBySenderAndNonce(common.Address, uint64) (*common.Hash, error)
+
+	// GetContractCreator returns nil if the contract creation is not found.
+	GetContractCreator(common.Address) (*ContractCreator, error)
+
 	// GetLastRequestIndexedBlock returns the block height of the latest success called to IndexBlock()
 	GetLastRequestIndexedBlock() (int64, error)
 }
+
+// ContractCreator is the eth tx which created a contract and its sender.
+type ContractCreator struct {
+	TxHash  common.Hash
+	Creator common.Address
+}
@@ -23,6 +23,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetErc20PrecompileCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -97,6 +98,38 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetErc20PrecompileCmd queries the ERC20 precompile deployed for a denomination
+func GetErc20PrecompileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-precompile DENOM",
+		Short: "Get the address of the ERC20 precompile of a coin",
+		Long:  "Get the address of the ERC20 precompile deployed for a Cosmos coin denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryErc20PrecompileRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.Erc20Precompile(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries erc20 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/HarryBin2002/kairoschain/v12/x/erc20/keeper"
 	"github.com/HarryBin2002/kairoschain/v12/x/erc20/types"
@@ -34,6 +35,19 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	// deploy the ERC20 precompiles of the EVM denom and of the token pairs
+	k.RegisterERC20Precompiles(ctx)
+
+	for _, allowance := range data.Allowances {
+		k.SetAllowance(
+			ctx,
+			allowance.Denom,
+			common.HexToAddress(allowance.Owner),
+			common.HexToAddress(allowance.Spender),
+			allowance.Value.BigInt(),
+		)
+	}
 }
 
 // ExportGenesis export module status
@@ -41,5 +55,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Params:     k.GetParams(ctx),
 		TokenPairs: k.GetTokenPairs(ctx),
+		Allowances: k.GetAllowances(ctx),
 	}
 }
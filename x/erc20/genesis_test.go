@@ -2,12 +2,14 @@ package erc20_test
 
 import (
 	"github.com/HarryBin2002/kairoschain/v12/constants"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -138,6 +140,20 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 					},
 				}),
 		},
+		{
+			"genesis with allowances",
+			types.GenesisState{
+				Params: types.DefaultParams(),
+				Allowances: []types.Allowance{
+					types.NewAllowance(
+						"coin",
+						common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"),
+						common.HexToAddress("0xB8c77482e45F1F44dE1745F52C74426C631bDD52"),
+						big.NewInt(100),
+					),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testGenCases {
@@ -153,6 +169,8 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 			} else {
 				suite.Require().Len(genesisExported.TokenPairs, 0)
 			}
+
+			suite.Require().ElementsMatch(tc.genesisState.Allowances, genesisExported.Allowances)
 		})
 		// }
 	}
@@ -27,9 +27,9 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
-// the module keeper
-func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
-	return h.k.PostTxProcessing(ctx, msg, receipt)
+// the module keeper, the conversions don't pay out any fee
+func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) (sdk.Coins, error) {
+	return nil, h.k.PostTxProcessing(ctx, msg, receipt)
 }
 
 // PostTxProcessing implements EvmHooks.PostTxProcessing. The EVM hooks allows
@@ -143,6 +143,12 @@ func (k Keeper) PostTxProcessing(
 			continue
 		}
 
+		// deploy the ERC20 precompile of the coin, which might have been minted
+		// for the first time
+		if pair.ContractOwner == types.OWNER_EXTERNAL {
+			k.RegisterERC20Precompile(ctx, pair.Denom)
+		}
+
 		// Only need last 20 bytes from log.topics
 		from := common.BytesToAddress(log.Topics[1].Bytes())
 		recipient := sdk.AccAddress(from.Bytes())
@@ -411,8 +411,9 @@ func (suite *KeeperTestSuite) TestPostTxProcessing() {
 
 			tc.malleate()
 
-			err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+			paid, err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
 			suite.Require().NoError(err)
+			suite.Require().True(paid.IsZero())
 
 			if tc.expConversion {
 				sender := sdk.AccAddress(account.Bytes())
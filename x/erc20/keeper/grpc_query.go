@@ -77,6 +77,27 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// Erc20Precompile returns the address of the ERC20 precompile deployed for the
+// given denomination
+func (k Keeper) Erc20Precompile(c context.Context, req *types.QueryErc20PrecompileRequest) (*types.QueryErc20PrecompileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := types.ERC20PrecompileAddress(req.Denom)
+	if _, found := k.GetERC20PrecompileDenom(ctx, address); !found {
+		return nil, status.Errorf(codes.NotFound, "ERC20 precompile with denom '%s'", req.Denom)
+	}
+
+	return &types.QueryErc20PrecompileResponse{Address: address.Hex()}, nil
+}
+
 // Params returns the params of the erc20 module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/HarryBin2002/kairoschain/v12/constants"
 	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
 	"github.com/HarryBin2002/kairoschain/v12/x/erc20/types"
 )
@@ -160,6 +161,70 @@ func (suite *KeeperTestSuite) TestTokenPair() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestErc20Precompile() {
+	var (
+		req    *types.QueryErc20PrecompileRequest
+		expRes *types.QueryErc20PrecompileResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryErc20PrecompileRequest{}
+			},
+			false,
+		},
+		{
+			"precompile not deployed",
+			func() {
+				req = &types.QueryErc20PrecompileRequest{Denom: "ibc/coin"}
+			},
+			false,
+		},
+		{
+			"precompile of the evm denom",
+			func() {
+				req = &types.QueryErc20PrecompileRequest{Denom: constants.BaseDenom}
+				expRes = &types.QueryErc20PrecompileResponse{
+					Address: types.ERC20PrecompileAddress(constants.BaseDenom).Hex(),
+				}
+			},
+			true,
+		},
+		{
+			"registered precompile",
+			func() {
+				address := suite.app.Erc20Keeper.RegisterERC20Precompile(suite.ctx, "ibc/coin")
+
+				req = &types.QueryErc20PrecompileRequest{Denom: "ibc/coin"}
+				expRes = &types.QueryErc20PrecompileResponse{Address: address.Hex()}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.Erc20Precompile(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	expParams := types.DefaultParams()
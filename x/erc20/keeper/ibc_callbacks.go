@@ -45,6 +45,17 @@ func (k Keeper) OnRecvPacket(
 	// use a zero gas config to avoid extra costs for the relayers
 	ctx = utils.UseZeroGasConfig(ctx)
 
+	// parse the transferred denom
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	// deploy the ERC20 precompile of the received coin, which might have been
+	// minted for the first time by the ICS20 transfer
+	k.RegisterERC20Precompile(ctx, coin.Denom)
+
 	if !k.IsERC20Enabled(ctx) {
 		return ack
 	}
@@ -73,13 +84,6 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
-	// parse the transferred denom
-	coin := ibc.GetReceivedCoin(
-		packet.SourcePort, packet.SourceChannel,
-		packet.DestinationPort, packet.DestinationChannel,
-		data.Denom, data.Amount,
-	)
-
 	// check if the coin is a native staking token
 	bondDenom := k.stakingKeeper.BondDenom(ctx)
 	if coin.Denom == bondDenom {
@@ -100,6 +104,11 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
+	if pair.IsNativeCoin() && k.HasERC20Precompile(ctx, pair.Denom) {
+		// no-op: the coin is represented by its ERC20 precompile
+		return ack
+	}
+
 	// Instead of converting just the received coins, convert the whole user balance
 	// which includes the received coins.
 	balance := k.bankKeeper.GetBalance(ctx, recipient, coin.Denom)
@@ -188,6 +197,12 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 		return nil
 	}
 
+	pair, _ := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, coin.Denom))
+	if pair.IsNativeCoin() && k.HasERC20Precompile(ctx, pair.Denom) {
+		// no-op, the coin is represented by its ERC20 precompile
+		return nil
+	}
+
 	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(sender), sender)
 
 	// NOTE: we don't use ValidateBasic the msg since we've already validated the
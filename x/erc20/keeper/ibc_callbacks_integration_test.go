@@ -111,7 +111,7 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			pair, err = s.app.Erc20Keeper.RegisterCoin(s.KairoschainChain.GetContext(), osmoMeta)
 			s.Require().NoError(err)
 		})
-		It("should transfer and convert uosmo to tokens", func() {
+		It("should transfer and not convert uosmo represented by its ERC20 precompile", func() {
 			// Check receiver's balance for IBC and ERC-20 before transfer. Should be zero
 			balanceTokenBefore := s.app.Erc20Keeper.BalanceOf(s.KairoschainChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(receiverAcc.Bytes()))
 			s.Require().Equal(int64(0), balanceTokenBefore.Int64())
@@ -123,13 +123,13 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			// Send coins
 			s.SendAndReceiveMessage(s.pathOsmosisKairoschain, s.IBCOsmosisChain, "uosmo", amount, sender, receiver, 1, "")
 
-			// Check ERC20 balances
+			// Check ERC20 balances - should be zero (no conversion)
 			balanceTokenAfter := s.app.Erc20Keeper.BalanceOf(s.KairoschainChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(receiverAcc.Bytes()))
-			s.Require().Equal(amount, balanceTokenAfter.Int64())
+			s.Require().Equal(int64(0), balanceTokenAfter.Int64())
 
-			// Check IBC uosmo coin balance - should be zero
+			// Check IBC uosmo coin balance
 			ibcOsmoBalanceAfter := s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), receiverAcc, teststypes.UosmoIbcdenom)
-			s.Require().Equal(int64(0), ibcOsmoBalanceAfter.Amount.Int64())
+			s.Require().Equal(amount, ibcOsmoBalanceAfter.Amount.Int64())
 		})
 		It("should transfer and not convert unregistered coin (uatom)", func() {
 			sender = s.IBCCosmosChain.SenderAccount.GetAddress().String()
@@ -146,9 +146,9 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			s.Require().Equal(amount, ibcAtomBalanceAfter.Amount.Int64())
 		})
 		It("should transfer and not convert "+constants.BaseDenom, func() {
-			// Register native coin in ERC-20 keeper to validate it is not converting the coins when receiving native coin thru IBC
-			pair, err := s.app.Erc20Keeper.RegisterCoin(s.KairoschainChain.GetContext(), nativeCoinMeta)
-			s.Require().NoError(err)
+			// The native coin is represented by its ERC20 precompile and can't be registered
+			_, err := s.app.Erc20Keeper.RegisterCoin(s.KairoschainChain.GetContext(), nativeCoinMeta)
+			s.Require().ErrorIs(err, types.ErrNativeCoinConversion)
 
 			nativeCoinInitialBalance := s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), receiverAcc, constants.BaseDenom)
 
@@ -179,31 +179,27 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			// check IBC Coin balance - should be zero
 			ibcCoinsBalance := s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), receiverAcc, teststypes.NativeCoinIbcdenom)
 			s.Require().Equal(int64(0), ibcCoinsBalance.Amount.Int64())
-
-			// Check ERC20 balances - should be zero
-			balanceTokenAfter := s.app.Erc20Keeper.BalanceOf(s.KairoschainChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(receiverAcc.Bytes()))
-			s.Require().Equal(int64(0), balanceTokenAfter.Int64())
 		})
-		It("should transfer and convert original erc20", func() {
+		It("should transfer and send back uosmo represented by its ERC20 precompile", func() {
 			uosmoInitialBalance := s.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(s.IBCOsmosisChain.GetContext(), senderAcc, "uosmo")
 
 			// 1. Send 'uosmo' from Osmosis to Kairoschain
 			s.SendAndReceiveMessage(s.pathOsmosisKairoschain, s.IBCOsmosisChain, "uosmo", amount, sender, receiver, 1, "")
 
-			// validate 'uosmo' was transferred successfully and converted to ERC20
-			balanceERC20Token := s.app.Erc20Keeper.BalanceOf(s.KairoschainChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(receiverAcc.Bytes()))
-			s.Require().Equal(amount, balanceERC20Token.Int64())
+			// validate 'uosmo' was transferred successfully and not converted to ERC20
+			ibcCoinsBalance := s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), receiverAcc, teststypes.UosmoIbcdenom)
+			s.Require().Equal(amount, ibcCoinsBalance.Amount.Int64())
 
-			// 2. Transfer back the erc20 from Kairoschain to Osmosis
+			// 2. Transfer back the 'uosmo' from Kairoschain to Osmosis
 			ibcCoinMeta := fmt.Sprintf("%s/%s", teststypes.UosmoDenomtrace.Path, teststypes.UosmoDenomtrace.BaseDenom)
-			s.SendBackCoins(s.pathOsmosisKairoschain, s.KairoschainChain, types.ModuleName+"/"+pair.GetERC20Contract().String(), amount, receiver, sender, 1, ibcCoinMeta)
+			s.SendBackCoins(s.pathOsmosisKairoschain, s.KairoschainChain, pair.Denom, amount, receiver, sender, 1, ibcCoinMeta)
 
 			// after transfer, ERC-20 token balance should be zero
 			balanceTokenAfter := s.app.Erc20Keeper.BalanceOf(s.KairoschainChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(receiverAcc.Bytes()))
 			s.Require().Equal(int64(0), balanceTokenAfter.Int64())
 
 			// check IBC Coin balance - should be zero
-			ibcCoinsBalance := s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), receiverAcc, teststypes.UosmoIbcdenom)
+			ibcCoinsBalance = s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), receiverAcc, teststypes.UosmoIbcdenom)
 			s.Require().Equal(int64(0), ibcCoinsBalance.Amount.Int64())
 
 			// Final balance on Osmosis should be equal to initial balance
@@ -615,9 +611,14 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			receiverAcc = sdk.MustAccAddressFromBech32(receiver)
 			senderAcc = sdk.MustAccAddressFromBech32(sender)
 
+			// Register uosmo pair, before the ERC20 precompile of uosmo is deployed
+			var err error
+			pair, err = s.app.Erc20Keeper.RegisterCoin(s.KairoschainChain.GetContext(), osmoMeta)
+			s.Require().NoError(err)
+
 			erc20params := types.DefaultParams()
 			erc20params.EnableErc20 = false
-			err := s.app.Erc20Keeper.SetParams(s.KairoschainChain.GetContext(), erc20params)
+			err = s.app.Erc20Keeper.SetParams(s.KairoschainChain.GetContext(), erc20params)
 			s.Require().NoError(err)
 
 			// Send from osmosis to Kairoschain
@@ -626,10 +627,6 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			erc20params.EnableErc20 = true
 			err = s.app.Erc20Keeper.SetParams(s.KairoschainChain.GetContext(), erc20params)
 			s.Require().NoError(err)
-
-			// Register uosmo pair
-			pair, err = s.app.Erc20Keeper.RegisterCoin(s.KairoschainChain.GetContext(), osmoMeta)
-			s.Require().NoError(err)
 		})
 		It("should convert erc20 to ibc vouched and transfer", func() {
 			uosmoInitialBalance := s.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(s.IBCOsmosisChain.GetContext(), receiverAcc, "uosmo")
@@ -637,18 +634,8 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			balance := s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), senderAcc, teststypes.UosmoIbcdenom)
 			s.Require().Equal(amount, balance.Amount.Int64())
 
-			// Convert ibc vouchers to erc20 tokens
-			msgConvertCoin := types.NewMsgConvertCoin(
-				sdk.NewCoin(pair.Denom, sdk.NewInt(amount)),
-				common.BytesToAddress(senderAcc.Bytes()),
-				senderAcc,
-			)
-
-			err := msgConvertCoin.ValidateBasic()
-			s.Require().NoError(err)
-			// Use MsgConvertERC20 to convert the ERC20 to a Cosmos IBC Coin
-			_, err = s.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(s.KairoschainChain.GetContext()), msgConvertCoin)
-			s.Require().NoError(err)
+			// Hold the ibc vouchers as legacy erc20 tokens
+			s.MintLegacyERC20(s.KairoschainChain.GetContext(), pair, senderAcc, amount)
 
 			s.KairoschainChain.Coordinator.CommitBlock()
 
@@ -673,22 +660,12 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			s.Require().Equal(uosmoInitialBalance.Amount.Int64()+amount, uosmoBalance.Amount.Int64())
 		})
 
-		It("should timeout and reconvert coins", func() {
+		It("should timeout and refund coins represented by their ERC20 precompile", func() {
 			balance := s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), senderAcc, teststypes.UosmoIbcdenom)
 			s.Require().Equal(amount, balance.Amount.Int64())
 
-			// Convert ibc vouchers to erc20 tokens
-			msgConvertCoin := types.NewMsgConvertCoin(
-				sdk.NewCoin(pair.Denom, sdk.NewInt(amount)),
-				common.BytesToAddress(senderAcc.Bytes()),
-				senderAcc,
-			)
-			err := msgConvertCoin.ValidateBasic()
-			s.Require().NoError(err)
-
-			// Use MsgConvertERC20 to convert the ERC20 to a Cosmos IBC Coin
-			_, err = s.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(s.KairoschainChain.GetContext()), msgConvertCoin)
-			s.Require().NoError(err)
+			// Hold the ibc vouchers as legacy erc20 tokens
+			s.MintLegacyERC20(s.KairoschainChain.GetContext(), pair, senderAcc, amount)
 
 			s.KairoschainChain.Coordinator.CommitBlock()
 
@@ -708,7 +685,7 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			denom := originChain.App.(*app.Kairoschain).StakingKeeper.BondDenom(originChain.GetContext())
 			fee := sdk.Coins{sdk.NewInt64Coin(denom, ibctesting.DefaultFeeAmt)}
 
-			_, _, err = ibctesting.SignAndDeliver(
+			_, _, err := ibctesting.SignAndDeliver(
 				originChain.T,
 				originChain.TxConfig,
 				originChain.App.GetBaseApp(),
@@ -748,14 +725,14 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			s.Require().NoError(err)
 			originChain.NextBlock()
 
-			// Check that balance was reconverted
+			// Check that balance was refunded and not reconverted
 			balance = s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), senderAcc, teststypes.UosmoIbcdenom)
-			s.Require().Equal(int64(0), balance.Amount.Int64())
+			s.Require().Equal(amount, balance.Amount.Int64())
 
 			balanceERC20TokenAfter = s.app.Erc20Keeper.BalanceOf(s.KairoschainChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(senderAcc.Bytes()))
-			s.Require().Equal(amount, balanceERC20TokenAfter.Int64())
+			s.Require().Equal(int64(0), balanceERC20TokenAfter.Int64())
 		})
-		It("should error and reconvert coins", func() {
+		It("should error and refund coins represented by their ERC20 precompile", func() {
 			receiverAcc = s.IBCCosmosChain.GetSimApp().AccountKeeper.GetModuleAddress("distribution")
 			receiver = receiverAcc.String()
 			s.IBCOsmosisChain.GetSimApp().BankKeeper.BlockedAddr(receiverAcc)
@@ -763,18 +740,8 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			balance := s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), senderAcc, teststypes.UosmoIbcdenom)
 			s.Require().Equal(amount, balance.Amount.Int64())
 
-			// Convert ibc vouchers to erc20 tokens
-			msgConvertCoin := types.NewMsgConvertCoin(
-				sdk.NewCoin(pair.Denom, sdk.NewInt(amount)),
-				common.BytesToAddress(senderAcc.Bytes()),
-				senderAcc,
-			)
-			err := msgConvertCoin.ValidateBasic()
-			s.Require().NoError(err)
-
-			// Use MsgConvertERC20 to convert the ERC20 to a Cosmos IBC Coin
-			_, err = s.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(s.KairoschainChain.GetContext()), msgConvertCoin)
-			s.Require().NoError(err)
+			// Hold the ibc vouchers as legacy erc20 tokens
+			s.MintLegacyERC20(s.KairoschainChain.GetContext(), pair, senderAcc, amount)
 
 			s.KairoschainChain.Coordinator.CommitBlock()
 
@@ -788,7 +755,7 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			transferMsg := transfertypes.NewMsgTransfer(originEndpoint.ChannelConfig.PortID, originEndpoint.ChannelID,
 				sdk.NewCoin(coin, sdk.NewInt(amount)), sender, receiver, timeoutHeight, timeout, "")
 
-			_, err = ibctesting.SendMsgs(originChain, ibctesting.DefaultFeeAmt, transferMsg)
+			_, err := ibctesting.SendMsgs(originChain, ibctesting.DefaultFeeAmt, transferMsg)
 			s.Require().NoError(err) // message committed
 
 			// Recreate the packet that was sent
@@ -799,11 +766,12 @@ var _ = Describe("Convert outgoing ERC20 to IBC", Ordered, func() {
 			err = path.RelayPacket(packet)
 			s.Require().NoError(err)
 
+			// Check that balance was refunded and not reconverted
 			balance = s.app.BankKeeper.GetBalance(s.KairoschainChain.GetContext(), senderAcc, teststypes.UosmoIbcdenom)
-			s.Require().Equal(int64(0), balance.Amount.Int64())
+			s.Require().Equal(amount, balance.Amount.Int64())
 
 			balanceERC20TokenAfter := s.app.Erc20Keeper.BalanceOf(s.KairoschainChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(senderAcc.Bytes()))
-			s.Require().Equal(amount, balanceERC20TokenAfter.Int64())
+			s.Require().Equal(int64(0), balanceERC20TokenAfter.Int64())
 		})
 	})
 })
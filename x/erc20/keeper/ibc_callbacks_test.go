@@ -228,7 +228,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			expCoins:   coins,
 		},
 		{
-			name: "ibc conversion - sender == receiver",
+			name: "no-op - sender == receiver, coin represented by its ERC20 precompile",
 			malleate: func() {
 				sourcePrefix := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel)
 				prefixedDenom := sourcePrefix + registeredDenom
@@ -238,15 +238,11 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			receiver:   secpAddr,
 			ackSuccess: true,
-			expErc20s:  big.NewInt(1000),
-			expCoins: sdk.NewCoins(
-				sdk.NewCoin(constants.BaseDenom, sdk.NewInt(1000)),
-				sdk.NewCoin(registeredDenom, sdk.NewInt(0)),
-				sdk.NewCoin(ibcBase, sdk.NewInt(1000)),
-			),
+			expErc20s:  big.NewInt(0),
+			expCoins:   coins,
 		},
 		{
-			name: "ibc conversion - sender != receiver",
+			name: "no-op - sender != receiver, coin represented by its ERC20 precompile",
 			malleate: func() {
 				pk1 := secp256k1.GenPrivKey()
 				sourcePrefix := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel)
@@ -258,15 +254,11 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			receiver:   ethsecpAddr,
 			ackSuccess: true,
-			expErc20s:  big.NewInt(1000),
-			expCoins: sdk.NewCoins(
-				sdk.NewCoin(constants.BaseDenom, sdk.NewInt(1000)),
-				sdk.NewCoin(registeredDenom, sdk.NewInt(0)),
-				sdk.NewCoin(ibcBase, sdk.NewInt(1000)),
-			),
+			expErc20s:  big.NewInt(0),
+			expCoins:   coins,
 		},
 		{
-			name: "ibc conversion - receiver is a vesting account (eth address)",
+			name: "no-op - receiver is a vesting account (eth address), coin represented by its ERC20 precompile",
 			malleate: func() {
 				// Set vesting account
 				bacc := authtypes.NewBaseAccount(ethsecpAddr, nil, 0, 0)
@@ -282,12 +274,8 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			receiver:   ethsecpAddr,
 			ackSuccess: true,
-			expErc20s:  big.NewInt(1000),
-			expCoins: sdk.NewCoins(
-				sdk.NewCoin(ibcBase, sdk.NewInt(1000)),
-				sdk.NewCoin(constants.BaseDenom, sdk.NewInt(1000)),
-				sdk.NewCoin(registeredDenom, sdk.NewInt(0)),
-			),
+			expErc20s:  big.NewInt(0),
+			expCoins:   coins,
 		},
 	}
 	for _, tc := range testCases {
@@ -7,7 +7,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
-var _ module.MigrationHandler = Migrator{}.Migrate2to3
+var (
+	_ module.MigrationHandler = Migrator{}.Migrate2to3
+	_ module.MigrationHandler = Migrator{}.Migrate3to4
+)
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
@@ -26,3 +29,11 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace)
 }
+
+// Migrate3to4 deploys the ERC20 precompiles of the EVM denomination and of the
+// registered token pairs. The other coins get their precompile when they are
+// minted again.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.RegisterERC20Precompiles(ctx)
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"github.com/HarryBin2002/kairoschain/v12/app"
+	"github.com/HarryBin2002/kairoschain/v12/constants"
 	"github.com/HarryBin2002/kairoschain/v12/encoding"
 	erc20keeper "github.com/HarryBin2002/kairoschain/v12/x/erc20/keeper"
 	v3types "github.com/HarryBin2002/kairoschain/v12/x/erc20/migrations/v3/types"
@@ -67,3 +68,20 @@ func (suite *KeeperTestSuite) TestMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	suite.SetupTest()
+
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(append(types.KeyPrefixERC20Precompile, types.ERC20PrecompileAddress(constants.BaseDenom).Bytes()...))
+
+	_, found := suite.app.Erc20Keeper.GetERC20PrecompileDenom(suite.ctx, types.ERC20PrecompileAddress(constants.BaseDenom))
+	suite.Require().False(found)
+
+	migrator := erc20keeper.NewMigrator(suite.app.Erc20Keeper, nil)
+	suite.Require().NoError(migrator.Migrate3to4(suite.ctx))
+
+	denom, found := suite.app.Erc20Keeper.GetERC20PrecompileDenom(suite.ctx, types.ERC20PrecompileAddress(constants.BaseDenom))
+	suite.Require().True(found)
+	suite.Require().Equal(constants.BaseDenom, denom)
+}
@@ -104,3 +104,18 @@ func (b *MockBankKeeper) GetBalance(_ sdk.Context, _ sdk.AccAddress, _ string) s
 	args := b.Called(mock.Anything, mock.Anything)
 	return args.Get(0).(sdk.Coin)
 }
+
+func (b *MockBankKeeper) GetSupply(_ sdk.Context, _ string) sdk.Coin {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(sdk.Coin)
+}
+
+func (b *MockBankKeeper) IsSendEnabledCoins(_ sdk.Context, _ ...sdk.Coin) error {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Error(0)
+}
+
+func (b *MockBankKeeper) SendCoins(_ sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress, _ sdk.Coins) error {
+	args := b.Called(mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	return args.Error(0)
+}
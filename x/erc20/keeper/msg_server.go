@@ -19,7 +19,9 @@ import (
 var _ types.MsgServer = &Keeper{}
 
 // ConvertCoin converts native Cosmos coins into ERC20 tokens for both
-// Cosmos-native and ERC20 TokenPair Owners
+// Cosmos-native and ERC20 TokenPair Owners. The coins of a Cosmos-native pair
+// can't be converted once the ERC20 precompile of the denomination is deployed,
+// the precompile is the only ERC20 representation of the coin.
 func (k Keeper) ConvertCoin(
 	goCtx context.Context,
 	msg *types.MsgConvertCoin,
@@ -52,6 +54,12 @@ func (k Keeper) ConvertCoin(
 	// Check ownership and execute conversion
 	switch {
 	case pair.IsNativeCoin():
+		if k.HasERC20Precompile(ctx, pair.Denom) {
+			return nil, errorsmod.Wrapf(
+				types.ErrNativeCoinConversion,
+				"use the ERC20 precompile %s of %s", types.ERC20PrecompileAddress(pair.Denom), pair.Denom,
+			)
+		}
 		return k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
 	case pair.IsNativeERC20():
 		return k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
@@ -61,7 +69,9 @@ func (k Keeper) ConvertCoin(
 }
 
 // ConvertERC20 converts ERC20 tokens into native Cosmos coins for both
-// Cosmos-native and ERC20 TokenPair Owners
+// Cosmos-native and ERC20 TokenPair Owners. The tokens of a Cosmos-native pair
+// are still converted once the ERC20 precompile of the denomination is
+// deployed, so that their holders can move them to the precompile.
 func (k Keeper) ConvertERC20(
 	goCtx context.Context,
 	msg *types.MsgConvertERC20,
@@ -352,6 +362,10 @@ func (k Keeper) convertERC20NativeToken(
 		return nil, err
 	}
 
+	// deploy the ERC20 precompile of the coin, which might have been minted for
+	// the first time
+	k.RegisterERC20Precompile(ctx, pair.Denom)
+
 	// Send minted coins to the receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
 		return nil, err
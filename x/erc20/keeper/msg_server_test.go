@@ -82,6 +82,17 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 			false,
 			false,
 		},
+		{
+			"fail - coin represented by its ERC20 precompile",
+			100,
+			10,
+			func(common.Address) {
+				suite.app.Erc20Keeper.RegisterERC20Precompile(suite.ctx, cosmosTokenBase)
+			},
+			func() {},
+			false,
+			false,
+		},
 		{
 			"fail - deleted module account - force fail", 100, 10, func(common.Address) {},
 			func() {
@@ -215,6 +226,13 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 	}{
 		{"ok - sufficient funds", 100, 10, 5, func() {}, true},
 		{"ok - equal funds", 10, 10, 10, func() {}, true},
+		{
+			"ok - coin represented by its ERC20 precompile", 100, 10, 5,
+			func() {
+				suite.app.Erc20Keeper.RegisterERC20Precompile(suite.ctx, cosmosTokenBase)
+			},
+			true,
+		},
 		{"fail - insufficient funds", 10, 1, 5, func() {}, false},
 		{"fail ", 10, 1, -5, func() {}, false},
 		{
@@ -718,6 +736,11 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.Require().Equal(expRes, res)
 					suite.Require().Equal(cosmosBalance.Amount, sdk.NewInt(tc.transfer))
 					suite.Require().Equal(balance.(*big.Int).Int64(), big.NewInt(tc.mint-tc.transfer).Int64())
+
+					// the precompile of the minted coin is deployed
+					denom, found := suite.app.Erc20Keeper.GetERC20PrecompileDenom(suite.ctx, types.ERC20PrecompileAddress(coinName))
+					suite.Require().True(found)
+					suite.Require().Equal(coinName, denom)
 				}
 			} else {
 				suite.Require().Error(err, tc.name)
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	erc20precompile "github.com/HarryBin2002/kairoschain/v12/precompiles/erc20"
+	"github.com/HarryBin2002/kairoschain/v12/x/erc20/types"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+var _ evmtypes.DynamicPrecompiles = Keeper{}
+
+// GetDynamicPrecompile returns the ERC20 precompile deployed at the given address.
+// No precompile is returned if the erc20 module is disabled.
+func (k Keeper) GetDynamicPrecompile(ctx sdk.Context, address common.Address) (vm.PrecompiledContract, bool) {
+	if !k.IsERC20Enabled(ctx) {
+		return nil, false
+	}
+
+	denom, found := k.GetERC20PrecompileDenom(ctx, address)
+	if !found {
+		return nil, false
+	}
+
+	precompile, err := erc20precompile.NewPrecompile(denom, k.bankKeeper, k)
+	if err != nil {
+		k.Logger(ctx).Error("failed to create ERC20 precompile", "denom", denom, "error", err)
+		return nil, false
+	}
+
+	return precompile, true
+}
+
+// RegisterERC20Precompile deploys the ERC20 precompile of the given denomination
+// and returns its address. It is a no-op if the precompile is already deployed.
+func (k Keeper) RegisterERC20Precompile(ctx sdk.Context, denom string) common.Address {
+	address := types.ERC20PrecompileAddress(denom)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Precompile)
+	if !store.Has(address.Bytes()) {
+		store.Set(address.Bytes(), []byte(denom))
+	}
+
+	return address
+}
+
+// RegisterERC20Precompiles deploys the ERC20 precompiles of the EVM denomination
+// and of the coins of the registered token pairs. The precompiles of the other
+// coins are deployed lazily, when they are minted by the module or received
+// through an ICS20 transfer, so the bank supply is never iterated.
+func (k Keeper) RegisterERC20Precompiles(ctx sdk.Context) {
+	k.RegisterERC20Precompile(ctx, k.evmKeeper.GetParams(ctx).EvmDenom)
+
+	for _, pair := range k.GetTokenPairs(ctx) {
+		k.RegisterERC20Precompile(ctx, pair.Denom)
+	}
+}
+
+// HasERC20Precompile returns true if the ERC20 precompile of the given
+// denomination is deployed.
+func (k Keeper) HasERC20Precompile(ctx sdk.Context, denom string) bool {
+	_, found := k.GetERC20PrecompileDenom(ctx, types.ERC20PrecompileAddress(denom))
+	return found
+}
+
+// GetERC20PrecompileDenom returns the denomination of the ERC20 precompile
+// deployed at the given address.
+func (k Keeper) GetERC20PrecompileDenom(ctx sdk.Context, address common.Address) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Precompile)
+	bz := store.Get(address.Bytes())
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// GetAllowance returns the amount of coins of the given denomination that the
+// spender is allowed to transfer on behalf of the owner.
+func (k Keeper) GetAllowance(ctx sdk.Context, denom string, owner, spender common.Address) *big.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	bz := store.Get(types.AllowanceKey(denom, owner, spender))
+	return new(big.Int).SetBytes(bz)
+}
+
+// SetAllowance sets the amount of coins of the given denomination that the
+// spender is allowed to transfer on behalf of the owner. Zero allowances are
+// removed from the store.
+func (k Keeper) SetAllowance(ctx sdk.Context, denom string, owner, spender common.Address, value *big.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	key := types.AllowanceKey(denom, owner, spender)
+
+	if value.Sign() == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, value.Bytes())
+}
+
+// GetAllowances returns all the stored ERC20 precompile allowances.
+func (k Keeper) GetAllowances(ctx sdk.Context) []types.Allowance {
+	allowances := []types.Allowance{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		owner := common.BytesToAddress(key[:common.AddressLength])
+		spender := common.BytesToAddress(key[common.AddressLength : 2*common.AddressLength])
+		denom := string(key[2*common.AddressLength:])
+
+		allowances = append(allowances, types.NewAllowance(denom, owner, spender, new(big.Int).SetBytes(iterator.Value())))
+	}
+
+	return allowances
+}
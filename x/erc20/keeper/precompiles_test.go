@@ -0,0 +1,299 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	erc20precompile "github.com/HarryBin2002/kairoschain/v12/precompiles/erc20"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/erc20/types"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (suite *KeeperTestSuite) TestGetDynamicPrecompile() {
+	evmDenomAddress := types.ERC20PrecompileAddress(constants.BaseDenom)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		address  common.Address
+		expFound bool
+	}{
+		{
+			"ok - coins with supply at genesis are deployed",
+			func() {},
+			evmDenomAddress,
+			true,
+		},
+		{
+			"ok - registered denom",
+			func() {
+				suite.app.Erc20Keeper.RegisterERC20Precompile(suite.ctx, "ibc/coin")
+			},
+			types.ERC20PrecompileAddress("ibc/coin"),
+			true,
+		},
+		{
+			"fail - unregistered denom",
+			func() {},
+			types.ERC20PrecompileAddress("ibc/coin"),
+			false,
+		},
+		{
+			"fail - erc20 module disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableErc20 = false
+				suite.Require().NoError(suite.app.Erc20Keeper.SetParams(suite.ctx, params))
+			},
+			evmDenomAddress,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			precompile, found := suite.app.Erc20Keeper.GetDynamicPrecompile(suite.ctx, tc.address)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.address, precompile.Address())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20Precompiles() {
+	suite.SetupTest()
+
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(append(types.KeyPrefixERC20Precompile, types.ERC20PrecompileAddress(constants.BaseDenom).Bytes()...))
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+
+	// coins without a token pair are not deployed by the bank supply
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ibc/coin", 100))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+
+	suite.app.Erc20Keeper.RegisterERC20Precompiles(suite.ctx)
+
+	for _, denom := range []string{constants.BaseDenom, "coin"} {
+		_, found := suite.app.Erc20Keeper.GetERC20PrecompileDenom(suite.ctx, types.ERC20PrecompileAddress(denom))
+		suite.Require().True(found, denom)
+	}
+
+	_, found := suite.app.Erc20Keeper.GetERC20PrecompileDenom(suite.ctx, types.ERC20PrecompileAddress("ibc/coin"))
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestAllowances() {
+	suite.SetupTest()
+
+	owner := utiltx.GenerateAddress()
+	spender := utiltx.GenerateAddress()
+
+	suite.Require().Equal(int64(0), suite.app.Erc20Keeper.GetAllowance(suite.ctx, "coin", owner, spender).Int64())
+
+	suite.app.Erc20Keeper.SetAllowance(suite.ctx, "coin", owner, spender, big.NewInt(100))
+	suite.app.Erc20Keeper.SetAllowance(suite.ctx, "token", owner, spender, abi.MaxUint256)
+	suite.Require().Equal(int64(100), suite.app.Erc20Keeper.GetAllowance(suite.ctx, "coin", owner, spender).Int64())
+	suite.Require().Equal(int64(0), suite.app.Erc20Keeper.GetAllowance(suite.ctx, "coin", spender, owner).Int64())
+	suite.Require().Equal(abi.MaxUint256, suite.app.Erc20Keeper.GetAllowance(suite.ctx, "token", owner, spender))
+	suite.Require().ElementsMatch(
+		[]types.Allowance{
+			types.NewAllowance("coin", owner, spender, big.NewInt(100)),
+			types.NewAllowance("token", owner, spender, abi.MaxUint256),
+		},
+		suite.app.Erc20Keeper.GetAllowances(suite.ctx),
+	)
+
+	suite.app.Erc20Keeper.SetAllowance(suite.ctx, "coin", owner, spender, big.NewInt(0))
+	suite.Require().Len(suite.app.Erc20Keeper.GetAllowances(suite.ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestERC20Precompile() {
+	var (
+		contractABI abi.ABI
+		spender     common.Address
+		recipient   common.Address
+	)
+
+	precompileAddress := types.ERC20PrecompileAddress(constants.BaseDenom)
+
+	call := func(from common.Address, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+		res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contractABI, from, precompileAddress, true, method, args...)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	callFails := func(from common.Address, method string, args ...interface{}) {
+		_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contractABI, from, precompileAddress, true, method, args...)
+		suite.Require().Error(err)
+	}
+
+	query := func(method string, args ...interface{}) []interface{} {
+		res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contractABI, types.ModuleAddress, precompileAddress, false, method, args...)
+		suite.Require().NoError(err)
+
+		out, err := contractABI.Unpack(method, res.Ret)
+		suite.Require().NoError(err)
+		return out
+	}
+
+	balance := func(address common.Address) *big.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, address.Bytes(), constants.BaseDenom).Amount.BigInt()
+	}
+
+	testCases := []struct {
+		name string
+		test func()
+	}{
+		{
+			"balanceOf and totalSupply are read from the bank",
+			func() {
+				out := query(erc20precompile.BalanceOfMethod, suite.address)
+				suite.Require().Equal(balance(suite.address), out[0])
+
+				out = query(erc20precompile.TotalSupplyMethod)
+				supply := suite.app.BankKeeper.GetSupply(suite.ctx, constants.BaseDenom)
+				suite.Require().Equal(supply.Amount.BigInt(), out[0])
+			},
+		},
+		{
+			"metadata falls back to the denomination",
+			func() {
+				suite.Require().Equal(constants.BaseDenom, query(erc20precompile.NameMethod)[0])
+				suite.Require().Equal(constants.BaseDenom, query(erc20precompile.SymbolMethod)[0])
+				suite.Require().Equal(uint8(0), query(erc20precompile.DecimalsMethod)[0])
+			},
+		},
+		{
+			"metadata is read from the bank",
+			func() {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+					Base:    constants.BaseDenom,
+					Display: constants.DisplayDenom,
+					Name:    "Kairos",
+					Symbol:  constants.SymbolDenom,
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: constants.BaseDenom, Exponent: 0},
+						{Denom: constants.DisplayDenom, Exponent: constants.BaseDenomExponent},
+					},
+				})
+
+				suite.Require().Equal("Kairos", query(erc20precompile.NameMethod)[0])
+				suite.Require().Equal(constants.SymbolDenom, query(erc20precompile.SymbolMethod)[0])
+				suite.Require().Equal(uint8(constants.BaseDenomExponent), query(erc20precompile.DecimalsMethod)[0])
+			},
+		},
+		{
+			"transfer moves the bank balances and emits the Transfer event",
+			func() {
+				senderBalance := balance(suite.address)
+
+				res := call(suite.address, erc20precompile.TransferMethod, recipient, big.NewInt(100))
+				suite.Require().Empty(res.VmError)
+				suite.Require().Equal(big.NewInt(100), balance(recipient))
+				suite.Require().Equal(new(big.Int).Sub(senderBalance, big.NewInt(100)), balance(suite.address))
+
+				suite.Require().Len(res.Logs, 1)
+				suite.Require().Equal(precompileAddress.String(), res.Logs[0].Address)
+				suite.Require().Equal(contractABI.Events[erc20precompile.EventTypeTransfer].ID.String(), res.Logs[0].Topics[0])
+				suite.Require().Equal(common.BytesToHash(suite.address.Bytes()).String(), res.Logs[0].Topics[1])
+				suite.Require().Equal(common.BytesToHash(recipient.Bytes()).String(), res.Logs[0].Topics[2])
+				suite.Require().Equal(common.BigToHash(big.NewInt(100)).Bytes(), res.Logs[0].Data)
+			},
+		},
+		{
+			"transfer fails with insufficient balance",
+			func() {
+				callFails(suite.address, erc20precompile.TransferMethod, recipient, new(big.Int).Add(balance(suite.address), big.NewInt(1)))
+				suite.Require().Equal(int64(0), balance(recipient).Int64())
+			},
+		},
+		{
+			"transfer fails to the zero address",
+			func() {
+				callFails(suite.address, erc20precompile.TransferMethod, common.Address{}, big.NewInt(1))
+			},
+		},
+		{
+			"transfer fails to a blocked address",
+			func() {
+				moduleAddress := common.BytesToAddress(suite.app.AccountKeeper.GetModuleAddress("bonded_tokens_pool"))
+				callFails(suite.address, erc20precompile.TransferMethod, moduleAddress, big.NewInt(1))
+			},
+		},
+		{
+			"approve sets the allowance and emits the Approval event",
+			func() {
+				res := call(suite.address, erc20precompile.ApproveMethod, spender, big.NewInt(100))
+				suite.Require().Empty(res.VmError)
+				suite.Require().Len(res.Logs, 1)
+				suite.Require().Equal(contractABI.Events[erc20precompile.EventTypeApproval].ID.String(), res.Logs[0].Topics[0])
+
+				out := query(erc20precompile.AllowanceMethod, suite.address, spender)
+				suite.Require().Equal(big.NewInt(100), out[0])
+			},
+		},
+		{
+			"transferFrom spends the allowance",
+			func() {
+				call(suite.address, erc20precompile.ApproveMethod, spender, big.NewInt(100))
+
+				res := call(spender, erc20precompile.TransferFromMethod, suite.address, recipient, big.NewInt(60))
+				suite.Require().Empty(res.VmError)
+				suite.Require().Equal(big.NewInt(60), balance(recipient))
+				suite.Require().Equal(big.NewInt(40), query(erc20precompile.AllowanceMethod, suite.address, spender)[0])
+
+				callFails(spender, erc20precompile.TransferFromMethod, suite.address, recipient, big.NewInt(41))
+				suite.Require().Equal(big.NewInt(60), balance(recipient))
+				suite.Require().Equal(big.NewInt(40), query(erc20precompile.AllowanceMethod, suite.address, spender)[0])
+			},
+		},
+		{
+			"transferFrom does not spend an infinite allowance",
+			func() {
+				call(suite.address, erc20precompile.ApproveMethod, spender, abi.MaxUint256)
+				call(spender, erc20precompile.TransferFromMethod, suite.address, recipient, big.NewInt(60))
+
+				suite.Require().Equal(big.NewInt(60), balance(recipient))
+				suite.Require().Equal(abi.MaxUint256, query(erc20precompile.AllowanceMethod, suite.address, spender)[0])
+			},
+		},
+		{
+			"transferFrom fails without allowance",
+			func() {
+				callFails(spender, erc20precompile.TransferFromMethod, suite.address, recipient, big.NewInt(1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var err error
+			contractABI, err = erc20precompile.LoadABI()
+			suite.Require().NoError(err)
+
+			spender = common.BytesToAddress(crypto.Keccak256([]byte("spender")))
+			recipient = common.BytesToAddress(crypto.Keccak256([]byte("recipient")))
+
+			// the spender needs an account to send transactions
+			suite.app.AccountKeeper.SetAccount(
+				suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, sdk.AccAddress(spender.Bytes())),
+			)
+
+			tc.test()
+		})
+	}
+}
@@ -11,7 +11,8 @@ import (
 )
 
 // RegisterCoin deploys an erc20 contract and creates the token pair for the
-// existing cosmos coin
+// existing cosmos coin. The coins with an ERC20 precompile can't be registered,
+// the precompile is their ERC20 representation.
 func (k Keeper) RegisterCoin(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
@@ -23,6 +24,13 @@ func (k Keeper) RegisterCoin(
 		)
 	}
 
+	if k.HasERC20Precompile(ctx, coinMetadata.Base) {
+		return nil, errorsmod.Wrapf(
+			types.ErrNativeCoinConversion, "coin %s is represented by the ERC20 precompile %s",
+			coinMetadata.Base, types.ERC20PrecompileAddress(coinMetadata.Base),
+		)
+	}
+
 	// Check if the coin exists by ensuring the supply is set
 	if !k.bankKeeper.HasSupply(ctx, coinMetadata.Base) {
 		return nil, errorsmod.Wrapf(
@@ -185,6 +185,16 @@ func (suite KeeperTestSuite) TestRegisterCoin() { //nolint:govet // we can copy
 			},
 			false,
 		},
+		{
+			"coin represented by its ERC20 precompile",
+			func() {
+				metadata.Base = cosmosTokenBase
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadata.Base, 1)})
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.RegisterERC20Precompile(suite.ctx, metadata.Base)
+			},
+			false,
+		},
 		{
 			"ok",
 			func() {
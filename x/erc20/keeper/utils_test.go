@@ -359,6 +359,18 @@ func (suite *KeeperTestSuite) DeployContractToChain(name, symbol string, decimal
 	)
 }
 
+// MintLegacyERC20 escrows the coins of a Cosmos-native token pair and mints its
+// ERC20 tokens to the holder, like a conversion made before the ERC20 precompile
+// of the coin was deployed.
+func (suite *KeeperTestSuite) MintLegacyERC20(ctx sdk.Context, pair *types.TokenPair, holder sdk.AccAddress, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, amount))
+	err := suite.app.BankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.Erc20Keeper.CallEVM(ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, types.ModuleAddress, pair.GetERC20Contract(), true, "mint", common.BytesToAddress(holder.Bytes()), big.NewInt(amount))
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) sendAndReceiveMessage(
 	path *ibctesting.Path,
 	originEndpoint *ibctesting.Endpoint,
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	evertypes "github.com/HarryBin2002/kairoschain/v12/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ERC20PrecompileAddress returns the address of the ERC20 precompile of the given
+// Cosmos coin denomination.
+func ERC20PrecompileAddress(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(ModuleName), []byte(denom)))
+}
+
+// NewAllowance returns an instance of Allowance
+func NewAllowance(denom string, owner, spender common.Address, value *big.Int) Allowance {
+	return Allowance{
+		Denom:   denom,
+		Owner:   owner.String(),
+		Spender: spender.String(),
+		Value:   sdkmath.NewIntFromBigInt(value),
+	}
+}
+
+// Validate performs a stateless validation of an Allowance
+func (a Allowance) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+
+	if err := evertypes.ValidateAddress(a.Owner); err != nil {
+		return err
+	}
+
+	if err := evertypes.ValidateAddress(a.Spender); err != nil {
+		return err
+	}
+
+	if a.Value.IsNil() || !a.Value.IsPositive() {
+		return fmt.Errorf("allowance value must be positive: %s", a.Value)
+	}
+
+	if a.Value.BigInt().Cmp(math.MaxBig256) > 0 {
+		return fmt.Errorf("allowance value overflows uint256: %s", a.Value)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -131,6 +132,73 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+// Allowance defines the amount of a Cosmos coin that a spender is allowed to
+// transfer on behalf of an owner through the ERC20 precompile of the coin.
+type Allowance struct {
+	// denom is the cosmos base denomination of the allowance
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// owner is the hex address of the account that granted the allowance
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the hex address of the account allowed to transfer the coins
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// value is the amount of coins that the spender is allowed to transfer
+	Value github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
+func (m *Allowance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Allowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Allowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
 // RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin.
 type RegisterCoinProposal struct {
@@ -146,7 +214,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{1}
+	return fileDescriptor_668d5dc537f45142, []int{2}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,7 +279,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{2}
+	return fileDescriptor_668d5dc537f45142, []int{3}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +345,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{3}
+	return fileDescriptor_668d5dc537f45142, []int{4}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +406,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,6 +445,7 @@ func (m *ProposalMetadata) GetMetadata() []types.Metadata {
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*Allowance)(nil), "evmos.erc20.v1.Allowance")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
 	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "evmos.erc20.v1.ToggleTokenConversionProposal")
@@ -386,39 +455,44 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6b, 0x13, 0x41,
-	0x18, 0xdd, 0x69, 0x52, 0x6d, 0xa6, 0x6d, 0x88, 0x43, 0x02, 0x4b, 0xa0, 0xdb, 0x10, 0x41, 0x82,
-	0xe0, 0x6e, 0x13, 0x6f, 0x22, 0x48, 0x93, 0xae, 0x52, 0x69, 0x93, 0xb0, 0x4d, 0x51, 0xbc, 0x84,
-	0xc9, 0xee, 0xc7, 0xba, 0x34, 0x99, 0x09, 0x33, 0xe3, 0xaa, 0x07, 0xef, 0x1e, 0xbd, 0x78, 0x17,
-	0xf4, 0x8f, 0xe9, 0xb1, 0x47, 0x4f, 0x22, 0xc9, 0xc5, 0x3f, 0x43, 0x76, 0x66, 0x57, 0xac, 0x47,
-	0x7b, 0xfb, 0xde, 0xfb, 0x7e, 0xec, 0xdb, 0xf7, 0x18, 0xdc, 0x84, 0x74, 0xc1, 0xa5, 0x07, 0x22,
-	0xec, 0x1d, 0x78, 0x69, 0xd7, 0x14, 0xee, 0x52, 0x70, 0xc5, 0x49, 0x55, 0xf7, 0x5c, 0x43, 0xa5,
-	0xdd, 0xa6, 0x13, 0x72, 0x99, 0x0d, 0xcf, 0x28, 0xbb, 0xf0, 0xd2, 0xee, 0x0c, 0x14, 0xed, 0x6a,
-	0x60, 0xe6, 0x9b, 0xf5, 0x98, 0xc7, 0x5c, 0x97, 0x5e, 0x56, 0x19, 0xb6, 0xfd, 0x0d, 0xe1, 0xca,
-	0x84, 0x5f, 0x00, 0x1b, 0xd3, 0x44, 0x90, 0xbb, 0x78, 0x57, 0xdf, 0x9b, 0xd2, 0x28, 0x12, 0x20,
-	0xa5, 0x8d, 0x5a, 0xa8, 0x53, 0x09, 0x76, 0x34, 0x79, 0x68, 0x38, 0x52, 0xc7, 0x9b, 0x11, 0x30,
-	0xbe, 0xb0, 0x37, 0x74, 0xd3, 0x00, 0x62, 0xe3, 0xdb, 0xc0, 0xe8, 0x6c, 0x0e, 0x91, 0x5d, 0x6a,
-	0xa1, 0xce, 0x56, 0x50, 0x40, 0xf2, 0x18, 0x57, 0x43, 0xce, 0x94, 0xa0, 0xa1, 0x9a, 0xf2, 0xb7,
-	0x0c, 0x84, 0x5d, 0x6e, 0xa1, 0x4e, 0xb5, 0xd7, 0x70, 0xaf, 0xff, 0x81, 0x3b, 0xca, 0x9a, 0xc1,
-	0x6e, 0x31, 0xac, 0xe1, 0xa3, 0xf2, 0xaf, 0x2f, 0xfb, 0xa8, 0xfd, 0x19, 0xe1, 0x7a, 0x00, 0x71,
-	0x22, 0x15, 0x88, 0x01, 0x4f, 0xd8, 0x58, 0xf0, 0x25, 0x97, 0x74, 0x9e, 0x89, 0x51, 0x89, 0x9a,
-	0x43, 0xae, 0xd4, 0x00, 0xd2, 0xc2, 0xdb, 0x11, 0xc8, 0x50, 0x24, 0x4b, 0x95, 0x70, 0x96, 0x0b,
-	0xfd, 0x9b, 0x22, 0x4f, 0xf0, 0xd6, 0x02, 0x14, 0x8d, 0xa8, 0xa2, 0x76, 0xa9, 0x55, 0xea, 0x6c,
-	0xf7, 0xf6, 0x5c, 0x63, 0xa0, 0xab, 0x3d, 0xcb, 0x0d, 0x74, 0x4f, 0xf3, 0xa1, 0x7e, 0xf9, 0xf2,
-	0xc7, 0xbe, 0x15, 0xfc, 0x59, 0xd2, 0xba, 0xac, 0xf6, 0x07, 0xdc, 0x28, 0x64, 0xf9, 0xc1, 0xa0,
-	0x77, 0x70, 0x63, 0x5d, 0xf7, 0x70, 0x55, 0xfb, 0x91, 0x07, 0x00, 0x52, 0xab, 0xab, 0x04, 0xff,
-	0xb0, 0xf9, 0xe7, 0x25, 0xde, 0x9b, 0xf0, 0x38, 0x9e, 0x83, 0x8e, 0x70, 0xc0, 0x59, 0x0a, 0x42,
-	0x26, 0xfc, 0xe6, 0xf6, 0x64, 0x7b, 0xd9, 0x49, 0x9d, 0x65, 0xb6, 0x97, 0x81, 0x3c, 0x8b, 0x33,
-	0x5c, 0x2b, 0xee, 0x17, 0xee, 0x5c, 0xb3, 0x13, 0xfd, 0x87, 0x9d, 0xf7, 0x9f, 0xe3, 0x4d, 0x9d,
-	0x37, 0x69, 0xe0, 0x3b, 0xa3, 0x17, 0x43, 0x3f, 0x98, 0x9e, 0x0f, 0xcf, 0xc6, 0xfe, 0xe0, 0xf8,
-	0xe9, 0xb1, 0x7f, 0x54, 0xb3, 0x48, 0x0d, 0xef, 0x18, 0xfa, 0x74, 0x74, 0x74, 0x7e, 0xe2, 0xd7,
-	0x10, 0x21, 0xb8, 0x6a, 0x18, 0xff, 0xe5, 0xc4, 0x0f, 0x86, 0x87, 0x27, 0xb5, 0x8d, 0x66, 0xf9,
-	0xe3, 0x57, 0xc7, 0xea, 0x3f, 0xbb, 0x5c, 0x39, 0xe8, 0x6a, 0xe5, 0xa0, 0x9f, 0x2b, 0x07, 0x7d,
-	0x5a, 0x3b, 0xd6, 0xd5, 0xda, 0xb1, 0xbe, 0xaf, 0x1d, 0xeb, 0xd5, 0x83, 0x38, 0x51, 0xaf, 0xdf,
-	0xcc, 0xdc, 0x90, 0x2f, 0x3c, 0x5f, 0x86, 0x94, 0xf5, 0x7d, 0x0f, 0x52, 0x10, 0x8b, 0x84, 0x29,
-	0x2f, 0xed, 0xf6, 0xbc, 0x77, 0xf9, 0x5b, 0x53, 0xef, 0x97, 0x20, 0x67, 0xb7, 0xf4, 0x1b, 0x79,
-	0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x2c, 0xbd, 0x0b, 0x87, 0x03, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x6b, 0xdb, 0x3e,
+	0x14, 0xc7, 0xad, 0x36, 0xf9, 0xfd, 0x6a, 0xb5, 0x0d, 0x99, 0x48, 0xc1, 0x04, 0xea, 0x86, 0x0c,
+	0x4a, 0x18, 0xcc, 0x4e, 0x3c, 0x76, 0x19, 0x83, 0xd1, 0xa4, 0x1e, 0xeb, 0x68, 0x9b, 0xe2, 0xb6,
+	0x6c, 0xec, 0x52, 0x14, 0x5b, 0xb8, 0x26, 0x8e, 0x14, 0x24, 0xd5, 0x5d, 0x0f, 0xbb, 0xef, 0xb8,
+	0xcb, 0xd8, 0x75, 0xb0, 0xfd, 0x31, 0x3d, 0xf6, 0x38, 0x76, 0x28, 0xa3, 0xb9, 0xec, 0xcf, 0x18,
+	0x96, 0xec, 0x2d, 0xdd, 0x71, 0x3d, 0x45, 0xdf, 0xaf, 0xde, 0x7b, 0xf9, 0xf8, 0xbd, 0x67, 0xc3,
+	0x26, 0xc9, 0x26, 0x4c, 0xb8, 0x84, 0x87, 0x5e, 0xd7, 0xcd, 0x7a, 0xfa, 0xe0, 0x4c, 0x39, 0x93,
+	0x0c, 0xd5, 0xd4, 0x9d, 0xa3, 0xad, 0xac, 0xd7, 0xb4, 0x43, 0x26, 0xf2, 0xe0, 0x11, 0xa6, 0x63,
+	0x37, 0xeb, 0x8d, 0x88, 0xc4, 0x3d, 0x25, 0x74, 0x7c, 0xb3, 0x11, 0xb3, 0x98, 0xa9, 0xa3, 0x9b,
+	0x9f, 0xb4, 0xdb, 0xfe, 0x0a, 0xa0, 0x79, 0xc4, 0xc6, 0x84, 0x1e, 0xe0, 0x84, 0xa3, 0xfb, 0x70,
+	0x55, 0xd5, 0x3b, 0xc1, 0x51, 0xc4, 0x89, 0x10, 0x16, 0x68, 0x81, 0x8e, 0x19, 0xac, 0x28, 0x73,
+	0x4b, 0x7b, 0xa8, 0x01, 0xab, 0x11, 0xa1, 0x6c, 0x62, 0x2d, 0xa8, 0x4b, 0x2d, 0x90, 0x05, 0xff,
+	0x27, 0x14, 0x8f, 0x52, 0x12, 0x59, 0x8b, 0x2d, 0xd0, 0x59, 0x0a, 0x4a, 0x89, 0x9e, 0xc2, 0x5a,
+	0xc8, 0xa8, 0xe4, 0x38, 0x94, 0x27, 0xec, 0x9c, 0x12, 0x6e, 0x55, 0x5a, 0xa0, 0x53, 0xf3, 0xd6,
+	0x9c, 0xdb, 0x4f, 0xe0, 0x0c, 0xf3, 0xcb, 0x60, 0xb5, 0x0c, 0x56, 0xf2, 0x49, 0xe5, 0xe7, 0xe7,
+	0x0d, 0xd0, 0xfe, 0x04, 0xa0, 0xb9, 0x95, 0xa6, 0xec, 0x1c, 0xd3, 0x90, 0xfc, 0x21, 0x00, 0xf3,
+	0x04, 0x0d, 0x58, 0xd5, 0xe5, 0x0b, 0x2e, 0x25, 0x72, 0x2e, 0x31, 0x25, 0x34, 0x22, 0x5c, 0x71,
+	0x99, 0x41, 0x29, 0xd1, 0x36, 0xac, 0x66, 0x38, 0x3d, 0x23, 0x0a, 0xc7, 0xec, 0x3b, 0x97, 0xd7,
+	0x1b, 0xc6, 0xf7, 0xeb, 0x8d, 0xcd, 0x38, 0x91, 0xa7, 0x67, 0x23, 0x27, 0x64, 0x13, 0xb7, 0x68,
+	0xa9, 0xfe, 0x79, 0x28, 0xa2, 0xb1, 0x2b, 0x2f, 0xa6, 0x44, 0x38, 0x3b, 0x54, 0x06, 0x3a, 0xb9,
+	0xfd, 0x11, 0xc0, 0x46, 0x40, 0xe2, 0x44, 0x48, 0xc2, 0x07, 0x2c, 0xa1, 0x07, 0x9c, 0x4d, 0x99,
+	0xc0, 0x69, 0x8e, 0x23, 0x13, 0x99, 0x92, 0x12, 0x52, 0x09, 0xd4, 0x82, 0xcb, 0x11, 0x11, 0x21,
+	0x4f, 0xa6, 0x32, 0x61, 0xb4, 0x40, 0x9d, 0xb7, 0xd0, 0x33, 0xb8, 0x34, 0x21, 0x12, 0x47, 0x58,
+	0x62, 0x6b, 0xb1, 0xb5, 0xd8, 0x59, 0xf6, 0xd6, 0x1d, 0x0d, 0xe0, 0xa8, 0x69, 0x16, 0xa3, 0x75,
+	0xf6, 0x8a, 0xa0, 0x7e, 0x25, 0x07, 0x0f, 0x7e, 0x27, 0xa9, 0x8e, 0x19, 0xed, 0x77, 0x70, 0xad,
+	0xc4, 0xf2, 0x83, 0x81, 0xd7, 0xbd, 0x33, 0xd7, 0x26, 0xac, 0xa9, 0x49, 0x15, 0xab, 0x41, 0x84,
+	0xa2, 0x33, 0x83, 0xbf, 0xdc, 0xe2, 0xef, 0x05, 0x5c, 0x3f, 0x62, 0x71, 0x9c, 0x12, 0xb5, 0x5c,
+	0x03, 0x46, 0x33, 0xc2, 0x45, 0xc2, 0xee, 0xde, 0x9e, 0x3c, 0x2f, 0x2f, 0x59, 0x4c, 0x53, 0x8b,
+	0x62, 0x4b, 0x0e, 0x61, 0xbd, 0xac, 0x5f, 0x76, 0xe7, 0x56, 0x3b, 0xc1, 0x3f, 0xb4, 0xf3, 0xc1,
+	0x4b, 0x58, 0x55, 0x9b, 0x88, 0xd6, 0xe0, 0xbd, 0xe1, 0xab, 0x7d, 0x3f, 0x38, 0x39, 0xde, 0x3f,
+	0x3c, 0xf0, 0x07, 0x3b, 0xcf, 0x77, 0xfc, 0xed, 0xba, 0x81, 0xea, 0x70, 0x45, 0xdb, 0x7b, 0xc3,
+	0xed, 0xe3, 0x5d, 0xbf, 0x0e, 0x10, 0x82, 0x35, 0xed, 0xf8, 0xaf, 0x8f, 0xfc, 0x60, 0x7f, 0x6b,
+	0xb7, 0xbe, 0xd0, 0xac, 0xbc, 0xff, 0x62, 0x1b, 0xfd, 0xe1, 0xe5, 0x8d, 0x0d, 0xae, 0x6e, 0x6c,
+	0xf0, 0xe3, 0xc6, 0x06, 0x1f, 0x66, 0xb6, 0x71, 0x35, 0xb3, 0x8d, 0x6f, 0x33, 0xdb, 0x78, 0xf3,
+	0x78, 0x6e, 0xeb, 0x5e, 0x60, 0xce, 0x2f, 0xfa, 0x09, 0xf5, 0xba, 0x5d, 0xcf, 0x1d, 0xe3, 0x84,
+	0x33, 0x11, 0x9e, 0xe2, 0x84, 0xba, 0x59, 0xcf, 0x73, 0xdf, 0x16, 0x5f, 0x03, 0xb5, 0x88, 0xa3,
+	0xff, 0xd4, 0x5b, 0xfc, 0xe8, 0xd7, 0x00, 0xf0, 0xa6, 0xc1, 0x3c, 0x29, 0x04, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -536,6 +610,60 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -748,6 +876,29 @@ func (m *TokenPair) Size() (n int) {
 	return n
 }
 
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
 func (m *RegisterCoinProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -989,6 +1140,186 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
@@ -40,5 +44,20 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenAllowances := make(map[string]bool)
+
+	for _, a := range gs.Allowances {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		key := string(AllowanceKey(a.Denom, common.HexToAddress(a.Owner), common.HexToAddress(a.Spender)))
+		if seenAllowances[key] {
+			return fmt.Errorf("allowance duplicated on genesis: '%s' '%s' '%s'", a.Denom, a.Owner, a.Spender)
+		}
+
+		seenAllowances[key] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -29,6 +29,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// allowances is a slice of the ERC20 precompile allowances at genesis
+	Allowances []Allowance `protobuf:"bytes,3,rep,name=allowances,proto3" json:"allowances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,13 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -142,27 +151,29 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x1c, 0xc5, 0x2f, 0x55, 0x8a, 0xe4, 0x5a, 0xc5, 0x43, 0xa4, 0x16, 0x49, 0x6b, 0xa7, 0x2e, 0x26,
-	0xf6, 0x74, 0x71, 0x93, 0x83, 0xa3, 0x2e, 0x42, 0xa9, 0xe2, 0xe0, 0x52, 0xd2, 0x12, 0xaf, 0x47,
-	0xcd, 0xe5, 0x48, 0x62, 0xd0, 0x2f, 0xe0, 0xec, 0xc7, 0xea, 0xd8, 0xd1, 0xa9, 0xc8, 0xf5, 0x8b,
-	0xc8, 0x25, 0xd7, 0xc1, 0x6e, 0xff, 0xbc, 0xf7, 0x7b, 0xff, 0xfc, 0x79, 0xf0, 0x9c, 0x19, 0x2e,
-	0x14, 0x61, 0x72, 0x16, 0x5e, 0x11, 0x33, 0x20, 0x09, 0xcb, 0x98, 0x4a, 0x15, 0xce, 0xa5, 0xd0,
-	0x22, 0x38, 0xb4, 0x2e, 0xb6, 0x2e, 0x36, 0x83, 0x76, 0x7b, 0x87, 0x76, 0x86, 0x65, 0xdb, 0x27,
-	0x89, 0x48, 0x84, 0x1d, 0x49, 0x39, 0x39, 0xb5, 0xf7, 0x05, 0x60, 0x63, 0xe8, 0x76, 0x3e, 0x6a,
-	0xaa, 0x59, 0x70, 0x03, 0xeb, 0x39, 0x95, 0x94, 0xab, 0x16, 0xe8, 0x82, 0xbe, 0x1f, 0x9e, 0xe2,
-	0xff, 0x7f, 0xe0, 0x91, 0x75, 0xa3, 0xfd, 0xe5, 0xba, 0xe3, 0x8d, 0x2b, 0x36, 0xb8, 0x83, 0xbe,
-	0x16, 0x0b, 0x96, 0x4d, 0x72, 0x9a, 0x4a, 0xd5, 0xaa, 0x75, 0xf7, 0xfa, 0x7e, 0x78, 0xb6, 0x1b,
-	0x7d, 0x2a, 0x91, 0x11, 0x4d, 0x65, 0x95, 0x86, 0x7a, 0x2b, 0xa8, 0xde, 0x2b, 0xac, 0xbb, 0xcd,
-	0xc1, 0x05, 0x6c, 0xb0, 0x8c, 0x4e, 0xdf, 0xd8, 0xc4, 0x06, 0xed, 0x1d, 0x07, 0x63, 0xdf, 0x69,
-	0x71, 0x29, 0x05, 0xb7, 0xf0, 0x68, 0x8b, 0x18, 0x3e, 0x99, 0x0b, 0xb1, 0x68, 0xd5, 0x4a, 0x2a,
-	0x3a, 0x2e, 0xd6, 0x9d, 0x66, 0xec, 0xc8, 0xe7, 0x87, 0x7b, 0x21, 0x16, 0xe3, 0x66, 0x15, 0x34,
-	0xbc, 0x7c, 0x46, 0xc3, 0x65, 0x81, 0xc0, 0xaa, 0x40, 0xe0, 0xb7, 0x40, 0xe0, 0x7b, 0x83, 0xbc,
-	0xd5, 0x06, 0x79, 0x3f, 0x1b, 0xe4, 0xbd, 0x5c, 0x26, 0xa9, 0x9e, 0xbf, 0x4f, 0xf1, 0x4c, 0x70,
-	0x12, 0xab, 0x19, 0xcd, 0xa2, 0x98, 0x30, 0xc3, 0x24, 0x4f, 0x33, 0x4d, 0xcc, 0x20, 0x24, 0x1f,
-	0x55, 0xb1, 0xfa, 0x33, 0x67, 0x6a, 0x5a, 0xb7, 0x05, 0x5e, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x1c, 0x74, 0x93, 0xe2, 0xa2, 0x01, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4f, 0xc2, 0x30,
+	0x1c, 0xc5, 0x37, 0x30, 0xc4, 0x74, 0xa0, 0x71, 0x31, 0x66, 0x12, 0x33, 0x90, 0x13, 0xa7, 0x15,
+	0xa6, 0x1e, 0x3c, 0xa9, 0x4b, 0x88, 0x5c, 0x8c, 0x04, 0x8d, 0x07, 0x2f, 0xa4, 0x2c, 0x75, 0x2c,
+	0x63, 0xfb, 0x2f, 0x6d, 0x9d, 0xf2, 0x2d, 0xfc, 0x56, 0x72, 0xe4, 0xe8, 0x89, 0x98, 0xf1, 0x45,
+	0xcc, 0xba, 0x2d, 0x46, 0xe2, 0xed, 0xdf, 0xf7, 0x7e, 0xef, 0xb5, 0xcd, 0x1f, 0x9d, 0xd0, 0x24,
+	0x04, 0x8e, 0x29, 0x73, 0xed, 0x1e, 0x4e, 0xfa, 0xd8, 0xa3, 0x11, 0xe5, 0x3e, 0xb7, 0x62, 0x06,
+	0x02, 0xf4, 0x3d, 0xe9, 0x5a, 0xd2, 0xb5, 0x92, 0x7e, 0xb3, 0xb9, 0x45, 0xe7, 0x86, 0x64, 0x9b,
+	0x87, 0x1e, 0x78, 0x20, 0x47, 0x9c, 0x4d, 0xb9, 0xda, 0xf9, 0x54, 0x51, 0xfd, 0x36, 0xef, 0x7c,
+	0x10, 0x44, 0x50, 0xfd, 0x1c, 0xd5, 0x62, 0xc2, 0x48, 0xc8, 0x0d, 0xb5, 0xad, 0x76, 0x35, 0xfb,
+	0xc8, 0xfa, 0x7b, 0x87, 0x35, 0x92, 0xae, 0xb3, 0xb3, 0x5c, 0xb7, 0x94, 0x71, 0xc1, 0xea, 0xd7,
+	0x48, 0x13, 0x10, 0xd0, 0x68, 0x12, 0x13, 0x9f, 0x71, 0xa3, 0xd2, 0xae, 0x76, 0x35, 0xfb, 0x78,
+	0x3b, 0xfa, 0x98, 0x21, 0x23, 0xe2, 0xb3, 0x22, 0x8d, 0x44, 0x29, 0x70, 0xfd, 0x0a, 0x21, 0x32,
+	0x9f, 0xc3, 0x1b, 0x89, 0x5c, 0xca, 0x8d, 0xea, 0xff, 0x05, 0x37, 0x25, 0x51, 0x16, 0xfc, 0x46,
+	0x3a, 0x2f, 0xa8, 0x96, 0x3f, 0x4d, 0x3f, 0x45, 0x75, 0x1a, 0x91, 0xe9, 0x9c, 0x4e, 0x64, 0x50,
+	0x7e, 0x64, 0x77, 0xac, 0xe5, 0xda, 0x20, 0x93, 0xf4, 0x4b, 0xb4, 0x5f, 0x22, 0x49, 0x38, 0x99,
+	0x01, 0x04, 0x46, 0x25, 0xa3, 0x9c, 0x83, 0x74, 0xdd, 0x6a, 0x0c, 0x72, 0xf2, 0xe9, 0x6e, 0x08,
+	0x10, 0x8c, 0x1b, 0x45, 0x30, 0x09, 0xb3, 0xa3, 0x73, 0xbf, 0x4c, 0x4d, 0x75, 0x95, 0x9a, 0xea,
+	0x77, 0x6a, 0xaa, 0x1f, 0x1b, 0x53, 0x59, 0x6d, 0x4c, 0xe5, 0x6b, 0x63, 0x2a, 0xcf, 0x17, 0x9e,
+	0x2f, 0x66, 0xaf, 0x53, 0xcb, 0x85, 0x10, 0x0f, 0x09, 0x63, 0x0b, 0xc7, 0x8f, 0xec, 0x5e, 0xcf,
+	0xc6, 0x01, 0xf1, 0x19, 0x70, 0x77, 0x46, 0xfc, 0x08, 0x27, 0x7d, 0x1b, 0xbf, 0x17, 0x1b, 0x12,
+	0x8b, 0x98, 0xf2, 0x69, 0x4d, 0x6e, 0xe2, 0xec, 0x67, 0x00, 0xe0, 0xe3, 0xb5, 0x67, 0xeb, 0x01,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +196,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -280,6 +305,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -400,6 +431,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
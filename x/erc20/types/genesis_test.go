@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/HarryBin2002/kairoschain/v12/x/erc20/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -58,6 +59,72 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: true,
 		},
+		{
+			name: "valid genesis - with allowances",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Allowances: []types.Allowance{
+					{
+						Denom:   "usdt",
+						Owner:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender: "0xB8c77482e45F1F44dE1745F52C74426C631bDD52",
+						Value:   math.NewInt(100),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated allowance",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Allowances: []types.Allowance{
+					{
+						Denom:   "usdt",
+						Owner:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender: "0xB8c77482e45F1F44dE1745F52C74426C631bDD52",
+						Value:   math.NewInt(100),
+					},
+					{
+						Denom:   "usdt",
+						Owner:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender: "0xB8c77482e45F1F44dE1745F52C74426C631bDD52",
+						Value:   math.NewInt(200),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid allowance spender",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Allowances: []types.Allowance{
+					{
+						Denom:   "usdt",
+						Owner:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender: "0x0000",
+						Value:   math.NewInt(100),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero allowance",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Allowances: []types.Allowance{
+					{
+						Denom:   "usdt",
+						Owner:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender: "0xB8c77482e45F1F44dE1745F52C74426C631bDD52",
+						Value:   math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid genesis - duplicated token pair",
 			genState: &types.GenesisState{
@@ -29,6 +29,8 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixERC20Precompile
+	prefixAllowance
 )
 
 // KVStore key prefixes
@@ -36,4 +38,15 @@ var (
 	KeyPrefixTokenPair        = []byte{prefixTokenPair}
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixERC20Precompile  = []byte{prefixERC20Precompile}
+	KeyPrefixAllowance        = []byte{prefixAllowance}
 )
+
+// AllowanceKey returns the store key of the allowance granted by the owner to the
+// spender for the given denomination: owner | spender | denom.
+func AllowanceKey(denom string, owner, spender common.Address) []byte {
+	key := make([]byte, 0, 2*common.AddressLength+len(denom))
+	key = append(key, owner.Bytes()...)
+	key = append(key, spender.Bytes()...)
+	return append(key, denom...)
+}
@@ -227,6 +227,100 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
+// QueryErc20PrecompileRequest is the request type for the Query/Erc20Precompile
+// RPC method.
+type QueryErc20PrecompileRequest struct {
+	// denom is the Cosmos coin denomination of the ERC20 precompile
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryErc20PrecompileRequest) Reset()         { *m = QueryErc20PrecompileRequest{} }
+func (m *QueryErc20PrecompileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryErc20PrecompileRequest) ProtoMessage()    {}
+func (*QueryErc20PrecompileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{4}
+}
+func (m *QueryErc20PrecompileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryErc20PrecompileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryErc20PrecompileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryErc20PrecompileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryErc20PrecompileRequest.Merge(m, src)
+}
+func (m *QueryErc20PrecompileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryErc20PrecompileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryErc20PrecompileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryErc20PrecompileRequest proto.InternalMessageInfo
+
+func (m *QueryErc20PrecompileRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryErc20PrecompileResponse is the response type for the
+// Query/Erc20Precompile RPC method.
+type QueryErc20PrecompileResponse struct {
+	// address is the hex address of the ERC20 precompile
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryErc20PrecompileResponse) Reset()         { *m = QueryErc20PrecompileResponse{} }
+func (m *QueryErc20PrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryErc20PrecompileResponse) ProtoMessage()    {}
+func (*QueryErc20PrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{5}
+}
+func (m *QueryErc20PrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryErc20PrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryErc20PrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryErc20PrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryErc20PrecompileResponse.Merge(m, src)
+}
+func (m *QueryErc20PrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryErc20PrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryErc20PrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryErc20PrecompileResponse proto.InternalMessageInfo
+
+func (m *QueryErc20PrecompileResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -235,7 +329,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fba814bce17cabdf, []int{4}
+	return fileDescriptor_fba814bce17cabdf, []int{6}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +369,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fba814bce17cabdf, []int{5}
+	return fileDescriptor_fba814bce17cabdf, []int{7}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,6 +410,8 @@ func init() {
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairRequest)(nil), "evmos.erc20.v1.QueryTokenPairRequest")
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
+	proto.RegisterType((*QueryErc20PrecompileRequest)(nil), "evmos.erc20.v1.QueryErc20PrecompileRequest")
+	proto.RegisterType((*QueryErc20PrecompileResponse)(nil), "evmos.erc20.v1.QueryErc20PrecompileResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
 }
@@ -323,39 +419,45 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
 
 var fileDescriptor_fba814bce17cabdf = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x5b, 0x1a, 0x29, 0x2f, 0x12, 0xc3, 0x11, 0x42, 0x30, 0x60, 0x2a, 0x47, 0x4d, 0x2b,
-	0x50, 0xef, 0xb0, 0x61, 0x46, 0x28, 0x52, 0xe9, 0xc0, 0x12, 0x22, 0x06, 0xc4, 0x02, 0x97, 0xe8,
-	0x64, 0x2c, 0xc8, 0x9d, 0xe3, 0xbb, 0x58, 0x54, 0x88, 0xa5, 0x0b, 0x62, 0x43, 0xe2, 0x2b, 0xf0,
-	0x61, 0x3a, 0x56, 0x62, 0x61, 0x42, 0x28, 0xe1, 0x83, 0x20, 0xdf, 0x5d, 0x9c, 0xda, 0xad, 0x92,
-	0xcd, 0x7e, 0xef, 0xfd, 0xfe, 0xbd, 0x67, 0x83, 0xcb, 0xb2, 0x89, 0x90, 0x84, 0xa5, 0xe3, 0xf0,
-	0x11, 0xc9, 0x02, 0x32, 0x9d, 0xb1, 0xf4, 0x04, 0x27, 0xa9, 0x50, 0x02, 0x5d, 0xd7, 0x3d, 0xac,
-	0x7b, 0x38, 0x0b, 0xdc, 0x07, 0x63, 0x21, 0xf3, 0xe1, 0x11, 0x95, 0xcc, 0x0c, 0x92, 0x2c, 0x18,
-	0x31, 0x45, 0x03, 0x92, 0xd0, 0x28, 0xe6, 0x54, 0xc5, 0x82, 0x1b, 0xac, 0x5b, 0xe5, 0x35, 0x24,
-	0xa6, 0x77, 0xb7, 0xd2, 0x8b, 0x18, 0x67, 0x32, 0x96, 0xb6, 0xdb, 0x8a, 0x44, 0x24, 0xf4, 0x23,
-	0xc9, 0x9f, 0x96, 0x98, 0x48, 0x88, 0xe8, 0x23, 0x23, 0x34, 0x89, 0x09, 0xe5, 0x5c, 0x28, 0x2d,
-	0x66, 0x31, 0xfe, 0x3b, 0x68, 0xbf, 0xcc, 0xfd, 0xbc, 0x12, 0x1f, 0x18, 0x1f, 0xd0, 0x38, 0x95,
-	0x43, 0x36, 0x9d, 0x31, 0xa9, 0xd0, 0x73, 0x80, 0x95, 0xb7, 0x8e, 0xb3, 0xeb, 0x1c, 0x34, 0xc3,
-	0x1e, 0x36, 0x41, 0x70, 0x1e, 0x04, 0x9b, 0xc4, 0x36, 0x08, 0x1e, 0xd0, 0x88, 0x59, 0xec, 0xf0,
-	0x02, 0xd2, 0xff, 0xe9, 0xc0, 0xad, 0x4b, 0x12, 0x32, 0x11, 0x5c, 0x32, 0xf4, 0x0c, 0x9a, 0x2a,
-	0xaf, 0xbe, 0x4d, 0xf2, 0x72, 0xc7, 0xd9, 0xdd, 0x3e, 0x68, 0x86, 0xb7, 0x71, 0x79, 0x7b, 0xb8,
-	0x00, 0xf6, 0xaf, 0x9d, 0xfd, 0xb9, 0x5f, 0x1b, 0x82, 0x2a, 0x98, 0xd0, 0x71, 0xc9, 0xe5, 0x96,
-	0x76, 0xb9, 0xbf, 0xd1, 0xa5, 0x91, 0x2f, 0xd9, 0x3c, 0x84, 0x9b, 0x65, 0x97, 0xcb, 0x3d, 0xb4,
-	0x60, 0x47, 0xeb, 0xe9, 0x15, 0x34, 0x86, 0xe6, 0xc5, 0x7f, 0x5d, 0xdd, 0x5b, 0x91, 0xe9, 0x29,
-	0xc0, 0x2a, 0x93, 0xdd, 0xdb, 0xc6, 0x48, 0x8d, 0x22, 0x92, 0xdf, 0x02, 0xa4, 0x99, 0x07, 0x34,
-	0xa5, 0x93, 0xe5, 0x35, 0xfc, 0x17, 0x70, 0xa3, 0x54, 0xb5, 0x62, 0x4f, 0xa0, 0x9e, 0xe8, 0x8a,
-	0x15, 0x6a, 0x57, 0x85, 0xcc, 0xbc, 0x55, 0xb1, 0xb3, 0xe1, 0xb7, 0x6d, 0xd8, 0xd1, 0x6c, 0xe8,
-	0xd4, 0x01, 0x58, 0xdd, 0x05, 0xf5, 0xaa, 0xf0, 0xab, 0xbf, 0x0d, 0x77, 0x7f, 0xe3, 0x9c, 0xf1,
-	0xe7, 0x77, 0x4f, 0x7f, 0xfd, 0xfb, 0xb1, 0x75, 0x0f, 0xdd, 0x21, 0x95, 0x2f, 0xf7, 0xc2, 0xd9,
-	0xd1, 0x57, 0x07, 0x1a, 0x05, 0x16, 0xed, 0xad, 0xe7, 0x5e, 0x5a, 0xe8, 0x6d, 0x1a, 0xb3, 0x0e,
-	0x1e, 0x6a, 0x07, 0x7b, 0xa8, 0xbb, 0xc6, 0x01, 0xf9, 0xac, 0x5f, 0xbe, 0xa0, 0x29, 0xd4, 0xcd,
-	0xc2, 0x90, 0x7f, 0x25, 0x7d, 0xe9, 0x26, 0x6e, 0x77, 0xed, 0x8c, 0xd5, 0xf7, 0xb4, 0x7e, 0x07,
-	0xb5, 0xab, 0xfa, 0xe6, 0x16, 0xfd, 0xe3, 0xb3, 0xb9, 0xe7, 0x9c, 0xcf, 0x3d, 0xe7, 0xef, 0xdc,
-	0x73, 0xbe, 0x2f, 0xbc, 0xda, 0xf9, 0xc2, 0xab, 0xfd, 0x5e, 0x78, 0xb5, 0x37, 0x87, 0x51, 0xac,
-	0xde, 0xcf, 0x46, 0x78, 0x2c, 0x26, 0xe4, 0x48, 0x8e, 0x29, 0xef, 0x1f, 0x11, 0x96, 0xb1, 0x74,
-	0x12, 0x73, 0x45, 0xb2, 0x20, 0x24, 0x9f, 0x2c, 0x99, 0x3a, 0x49, 0x98, 0x1c, 0xd5, 0xf5, 0x0f,
-	0xfd, 0xf8, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xc6, 0x46, 0xf2, 0x98, 0x04, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0xd2, 0x16, 0xf5, 0x55, 0x02, 0xe9, 0x28, 0x25, 0xb8, 0xc5, 0x54, 0x8e, 0x9a,
+	0x16, 0x42, 0x7d, 0x38, 0x65, 0x60, 0x42, 0x28, 0x52, 0xe8, 0xc0, 0x12, 0x22, 0x06, 0xc4, 0x52,
+	0x2e, 0xc9, 0xc9, 0x58, 0xd4, 0x3e, 0xc7, 0xe7, 0x58, 0x54, 0x55, 0x97, 0x2e, 0xac, 0x48, 0x7c,
+	0x00, 0x16, 0x36, 0xbe, 0x48, 0xc7, 0x4a, 0x2c, 0x4c, 0x08, 0x25, 0x7c, 0x10, 0xe4, 0xbb, 0xb3,
+	0x13, 0x1b, 0x2b, 0x61, 0xcb, 0xdd, 0x7b, 0xff, 0xf7, 0x7e, 0xff, 0x77, 0x2f, 0x06, 0x9d, 0xc6,
+	0x1e, 0xe3, 0x98, 0x86, 0xfd, 0xe6, 0x63, 0x1c, 0xdb, 0x78, 0x38, 0xa2, 0xe1, 0xa9, 0x15, 0x84,
+	0x2c, 0x62, 0xe8, 0x86, 0x88, 0x59, 0x22, 0x66, 0xc5, 0xb6, 0xfe, 0xb0, 0xcf, 0x78, 0x92, 0xdc,
+	0x23, 0x9c, 0xca, 0x44, 0x1c, 0xdb, 0x3d, 0x1a, 0x11, 0x1b, 0x07, 0xc4, 0x71, 0x7d, 0x12, 0xb9,
+	0xcc, 0x97, 0x5a, 0xbd, 0x58, 0x57, 0x16, 0x91, 0xb1, 0xed, 0x42, 0xcc, 0xa1, 0x3e, 0xe5, 0x2e,
+	0x57, 0xd1, 0x0d, 0x87, 0x39, 0x4c, 0xfc, 0xc4, 0xc9, 0xaf, 0x54, 0xe3, 0x30, 0xe6, 0x9c, 0x50,
+	0x4c, 0x02, 0x17, 0x13, 0xdf, 0x67, 0x91, 0x68, 0xa6, 0x34, 0xe6, 0x3b, 0xd8, 0x7c, 0x95, 0xf0,
+	0xbc, 0x66, 0x1f, 0xa8, 0xdf, 0x21, 0x6e, 0xc8, 0xbb, 0x74, 0x38, 0xa2, 0x3c, 0x42, 0x2f, 0x00,
+	0xa6, 0x6c, 0x55, 0x6d, 0x47, 0xdb, 0x5f, 0x6f, 0xd6, 0x2d, 0x69, 0xc4, 0x4a, 0x8c, 0x58, 0xd2,
+	0xb1, 0x32, 0x62, 0x75, 0x88, 0x43, 0x95, 0xb6, 0x3b, 0xa3, 0x34, 0xbf, 0x69, 0x70, 0xe7, 0x9f,
+	0x16, 0x3c, 0x60, 0x3e, 0xa7, 0xe8, 0x39, 0xac, 0x47, 0xc9, 0xed, 0x71, 0x90, 0x5c, 0x57, 0xb5,
+	0x9d, 0x6b, 0xfb, 0xeb, 0xcd, 0xbb, 0x56, 0x7e, 0x7a, 0x56, 0x26, 0x6c, 0x2d, 0x5f, 0xfe, 0xba,
+	0x5f, 0xe9, 0x42, 0x94, 0x55, 0x42, 0x47, 0x39, 0xca, 0x25, 0x41, 0xb9, 0xb7, 0x90, 0x52, 0xb6,
+	0xcf, 0x61, 0x1e, 0xc0, 0xed, 0x3c, 0x65, 0x3a, 0x87, 0x0d, 0x58, 0x11, 0xfd, 0xc4, 0x08, 0xd6,
+	0xba, 0xf2, 0x60, 0xbe, 0x29, 0xce, 0x2d, 0xf3, 0xf4, 0x0c, 0x60, 0xea, 0x49, 0xcd, 0x6d, 0xa1,
+	0xa5, 0xb5, 0xcc, 0x92, 0x79, 0x08, 0x5b, 0xa2, 0x72, 0x3b, 0xc9, 0xed, 0x84, 0xb4, 0xcf, 0xbc,
+	0xc0, 0x3d, 0xa1, 0x33, 0x38, 0x03, 0xea, 0x33, 0x2f, 0xc5, 0x11, 0x07, 0xf3, 0x29, 0x6c, 0x97,
+	0x8b, 0x14, 0x54, 0x15, 0xae, 0x93, 0xc1, 0x20, 0xa4, 0x9c, 0x2b, 0x5d, 0x7a, 0x34, 0x37, 0x00,
+	0x09, 0x65, 0x87, 0x84, 0xc4, 0x4b, 0x1f, 0xdf, 0x7c, 0x09, 0xb7, 0x72, 0xb7, 0xaa, 0xcc, 0x13,
+	0x58, 0x0d, 0xc4, 0x8d, 0xf2, 0xb5, 0x59, 0xf4, 0x25, 0xf3, 0x95, 0x29, 0x95, 0xdb, 0xfc, 0xbe,
+	0x0c, 0x2b, 0xa2, 0x1a, 0xba, 0xd0, 0x00, 0xa6, 0x6b, 0x80, 0xea, 0x45, 0x79, 0xf9, 0x2a, 0xea,
+	0x7b, 0x0b, 0xf3, 0x24, 0x9f, 0x59, 0xbb, 0xf8, 0xf1, 0xe7, 0xcb, 0xd2, 0x3d, 0xb4, 0x85, 0x0b,
+	0x7f, 0x94, 0x99, 0x2d, 0x43, 0x9f, 0x34, 0x58, 0xcb, 0xb4, 0x68, 0x77, 0x7e, 0xed, 0x14, 0xa1,
+	0xbe, 0x28, 0x4d, 0x11, 0x34, 0x04, 0xc1, 0x2e, 0xaa, 0xcd, 0x21, 0xc0, 0x67, 0xe2, 0x70, 0x8e,
+	0xbe, 0x6a, 0x70, 0xb3, 0xf0, 0x62, 0xa8, 0x51, 0xda, 0xa8, 0x7c, 0x19, 0xf4, 0x47, 0xff, 0x97,
+	0xac, 0xd8, 0x6c, 0xc1, 0xd6, 0x40, 0x0f, 0x70, 0xd9, 0x27, 0xe6, 0x38, 0xc8, 0x14, 0x1c, 0x9f,
+	0x89, 0xb5, 0x3a, 0x47, 0x43, 0x58, 0x95, 0x4f, 0x8a, 0xcc, 0xd2, 0x56, 0xb9, 0xad, 0xd1, 0x6b,
+	0x73, 0x73, 0x14, 0x85, 0x21, 0x28, 0xaa, 0x68, 0xb3, 0x48, 0x21, 0xb7, 0xa5, 0x75, 0x74, 0x39,
+	0x36, 0xb4, 0xab, 0xb1, 0xa1, 0xfd, 0x1e, 0x1b, 0xda, 0xe7, 0x89, 0x51, 0xb9, 0x9a, 0x18, 0x95,
+	0x9f, 0x13, 0xa3, 0xf2, 0xf6, 0xc0, 0x71, 0xa3, 0xf7, 0xa3, 0x9e, 0xd5, 0x67, 0x1e, 0x6e, 0xf3,
+	0x3e, 0xf1, 0x5b, 0x6d, 0x4c, 0x63, 0x1a, 0x7a, 0xae, 0x1f, 0xe1, 0xd8, 0x6e, 0xe2, 0x8f, 0xaa,
+	0x58, 0x74, 0x1a, 0x50, 0xde, 0x5b, 0x15, 0x5f, 0xb8, 0xc3, 0xbf, 0x03, 0x00, 0x44, 0xe1, 0xe9,
+	0x67, 0xa9, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +476,9 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// Erc20Precompile retrieves the address of the ERC20 precompile deployed for a
+	// Cosmos coin denomination
+	Erc20Precompile(ctx context.Context, in *QueryErc20PrecompileRequest, opts ...grpc.CallOption) (*QueryErc20PrecompileResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -404,6 +509,15 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
+func (c *queryClient) Erc20Precompile(ctx context.Context, in *QueryErc20PrecompileRequest, opts ...grpc.CallOption) (*QueryErc20PrecompileResponse, error) {
+	out := new(QueryErc20PrecompileResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/Erc20Precompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/Params", in, out, opts...)
@@ -419,6 +533,9 @@ type QueryServer interface {
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// Erc20Precompile retrieves the address of the ERC20 precompile deployed for a
+	// Cosmos coin denomination
+	Erc20Precompile(context.Context, *QueryErc20PrecompileRequest) (*QueryErc20PrecompileResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -433,6 +550,9 @@ func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryToken
 func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (*UnimplementedQueryServer) Erc20Precompile(ctx context.Context, req *QueryErc20PrecompileRequest) (*QueryErc20PrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Erc20Precompile not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -477,6 +597,24 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Erc20Precompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryErc20PrecompileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Erc20Precompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/Erc20Precompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Erc20Precompile(ctx, req.(*QueryErc20PrecompileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -507,6 +645,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "Erc20Precompile",
+			Handler:    _Query_Erc20Precompile_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -663,6 +805,66 @@ func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryErc20PrecompileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryErc20PrecompileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryErc20PrecompileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryErc20PrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryErc20PrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryErc20PrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -786,6 +988,32 @@ func (m *QueryTokenPairResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryErc20PrecompileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryErc20PrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1183,6 +1411,170 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryErc20PrecompileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryErc20PrecompileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryErc20PrecompileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryErc20PrecompileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryErc20PrecompileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryErc20PrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	rpctypes "github.com/HarryBin2002/kairoschain/v12/rpc/types"
 	"github.com/spf13/cobra"
 
@@ -24,6 +26,10 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetPredeploysCmd(),
+		GetSponsoredContractsCmd(),
+		GetAddressPermissionsCmd(),
+		GetBlockRandomCmd(),
 	)
 	return cmd
 }
@@ -132,3 +138,126 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPredeploysCmd queries the installed predeploys
+func GetPredeploysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predeploys",
+		Short: "Get the installed predeploys",
+		Long:  "Get the well-known predeploys installed at their canonical addresses.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Predeploys(cmd.Context(), &types.QueryPredeploysRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSponsoredContractsCmd queries the contracts sponsored by an account
+func GetSponsoredContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsored-contracts SPONSOR_ADDRESS",
+		Short: "Get the contracts sponsored by an account",
+		Long:  "Get the contracts whose calls are paid for by the sponsor account.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SponsoredContracts(cmd.Context(), &types.QuerySponsoredContractsRequest{
+				Sponsor: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAddressPermissionsCmd queries the restrictions applied to an address
+func GetAddressPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-permissions ADDRESS",
+		Short: "Get the restrictions applied to an address",
+		Long:  "Get whether the hex address can deploy contracts, can be called and is blocked from sending transactions, according to the access control params.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AddressPermissions(cmd.Context(), &types.QueryAddressPermissionsRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBlockRandomCmd queries the random value of a block
+func GetBlockRandomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-random [HEIGHT]",
+		Short: "Get the random value of a block",
+		Long:  "Get the random value of a block, which is returned by the PREVRANDAO opcode. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBlockRandomRequest{}
+			if len(args) > 0 {
+				if req.Height, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.BlockRandom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
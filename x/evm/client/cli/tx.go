@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"os"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -25,7 +27,10 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewSetSponsoredContractsCmd(),
+	)
 	return cmd
 }
 
@@ -34,7 +39,10 @@ func NewRawTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "raw TX_HEX",
 		Short: "Build cosmos transaction from raw ethereum transaction",
-		Args:  cobra.ExactArgs(1),
+		Long: `Build cosmos transaction from raw ethereum transaction.
+The gas is paid by the account set with the --fee-granter flag, if any.
+The fee granter must sign the generated transaction, so --generate-only is required along with it.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			data, err := hexutil.Decode(args[0])
 			if err != nil {
@@ -60,7 +68,14 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			if !clientCtx.FeeGranter.Empty() && !clientCtx.GenerateOnly {
+				return fmt.Errorf("the transaction must be signed by the fee granter, use --generate-only")
+			}
+
+			txBuilder := clientCtx.TxConfig.NewTxBuilder()
+			txBuilder.SetFeeGranter(clientCtx.FeeGranter)
+
+			tx, err := msg.BuildTx(txBuilder, rsp.Params.EvmDenom)
 			if err != nil {
 				return err
 			}
@@ -109,3 +124,82 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// flags of the sponsorship limits
+const (
+	FlagMaxGasPerTx          = "max-gas-per-tx"
+	FlagMaxFeePerGas         = "max-fee-per-gas"
+	FlagMaxPriorityFeePerGas = "max-priority-fee-per-gas"
+	FlagSpendLimit           = "spend-limit"
+)
+
+// NewSetSponsoredContractsCmd command sets the contracts whose calls are paid for by the sender
+func NewSetSponsoredContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-sponsored-contracts [CONTRACT_ADDRESS...]",
+		Short: "Set the contracts whose calls are paid for by the sender",
+		Long: `Set the contracts whose calls are paid for by the sender, replacing the previous ones.
+The sender pays the gas of the ethereum transactions calling these contracts which set it as fee granter,
+within the limits set by the flags. The spend limit, in the EVM denomination, decreases with the gas paid.
+Providing no contract removes the sponsorship.`,
+		Example: fmt.Sprintf(
+			"set-sponsored-contracts 0x... --%s 300000 --%s 100000000000 --%s 1000000000 --%s 1000000000000000000",
+			FlagMaxGasPerTx, FlagMaxFeePerGas, FlagMaxPriorityFeePerGas, FlagSpendLimit,
+		),
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limits, err := parseSponsorshipLimits(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetSponsoredContracts{
+				Sponsor:   clientCtx.GetFromAddress().String(),
+				Contracts: args,
+				Limits:    limits,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxGasPerTx, 0, "highest gas limit of a sponsored transaction")
+	cmd.Flags().String(FlagMaxFeePerGas, "0", "highest effective gas price paid for a sponsored transaction")
+	cmd.Flags().String(FlagMaxPriorityFeePerGas, "0", "highest effective gas tip paid for a sponsored transaction")
+	cmd.Flags().String(FlagSpendLimit, "0", "amount of EVM denomination paid for the sponsored transactions")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseSponsorshipLimits reads the sponsorship limits from the command flags.
+func parseSponsorshipLimits(cmd *cobra.Command) (types.SponsorshipLimits, error) {
+	maxGasPerTx, err := cmd.Flags().GetUint64(FlagMaxGasPerTx)
+	if err != nil {
+		return types.SponsorshipLimits{}, err
+	}
+
+	amounts := make([]sdkmath.Int, 3)
+	for i, flag := range []string{FlagMaxFeePerGas, FlagMaxPriorityFeePerGas, FlagSpendLimit} {
+		value, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return types.SponsorshipLimits{}, err
+		}
+
+		amount, ok := sdkmath.NewIntFromString(value)
+		if !ok {
+			return types.SponsorshipLimits{}, fmt.Errorf("invalid --%s amount %s", flag, value)
+		}
+		amounts[i] = amount
+	}
+
+	return types.NewSponsorshipLimits(maxGasPerTx, amounts[0], amounts[1], amounts[2]), nil
+}
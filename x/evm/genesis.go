@@ -68,6 +68,23 @@ func InitGenesis(
 		}
 	}
 
+	if err := k.UpdateStorageTries(ctx); err != nil {
+		panic(fmt.Errorf("error updating the storage tries %s", err))
+	}
+
+	// the predeploys already exported with the accounts are kept as is
+	if err := k.InstallPredeploysByName(ctx, data.Predeploys...); err != nil {
+		panic(fmt.Errorf("error installing predeploys %s", err))
+	}
+
+	for _, sponsorship := range data.Sponsorships {
+		contracts := make([]common.Address, len(sponsorship.Contracts))
+		for i, contract := range sponsorship.Contracts {
+			contracts[i] = common.HexToAddress(contract)
+		}
+		k.SetSponsorship(ctx, sdk.MustAccAddressFromBech32(sponsorship.Sponsor), contracts, sponsorship.Limits)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -95,8 +112,15 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	var predeploys []string
+	for _, predeploy := range k.GetPredeploys(ctx) {
+		predeploys = append(predeploys, predeploy.Name)
+	}
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:     ethGenAccounts,
+		Params:       k.GetParams(ctx),
+		Predeploys:   predeploys,
+		Sponsorships: k.GetAllSponsorships(ctx),
 	}
 }
@@ -3,6 +3,7 @@ package evm_test
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/HarryBin2002/kairoschain/v12/crypto/ethsecp256k1"
@@ -10,6 +11,7 @@ import (
 	"github.com/HarryBin2002/kairoschain/v12/x/evm"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -51,6 +53,42 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 			},
 			false,
 		},
+		{
+			"predeploys",
+			func() {},
+			&types.GenesisState{
+				Params:     types.DefaultParams(),
+				Predeploys: []string{types.PredeployCreate2Deployer},
+			},
+			false,
+		},
+		{
+			"sponsorships",
+			func() {},
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Sponsorships: []types.Sponsorship{
+					types.NewSponsorship(
+						sdk.AccAddress(address.Bytes()),
+						[]common.Address{address},
+						types.NewSponsorshipLimits(1000000, sdkmath.NewInt(1000000000000), sdkmath.NewInt(1000000000), sdkmath.NewInt(1000000000000000000)),
+					),
+				},
+			},
+			false,
+		},
+		{
+			"predeploy address with a different code",
+			func() {
+				predeploy, _ := types.GetPredeploy(types.PredeployCreate2Deployer)
+				vmdb.SetCode(common.HexToAddress(predeploy.Address), []byte{0x01})
+			},
+			&types.GenesisState{
+				Params:     types.DefaultParams(),
+				Predeploys: []string{types.PredeployCreate2Deployer},
+			},
+			true,
+		},
 		{
 			"account not found",
 			func() {},
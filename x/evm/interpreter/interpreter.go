@@ -0,0 +1,46 @@
+// Package interpreter enables the instructions of the Shanghai and Cancun
+// forks in the go-ethereum EVM interpreter, they are implemented by the
+// go-ethereum fork used by the module but aren't part of its default jump
+// tables:
+//   - PUSH0 (EIP-3855)
+//   - TLOAD and TSTORE (EIP-1153)
+//   - MCOPY (EIP-5656)
+package interpreter
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Enable replaces the interpreter of the EVM with one running the
+// instructions of the Shanghai and Cancun forks if any of them is active at
+// the block number of the EVM, the EVM is left unchanged otherwise.
+func Enable(evm *vm.EVM) error {
+	chainConfig := evm.ChainConfig()
+	number := evm.Context.BlockNumber
+	shanghai, cancun := chainConfig.IsShanghai(number), chainConfig.IsCancun(number)
+	if !shanghai && !cancun {
+		return nil
+	}
+
+	// the go-ethereum interpreter only applies the extra EIPs to the default
+	// jump table
+	cfg := evm.Config
+	rules := chainConfig.Rules(number, evm.Context.Random != nil)
+	jt := vm.CopyJumpTable(vm.DefaultJumpTable(rules))
+	eips := append([]int{}, cfg.ExtraEips...)
+	if shanghai {
+		eips = append(eips, 3855)
+	}
+	if cancun {
+		eips = append(eips, 1153, 5656)
+	}
+	for _, eip := range eips {
+		if err := vm.EnableEIP(eip, jt); err != nil {
+			return err
+		}
+	}
+
+	cfg.JumpTable = jt
+	evm.WithInterpreter(vm.NewEVMInterpreter(evm, cfg))
+	return nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,14 +10,20 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper. It also
+// stores and emits the random value of the block.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+
+	random := k.CurrentBlockRandom(ctx)
+	k.SetBlockRandom(ctx, random)
+	k.EmitBlockRandomEvent(ctx, random)
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore, along with the transactions and receipts roots of the block. The storage tries of the
+// accounts written in the block are updated as well. The EVM end block logic
+// doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
@@ -23,5 +31,15 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Valida
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	txRoot, receiptRoot := k.DeriveBlockRoots(infCtx)
+	k.SetBlockRoots(infCtx, txRoot, receiptRoot)
+	k.EmitBlockRootsEvent(infCtx, txRoot, receiptRoot)
+
+	// the storage roots are part of the consensus state, the chain halts
+	// rather than diverging from the storage
+	if err := k.UpdateStorageTries(infCtx); err != nil {
+		panic(fmt.Errorf("failed to update the storage tries: %w", err))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
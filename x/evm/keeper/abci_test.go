@@ -1,8 +1,20 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/HarryBin2002/kairoschain/v12/server/config"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/keeper"
 	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	"github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
 )
 
 func (suite *KeeperTestSuite) TestEndBlock() {
@@ -12,7 +24,103 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	res := suite.app.EvmKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
 	suite.Require().Equal([]types.ValidatorUpdate{}, res)
 
-	// should emit 1 EventTypeBlockBloom event on EndBlock
-	suite.Require().Equal(1, len(em.Events()))
+	// should emit 1 EventTypeBlockBloom event and 1 EventTypeBlockRoots event on EndBlock
+	suite.Require().Equal(2, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
+	suite.Require().Equal(evmtypes.EventTypeBlockRoots, em.Events()[1].Type)
+
+	// the roots of a block without transactions are empty
+	txRoot, receiptRoot := suite.app.EvmKeeper.GetBlockRoots(suite.ctx)
+	suite.Require().Equal(ethtypes.EmptyRootHash, txRoot)
+	suite.Require().Equal(ethtypes.EmptyRootHash, receiptRoot)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockRoots() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	contract := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Commit()
+
+	to := utiltx.GenerateAddress()
+	tx := suite.TransferERC20Token(suite.T(), contract, suite.address, to, big.NewInt(10))
+
+	receipt := &ethtypes.Receipt{
+		Type:              tx.AsTransaction().Type(),
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: k.GetTransientGasUsed(suite.ctx),
+		Logs: []*ethtypes.Log{{
+			Address: contract,
+			Topics: []common.Hash{
+				evmtypes.ERC20Contract.ABI.Events["Transfer"].ID,
+				common.BytesToHash(suite.address.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.LeftPadBytes(big.NewInt(10).Bytes(), 32),
+		}},
+	}
+	receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	k.EndBlock(ctx, types.RequestEndBlock{})
+
+	expTxRoot := ethtypes.DeriveSha(ethtypes.Transactions{tx.AsTransaction()}, trie.NewStackTrie(nil))
+	expReceiptRoot := ethtypes.DeriveSha(ethtypes.Receipts{receipt}, trie.NewStackTrie(nil))
+	txRoot, receiptRoot := k.GetBlockRoots(ctx)
+	suite.Require().Equal(expTxRoot, txRoot)
+	suite.Require().Equal(expReceiptRoot, receiptRoot)
+
+	// the roots are emitted on EndBlock
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(evmtypes.EventTypeBlockRoots, events[1].Type)
+	suite.Require().Equal(expTxRoot.Hex(), events[1].Attributes[0].Value)
+	suite.Require().Equal(expReceiptRoot.Hex(), events[1].Attributes[1].Value)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockRandom() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	k.BeginBlock(ctx, types.RequestBeginBlock{})
+	random, found := k.GetBlockRandom(ctx, ctx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().NotEqual(common.Hash{}, random)
+	suite.Require().Equal(random, k.CurrentBlockRandom(ctx))
+
+	// should emit the random value on BeginBlock
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(1, len(events))
+	suite.Require().Equal(evmtypes.EventTypeBlockRandom, events[0].Type)
+	suite.Require().Equal(random.Hex(), events[0].Attributes[0].Value)
+
+	// the PREVRANDAO opcode returns the random value
+	contract := utiltx.GenerateAddress()
+	code := hexutil.Bytes{0x44, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	args, err := json.Marshal(&evmtypes.TransactionArgs{From: &suite.address, To: &contract})
+	suite.Require().NoError(err)
+	overrides, err := json.Marshal(evmtypes.StateOverride{contract: {Code: &code}})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EthCall(ctx, &evmtypes.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+	suite.Require().Equal(random, common.BytesToHash(res.Ret))
+
+	// the random value chains the value of the previous block
+	next := ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithHeaderHash(common.BigToHash(big.NewInt(1)).Bytes())
+	expRandom := crypto.Keccak256Hash(random.Bytes(), common.BigToHash(big.NewInt(1)).Bytes())
+	suite.Require().Equal(expRandom, k.CurrentBlockRandom(next))
+	k.BeginBlock(next, types.RequestBeginBlock{})
+	nextRandom, found := k.GetBlockRandom(next, next.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().Equal(expRandom, nextRandom)
+
+	// the values which left the history window are deleted
+	pruneCtx := ctx.WithBlockHeight(ctx.BlockHeight() + keeper.BlockRandomHistory)
+	k.BeginBlock(pruneCtx, types.RequestBeginBlock{})
+	_, found = k.GetBlockRandom(pruneCtx, ctx.BlockHeight())
+	suite.Require().False(found)
+	_, found = k.GetBlockRandom(pruneCtx, next.BlockHeight())
+	suite.Require().True(found)
 }
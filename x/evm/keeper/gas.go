@@ -24,8 +24,9 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
+// RefundGas transfers the leftover gas to the sender of the message, or to the sponsor that paid
+// for its gas, caped to half of the total gas consumed in the transaction. A sponsor also gets the
+// refund back on the sponsorship spend limit or on the fee allowance it was charged. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
@@ -40,13 +41,30 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to sender, or to the sponsor that paid the gas, from the fee collector module account,
+		// which is the escrow account in charge of collecting tx fees
+		refundee := sdk.AccAddress(msg.From().Bytes())
+		sponsor, sponsored := k.GetTxSponsorTransient(ctx, msg.From(), msg.Nonce())
+		if sponsored {
+			refundee = sponsor.Address
+		}
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundee, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+
+		// the refund is also given back to the spend limit of the sponsorship or
+		// of the fee allowance, which were charged the full gas limit
+		switch {
+		case sponsored && sponsor.FeeAllowance:
+			if err := k.refundFeeAllowance(ctx, sponsor.Address, sdk.AccAddress(msg.From().Bytes()), refundedCoins); err != nil {
+				return errorsmod.Wrapf(err, "failed to refund the fee allowance of %s", sponsor.Address)
+			}
+		case sponsored:
+			k.refundSponsorship(ctx, sponsor.Address, refundedCoins.AmountOf(denom))
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
@@ -24,10 +24,12 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	evertypes "github.com/HarryBin2002/kairoschain/v12/types"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+	evmtracers "github.com/HarryBin2002/kairoschain/v12/x/evm/tracers"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 )
 
@@ -173,6 +175,43 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// AccountStorageRoot implements the Query/AccountStorageRoot gRPC method
+func (k Keeper) AccountStorageRoot(c context.Context, req *types.QueryAccountStorageRootRequest) (*types.QueryAccountStorageRootResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evertypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	proofs := make([]types.StorageProof, len(req.StorageKeys))
+	for i, storageKey := range req.StorageKeys {
+		key := common.HexToHash(storageKey)
+		proof, err := k.GetStorageProof(ctx, address, key)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		proofs[i] = types.StorageProof{
+			Key:   storageKey,
+			Value: k.GetState(ctx, address, key).Hex(),
+			Proof: proof,
+		}
+	}
+
+	return &types.QueryAccountStorageRootResponse{
+		StorageRoot:   k.GetStorageRoot(ctx, address).Hex(),
+		StorageProofs: proofs,
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -229,13 +268,22 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	stateOverrides, blockOverrides, err := parseOverrides(req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	cfg.Overrides = stateOverrides
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
+	if override, ok := stateOverrides[args.GetFrom()]; ok && override.Nonce != nil {
+		nonce = uint64(*override.Nonce)
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -304,14 +352,24 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		hi = req.GasCap
 	}
 
+	stateOverrides, blockOverrides, err := parseOverrides(req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	gasCap = hi
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	cfg.Overrides = stateOverrides
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
+	if override, ok := stateOverrides[args.GetFrom()]; ok && override.Nonce != nil {
+		nonce = uint64(*override.Nonce)
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
@@ -380,6 +438,89 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api. The call is executed
+// with the access list collected by the previous execution until the access
+// list stops changing.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.QueryCreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	stateOverrides, blockOverrides, err := parseOverrides(req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	cfg.Overrides = stateOverrides
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	if override, ok := stateOverrides[from]; ok && override.Nonce != nil {
+		nonce = uint64(*override.Nonce)
+	}
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the sender, the recipient and the precompiles are always warm so they are
+	// excluded from the access list
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+	height := big.NewInt(ctx.BlockHeight())
+	rules := cfg.ChainConfig.Rules(height, types.IsMergeNetsplit(cfg.ChainConfig, height))
+	_, precompiles := k.ActivePrecompiles(cfg.Params, rules)
+
+	var accessList ethtypes.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	for {
+		// retrieve the current access list to expand
+		accessList = prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// apply the message with the access list and collect the accessed slots
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.QueryCreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -459,7 +600,12 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
 	}
 
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result, _, err := k.traceTx(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -522,6 +668,12 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		cfg.BaseFee = baseFee
 	}
 
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
@@ -531,7 +683,13 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			result.Error = status.Error(codes.Internal, err.Error()).Error()
+			results = append(results, &result)
+			continue
+		}
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, msg, req.TraceConfig, true, tracerConfig)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -551,13 +709,96 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call in the provided environment, with the optional state
+// and block overrides applied. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stateOverrides, blockOverrides, err := parseOverrides(req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.BlockNumber > 0 {
+		ctx = ctx.WithBlockHeight(req.BlockNumber)
+		ctx = ctx.WithBlockTime(req.BlockTime)
+		ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	}
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	// Only the block max gas from the consensus params is needed to calculate base fee
+	if req.BlockMaxGas > 0 {
+		ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+		})
+	}
+
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	cfg.Overrides = stateOverrides
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	if override, ok := stateOverrides[args.GetFrom()]; ok && override.Nonce != nil {
+		nonce = uint64(*override.Nonce)
+	}
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	result, _, err := k.traceTx(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceTx
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
-	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	msg core.Message,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -569,10 +810,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -601,7 +838,7 @@ func (k *Keeper) traceTx(
 	}
 
 	if traceConfig.Tracer != "" {
-		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
+		if tracer, err = evmtracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -660,6 +897,84 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// Predeploys implements the Query/Predeploys gRPC method
+func (k Keeper) Predeploys(c context.Context, _ *types.QueryPredeploysRequest) (*types.QueryPredeploysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPredeploysResponse{
+		Predeploys: k.GetPredeploys(ctx),
+	}, nil
+}
+
+// SponsoredContracts implements the Query/SponsoredContracts gRPC method
+func (k Keeper) SponsoredContracts(c context.Context, req *types.QuerySponsoredContractsRequest) (*types.QuerySponsoredContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contracts := []string{}
+	for _, contract := range k.GetSponsoredContracts(ctx, sponsor) {
+		contracts = append(contracts, contract.Hex())
+	}
+
+	limits, _ := k.GetSponsorshipLimits(ctx, sponsor)
+
+	return &types.QuerySponsoredContractsResponse{
+		Contracts: contracts,
+		Limits:    limits,
+	}, nil
+}
+
+// AddressPermissions implements the Query/AddressPermissions gRPC method
+func (k Keeper) AddressPermissions(c context.Context, req *types.QueryAddressPermissionsRequest) (*types.QueryAddressPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evertypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	accessLists := k.GetParams(ctx).AccessLists()
+	address := common.HexToAddress(req.Address)
+
+	return &types.QueryAddressPermissionsResponse{
+		CanDeploy:   accessLists.IsDeployerAllowed(address),
+		CanBeCalled: !accessLists.IsContractDenied(address),
+		IsBlocked:   accessLists.IsSenderBlocked(address),
+	}, nil
+}
+
+// BlockRandom implements the Query/BlockRandom gRPC method
+func (k Keeper) BlockRandom(c context.Context, req *types.QueryBlockRandomRequest) (*types.QueryBlockRandomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+
+	random, found := k.GetBlockRandom(ctx, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "random value of block %d not found", height)
+	}
+
+	return &types.QueryBlockRandomResponse{
+		Random: random.Hex(),
+	}, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -667,3 +982,51 @@ func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	}
 	return big.NewInt(chainID), nil
 }
+
+// parseOverrides decodes the json encoded state and block overrides of the request,
+// an empty value means no override.
+func parseOverrides(stateBz, blockBz []byte) (types.StateOverride, *types.BlockOverrides, error) {
+	var (
+		stateOverrides types.StateOverride
+		blockOverrides *types.BlockOverrides
+	)
+	if len(stateBz) > 0 {
+		if err := json.Unmarshal(stateBz, &stateOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid state overrides: %w", err)
+		}
+		if err := stateOverrides.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(blockBz) > 0 {
+		blockOverrides = new(types.BlockOverrides)
+		if err := json.Unmarshal(blockBz, blockOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid block overrides: %w", err)
+		}
+		if err := blockOverrides.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return stateOverrides, blockOverrides, nil
+}
+
+// applyBlockOverrides overrides the block context used by the EVM with the
+// header fields of the block overrides.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *types.BlockOverrides) sdk.Context {
+	if overrides == nil {
+		return ctx
+	}
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC())
+	}
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+	return ctx
+}
@@ -4,16 +4,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 
 	"github.com/HarryBin2002/kairoschain/v12/server/config"
 	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
@@ -287,6 +292,103 @@ func (suite *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryAccountStorageRoot() {
+	var (
+		req      *types.QueryAccountStorageRootRequest
+		expRoot  common.Hash
+		expProof []types.StorageProof
+	)
+
+	key := common.BytesToHash([]byte("key"))
+	value := common.BytesToHash([]byte("value"))
+
+	testCases := []struct {
+		msg      string
+		malleate func(vm.StateDB)
+		expPass  bool
+	}{
+		{
+			"invalid address",
+			func(vm.StateDB) {
+				req = &types.QueryAccountStorageRootRequest{
+					Address: invalidAddress,
+				}
+			},
+			false,
+		},
+		{
+			"success - empty storage",
+			func(vm.StateDB) {
+				expRoot = ethtypes.EmptyRootHash
+				expProof = []types.StorageProof{{Key: key.String(), Value: common.Hash{}.String()}}
+				req = &types.QueryAccountStorageRootRequest{
+					Address:     suite.address.String(),
+					StorageKeys: []string{key.String()},
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func(vmdb vm.StateDB) {
+				vmdb.SetState(suite.address, key, value)
+
+				enc, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value.Bytes()))
+				suite.Require().NoError(err)
+				expTrie := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+				expTrie.Update(crypto.Keccak256(key.Bytes()), enc)
+				expRoot = expTrie.Hash()
+
+				var proof proofList
+				suite.Require().NoError(expTrie.Prove(crypto.Keccak256(key.Bytes()), 0, &proof))
+				expProof = []types.StorageProof{{Key: key.String(), Value: value.String(), Proof: proof}}
+				req = &types.QueryAccountStorageRootRequest{
+					Address:     suite.address.String(),
+					StorageKeys: []string{key.String()},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			vmdb := suite.StateDB()
+			tc.malleate(vmdb)
+			suite.Require().NoError(vmdb.Commit())
+			// the storage tries are updated at the end of the block
+			suite.Require().NoError(suite.app.EvmKeeper.UpdateStorageTries(suite.ctx))
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.AccountStorageRoot(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().Equal(expRoot.String(), res.StorageRoot)
+				suite.Require().Equal(expProof, res.StorageProofs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// proofList collects the trie nodes of a proof.
+type proofList [][]byte
+
+func (p *proofList) Put(_ []byte, value []byte) error {
+	*p = append(*p, value)
+	return nil
+}
+
+func (p *proofList) Delete([]byte) error {
+	return nil
+}
+
 func (suite *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -982,6 +1084,146 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var req *types.QueryTraceCallRequest
+
+	testCases := []struct {
+		msg           string
+		malleate      func()
+		expPass       bool
+		traceResponse string
+	}{
+		{
+			"fail - invalid args",
+			func() {
+				req = &types.QueryTraceCallRequest{Args: []byte("invalid args"), GasCap: config.DefaultGasCap}
+			},
+			false,
+			"",
+		},
+		{
+			"fail - negative limit",
+			func() {
+				req.TraceConfig = &types.TraceConfig{Limit: -1}
+			},
+			false,
+			"",
+		},
+		{
+			"default trace",
+			func() {},
+			true,
+			"\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\"",
+		},
+		{
+			"call tracer",
+			func() {
+				req.TraceConfig = &types.TraceConfig{Tracer: "callTracer"}
+			},
+			true,
+			"\"from\":\"" + strings.ToLower(suite.address.Hex()) + "\"",
+		},
+		{
+			"call tracer with logs",
+			func() {
+				req.TraceConfig = &types.TraceConfig{Tracer: "callTracer", TracerJsonConfig: `{"withLog":true}`}
+			},
+			true,
+			"\"logs\":[{\"address\":",
+		},
+		{
+			"flat call tracer",
+			func() {
+				req.TraceConfig = &types.TraceConfig{Tracer: "flatCallTracer"}
+			},
+			true,
+			"\"callType\":\"call\"",
+		},
+		{
+			"prestate tracer in diff mode",
+			func() {
+				req.TraceConfig = &types.TraceConfig{Tracer: "prestateTracer", TracerJsonConfig: `{"diffMode":true}`}
+			},
+			true,
+			"{\"post\":{\"",
+		},
+		{
+			"4byte tracer",
+			func() {
+				req.TraceConfig = &types.TraceConfig{Tracer: "4byteTracer"}
+			},
+			true,
+			"{\"0xa9059cbb-64\":1}",
+		},
+		{
+			"mux tracer",
+			func() {
+				req.TraceConfig = &types.TraceConfig{Tracer: "muxTracer", TracerJsonConfig: `{"4byteTracer":null,"callTracer":{"onlyTopCall":true}}`}
+			},
+			true,
+			"\"4byteTracer\":{\"0xa9059cbb-64\":1}",
+		},
+		{
+			"fail - invalid tracer config",
+			func() {
+				req.TraceConfig = &types.TraceConfig{Tracer: "muxTracer", TracerJsonConfig: `{"invalid_tracer":{}}`}
+			},
+			false,
+			"",
+		},
+		{
+			"fail - invalid state overrides",
+			func() {
+				req.Overrides = []byte("invalid overrides")
+			},
+			false,
+			"",
+		},
+		{
+			"state overrides",
+			func() {
+				// the sender of a value transfer has no funds without the balance override
+				from := utiltx.GenerateAddress()
+				to := utiltx.GenerateAddress()
+				args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &to, Value: (*hexutil.Big)(big.NewInt(100))})
+				suite.Require().NoError(err)
+				balance := (*hexutil.Big)(big.NewInt(1000))
+				overrides, err := json.Marshal(types.StateOverride{from: {Balance: &balance}})
+				suite.Require().NoError(err)
+				req = &types.QueryTraceCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides}
+			},
+			true,
+			"\"failed\":false,\"returnValue\":\"\",\"structLogs\":[]",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+
+			data, err := types.ERC20Contract.ABI.Pack("transfer", common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), big.NewInt(1000))
+			suite.Require().NoError(err)
+			args, err := json.Marshal(&types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&data)})
+			suite.Require().NoError(err)
+			req = &types.QueryTraceCallRequest{Args: args, GasCap: config.DefaultGasCap}
+
+			tc.malleate()
+
+			res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Contains(string(res.Data), tc.traceResponse)
+				// the traced call is never persisted
+				suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
 		txs         []*types.MsgEthereumTx
@@ -1339,6 +1581,257 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallOverrides() {
+	var (
+		stateOverrides types.StateOverride
+		blockOverrides *types.BlockOverrides
+	)
+
+	contract := utiltx.GenerateAddress()
+	slot0, slot1 := common.BigToHash(big.NewInt(0)), common.BigToHash(big.NewInt(1))
+
+	// returnOf returns the runtime code returning the word pushed by the given opcodes
+	returnOf := func(ops ...byte) *hexutil.Bytes {
+		code := hexutil.Bytes(append(ops, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3))
+		return &code
+	}
+	sload0 := returnOf(0x60, 0x00, 0x54)
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	coinbase := utiltx.GenerateAddress()
+	number := (*hexutil.Big)(big.NewInt(12345))
+	timestamp := hexutil.Uint64(1700000000)
+	baseFee := (*hexutil.Big)(big.NewInt(777))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expRet   common.Hash
+	}{
+		{
+			"code override without storage",
+			func() {
+				stateOverrides = types.StateOverride{contract: {Code: sload0}}
+			},
+			true,
+			common.Hash{},
+		},
+		{
+			"state override replaces the storage",
+			func() {
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, slot0, common.BigToHash(big.NewInt(5)).Bytes())
+				state := map[common.Hash]common.Hash{slot1: common.BigToHash(big.NewInt(9))}
+				stateOverrides = types.StateOverride{contract: {Code: sload0, State: &state}}
+			},
+			true,
+			common.Hash{},
+		},
+		{
+			"state diff override keeps the storage",
+			func() {
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, slot0, common.BigToHash(big.NewInt(5)).Bytes())
+				state := map[common.Hash]common.Hash{slot1: common.BigToHash(big.NewInt(9))}
+				stateOverrides = types.StateOverride{contract: {Code: sload0, StateDiff: &state}}
+			},
+			true,
+			common.BigToHash(big.NewInt(5)),
+		},
+		{
+			"state override sets the storage",
+			func() {
+				state := map[common.Hash]common.Hash{slot0: common.BigToHash(big.NewInt(42))}
+				stateOverrides = types.StateOverride{contract: {Code: sload0, State: &state}}
+			},
+			true,
+			common.BigToHash(big.NewInt(42)),
+		},
+		{
+			"balance override",
+			func() {
+				stateOverrides = types.StateOverride{contract: {Code: returnOf(0x47), Balance: &balance}}
+			},
+			true,
+			common.BigToHash(balance.ToInt()),
+		},
+		{
+			"block number override",
+			func() {
+				stateOverrides = types.StateOverride{contract: {Code: returnOf(0x43)}}
+				blockOverrides = &types.BlockOverrides{Number: number}
+			},
+			true,
+			common.BigToHash(number.ToInt()),
+		},
+		{
+			"block time override",
+			func() {
+				stateOverrides = types.StateOverride{contract: {Code: returnOf(0x42)}}
+				blockOverrides = &types.BlockOverrides{Time: &timestamp}
+			},
+			true,
+			common.BigToHash(new(big.Int).SetUint64(uint64(timestamp))),
+		},
+		{
+			"coinbase override",
+			func() {
+				stateOverrides = types.StateOverride{contract: {Code: returnOf(0x41)}}
+				blockOverrides = &types.BlockOverrides{Coinbase: &coinbase}
+			},
+			true,
+			common.BytesToHash(coinbase.Bytes()),
+		},
+		{
+			"base fee override",
+			func() {
+				stateOverrides = types.StateOverride{contract: {Code: returnOf(0x48)}}
+				blockOverrides = &types.BlockOverrides{BaseFee: baseFee}
+			},
+			true,
+			common.BigToHash(baseFee.ToInt()),
+		},
+		{
+			"fail - both state and state diff",
+			func() {
+				state := map[common.Hash]common.Hash{slot0: common.BigToHash(big.NewInt(42))}
+				stateOverrides = types.StateOverride{contract: {Code: sload0, State: &state, StateDiff: &state}}
+			},
+			false,
+			common.Hash{},
+		},
+		{
+			"fail - negative base fee",
+			func() {
+				stateOverrides = types.StateOverride{contract: {Code: returnOf(0x48)}}
+				blockOverrides = &types.BlockOverrides{BaseFee: (*hexutil.Big)(big.NewInt(-1))}
+			},
+			false,
+			common.Hash{},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			stateOverrides, blockOverrides = nil, nil
+			tc.malleate()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			req.Overrides, err = json.Marshal(stateOverrides)
+			suite.Require().NoError(err)
+			if blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(res.Failed(), res.VmError)
+				suite.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+				// the overrides are never persisted
+				suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(crypto.Keccak256(*stateOverrides[contract].Code))))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasOverrides() {
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+	args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &to, Value: (*hexutil.Big)(big.NewInt(100))})
+	suite.Require().NoError(err)
+
+	// the sender has no funds
+	_, err = suite.queryClient.EstimateGas(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	suite.Require().Error(err)
+
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	overrides, err := json.Marshal(types.StateOverride{from: {Balance: &balance}})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EstimateGas(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethparams.TxGas, res.Gas)
+	suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, from).Sign())
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	contract := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+	slot0 := common.BigToHash(big.NewInt(0))
+
+	// codeOf returns the runtime code returning the word pushed by the given opcodes
+	codeOf := func(ops ...byte) *hexutil.Bytes {
+		code := hexutil.Bytes(append(ops, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3))
+		return &code
+	}
+	sload0 := codeOf(0x60, 0x00, 0x54)
+	balanceOther := codeOf(append(append([]byte{0x73}, other.Bytes()...), 0x31)...)
+	revert := hexutil.Bytes{0x60, 0x00, 0x60, 0x00, 0xfd}
+
+	testCases := []struct {
+		name          string
+		overrides     types.StateOverride
+		expAccessList ethtypes.AccessList
+		expVMError    string
+	}{
+		{
+			"no code",
+			nil,
+			nil,
+			"",
+		},
+		{
+			"storage slot of the recipient",
+			types.StateOverride{contract: {Code: sload0}},
+			ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{slot0}}},
+			"",
+		},
+		{
+			"account accessed by the call",
+			types.StateOverride{contract: {Code: balanceOther}},
+			ethtypes.AccessList{{Address: other, StorageKeys: []common.Hash{}}},
+			"",
+		},
+		{
+			"reverted call",
+			types.StateOverride{contract: {Code: &revert}},
+			nil,
+			vm.ErrExecutionReverted.Error(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			req.Overrides, err = json.Marshal(tc.overrides)
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.CreateAccessList(suite.ctx, req)
+			suite.Require().NoError(err)
+			accessList := types.AccessList(res.AccessList).ToEthAccessList()
+			suite.Require().Equal(tc.expAccessList, *accessList)
+			suite.Require().Equal(tc.expVMError, res.VmError)
+
+			// the gas used is the one of the call including the access list
+			req.Args, err = json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract, AccessList: accessList})
+			suite.Require().NoError(err)
+			call, err := suite.queryClient.EthCall(suite.ctx, req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(call.GasUsed, res.GasUsed)
+		})
+	}
+
+	_, err := suite.queryClient.CreateAccessList(suite.ctx, &types.EthCallRequest{Args: []byte("invalid args"), GasCap: config.DefaultGasCap})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1400,6 +1893,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceTx(suite.ctx, nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
 		{
 			"TraceBlock method",
 			func() (interface{}, error) {
@@ -1416,3 +1915,112 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAddressPermissions() {
+	suite.SetupTest()
+
+	deployer := "0x0000000000000000000000000000000000000001"
+	contract := "0x0000000000000000000000000000000000000002"
+	blocked := "0x0000000000000000000000000000000000000003"
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.AllowedDeployers = []string{deployer}
+	params.DeniedContracts = []string{contract}
+	params.BlockedSenders = []string{blocked}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	testCases := []struct {
+		name    string
+		address string
+		expRes  *types.QueryAddressPermissionsResponse
+		expPass bool
+	}{
+		{
+			"fail - invalid address",
+			"0x1",
+			nil,
+			false,
+		},
+		{
+			"pass - allowed deployer",
+			deployer,
+			&types.QueryAddressPermissionsResponse{CanDeploy: true, CanBeCalled: true},
+			true,
+		},
+		{
+			"pass - denied contract",
+			contract,
+			&types.QueryAddressPermissionsResponse{},
+			true,
+		},
+		{
+			"pass - blocked sender",
+			blocked,
+			&types.QueryAddressPermissionsResponse{CanBeCalled: true, IsBlocked: true},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.AddressPermissions(suite.ctx, &types.QueryAddressPermissionsRequest{
+				Address: tc.address,
+			})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryBlockRandom() {
+	suite.SetupTest()
+
+	height := suite.ctx.BlockHeight()
+	random := common.BigToHash(big.NewInt(42))
+	suite.app.EvmKeeper.SetBlockRandom(suite.ctx, random)
+
+	testCases := []struct {
+		name    string
+		height  int64
+		expPass bool
+	}{
+		{
+			"pass - current block",
+			0,
+			true,
+		},
+		{
+			"pass - block height",
+			height,
+			true,
+		},
+		{
+			"fail - random value not found",
+			height + 1,
+			false,
+		},
+		{
+			"fail - negative height",
+			-1,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.BlockRandom(suite.ctx, &types.QueryBlockRandomRequest{
+				Height: tc.height,
+			})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(random.Hex(), res.Random)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
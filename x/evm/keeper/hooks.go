@@ -18,12 +18,16 @@ func NewMultiEvmHooks(hooks ...types.EvmHooks) MultiEvmHooks {
 	return hooks
 }
 
-// PostTxProcessing delegate the call to underlying hooks
-func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+// PostTxProcessing delegate the call to underlying hooks, it returns the sum of
+// the fees paid out by the hooks
+func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) (sdk.Coins, error) {
+	paidOut := sdk.Coins{}
 	for i := range mh {
-		if err := mh[i].PostTxProcessing(ctx, msg, receipt); err != nil {
-			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		paid, err := mh[i].PostTxProcessing(ctx, msg, receipt)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
 		}
+		paidOut = paidOut.Add(paid...)
 	}
-	return nil
+	return paidOut, nil
 }
@@ -19,16 +19,25 @@ type LogRecordHook struct {
 	Logs []*ethtypes.Log
 }
 
-func (dh *LogRecordHook) PostTxProcessing(_ sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+func (dh *LogRecordHook) PostTxProcessing(_ sdk.Context, _ core.Message, receipt *ethtypes.Receipt) (sdk.Coins, error) {
 	dh.Logs = receipt.Logs
-	return nil
+	return nil, nil
 }
 
 // FailureHook always fail
 type FailureHook struct{}
 
-func (dh FailureHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
-	return errors.New("post tx processing failed")
+func (dh FailureHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) (sdk.Coins, error) {
+	return nil, errors.New("post tx processing failed")
+}
+
+// PayoutHook reports the fees it pays out
+type PayoutHook struct {
+	Paid sdk.Coins
+}
+
+func (dh PayoutHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) (sdk.Coins, error) {
+	return dh.Paid, nil
 }
 
 func (suite *KeeperTestSuite) TestEvmHooks() {
@@ -83,8 +92,23 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 			TxHash: txHash,
 			Logs:   logs,
 		}
-		result := k.PostTxProcessing(ctx, ethtypes.Message{}, receipt)
+		_, result := k.PostTxProcessing(ctx, ethtypes.Message{}, receipt)
 
 		tc.expFunc(hook, result)
 	}
 }
+
+func (suite *KeeperTestSuite) TestMultiEvmHooksPaidOut() {
+	paid := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, 10))
+	hooks := keeper.NewMultiEvmHooks(PayoutHook{Paid: paid}, &LogRecordHook{}, PayoutHook{Paid: paid})
+
+	paidOut, err := hooks.PostTxProcessing(suite.ctx, ethtypes.Message{}, &ethtypes.Receipt{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(paid.Add(paid...), paidOut)
+
+	// nothing is paid out when a hook fails
+	hooks = keeper.NewMultiEvmHooks(PayoutHook{Paid: paid}, FailureHook{})
+	paidOut, err = hooks.PostTxProcessing(suite.ctx, ethtypes.Message{}, &ethtypes.Receipt{})
+	suite.Require().Error(err)
+	suite.Require().Nil(paidOut)
+}
@@ -44,6 +44,8 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 	// fetch EIP1559 base fee and parameters
 	feeMarketKeeper types.FeeMarketKeeper
+	// restore the fee allowances charged for sponsored transactions
+	feeGrantKeeper types.FeeGrantKeeper
 
 	// chain ID number obtained from the context's chain id
 	eip155ChainID *big.Int
@@ -53,6 +55,10 @@ type Keeper struct {
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
+	// stateful precompiled contracts that can be enabled through the module params
+	precompiles map[common.Address]vm.PrecompiledContract
+	// stateful precompiled contracts instantiated on demand from the chain state
+	dynamicPrecompiles types.DynamicPrecompiles
 	// Legacy subspace
 	ss paramstypes.Subspace
 }
@@ -66,6 +72,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
 	fmk types.FeeMarketKeeper,
+	fgk types.FeeGrantKeeper,
 	tracer string,
 	ss paramstypes.Subspace,
 ) *Keeper {
@@ -87,6 +94,7 @@ func NewKeeper(
 		bankKeeper:      bankKeeper,
 		stakingKeeper:   sk,
 		feeMarketKeeper: fmk,
+		feeGrantKeeper:  fgk,
 		storeKey:        storeKey,
 		transientKey:    transientKey,
 		tracer:          tracer,
@@ -240,9 +248,10 @@ func (k *Keeper) CleanHooks() *Keeper {
 }
 
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
-func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+// and no fees paid out
+func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) (sdk.Coins, error) {
 	if k.hooks == nil {
-		return nil
+		return nil, nil
 	}
 	return k.hooks.PostTxProcessing(ctx, msg, receipt)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 )
@@ -144,3 +145,40 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// InstallPredeploys implements the gRPC MsgServer interface. When an
+// InstallPredeploys proposal passes, it installs the well-known predeploys at
+// their canonical addresses. The installation can only be performed if the
+// requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) InstallPredeploys(goCtx context.Context, req *types.MsgInstallPredeploys) (*types.MsgInstallPredeploysResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.InstallPredeploysByName(ctx, req.Names...); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInstallPredeploysResponse{}, nil
+}
+
+// SetSponsoredContracts implements the gRPC MsgServer interface. It replaces
+// the contracts whose calls are paid for by the sponsor signing the message,
+// and the limits of the sponsored transactions.
+func (k *Keeper) SetSponsoredContracts(goCtx context.Context, req *types.MsgSetSponsoredContracts) (*types.MsgSetSponsoredContractsResponse, error) {
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	contracts := make([]common.Address, len(req.Contracts))
+	for i, contract := range req.Contracts {
+		contracts[i] = common.HexToAddress(contract)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetSponsorship(ctx, sponsor, contracts, req.Limits)
+
+	return &types.MsgSetSponsoredContractsResponse{}, nil
+}
@@ -109,3 +109,48 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestInstallPredeploys() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgInstallPredeploys
+		expectErr bool
+	}{
+		{
+			name: "fail - invalid authority",
+			request: &types.MsgInstallPredeploys{
+				Authority: "foobar",
+				Names:     []string{types.PredeployCreate2Deployer},
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - unknown predeploy",
+			request: &types.MsgInstallPredeploys{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Names:     []string{"multicall"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - valid install msg",
+			request: &types.MsgInstallPredeploys{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Names:     []string{types.PredeployCreate2Deployer},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.app.EvmKeeper.InstallPredeploys(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+var _ vm.OpCodeHooks = opCodeHooks{}
+
+// opCodeHooks are executed by the EVM before the CALL and CREATE opcodes and
+// before the message call of a transaction.
+type opCodeHooks struct {
+	ctx         sdk.Context
+	keeper      *Keeper
+	accessLists types.AccessLists
+}
+
+// OpCodeHooks returns the opcode hooks of the EVM instances created with the
+// given context and module params. The access lists of the params are parsed
+// once, when the hooks are created.
+func (k *Keeper) OpCodeHooks(ctx sdk.Context, params types.Params) vm.OpCodeHooks {
+	return opCodeHooks{
+		ctx:         ctx,
+		keeper:      k,
+		accessLists: params.AccessLists(),
+	}
+}
+
+// CallHook rejects the calls to the denied contracts, then loads the dynamic
+// precompile of the recipient, if any, so that it can be executed by the call.
+func (h opCodeHooks) CallHook(evm *vm.EVM, _ common.Address, recipient common.Address) error {
+	if h.accessLists.IsContractDenied(recipient) {
+		return errorsmod.Wrapf(types.ErrContractDenied, "address %s", recipient)
+	}
+
+	h.keeper.loadDynamicPrecompile(h.ctx, evm, recipient)
+	return nil
+}
+
+// CreateHook rejects the contract creations of the addresses outside of the
+// allowed deployers, whether the caller is the transaction sender or a
+// contract.
+func (h opCodeHooks) CreateHook(_ *vm.EVM, caller common.Address) error {
+	if !h.accessLists.IsDeployerAllowed(caller) {
+		return errorsmod.Wrapf(types.ErrDeployerNotAllowed, "address %s", caller)
+	}
+	return nil
+}
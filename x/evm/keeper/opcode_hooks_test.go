@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/HarryBin2002/kairoschain/v12/server/config"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestAccessControl() {
+	predeploy, _ := types.GetPredeploy(types.PredeployCreate2Deployer)
+	deployer := common.HexToAddress(predeploy.Address)
+
+	// the init code returns an empty runtime code
+	salt := common.HexToHash("0x01")
+	initCode := common.FromHex("0x60006000f3")
+	create2Data := append(salt.Bytes(), initCode...)
+
+	testCases := []struct {
+		name       string
+		malleate   func(params *types.Params)
+		to         *common.Address
+		data       []byte
+		expErr     bool
+		expVMError bool
+	}{
+		{
+			"pass - no restriction",
+			func(*types.Params) {},
+			&deployer,
+			create2Data,
+			false,
+			false,
+		},
+		{
+			"pass - allowed deployer",
+			func(params *types.Params) {
+				params.AllowedDeployers = []string{suite.address.Hex()}
+			},
+			nil,
+			initCode,
+			false,
+			false,
+		},
+		{
+			"fail - deployer not allowed",
+			func(params *types.Params) {
+				params.AllowedDeployers = []string{deployer.Hex()}
+			},
+			nil,
+			initCode,
+			true,
+			false,
+		},
+		{
+			"pass - nested create from an allowed deployer",
+			func(params *types.Params) {
+				params.AllowedDeployers = []string{deployer.Hex()}
+			},
+			&deployer,
+			create2Data,
+			false,
+			false,
+		},
+		{
+			"fail - nested create from a deployer not allowed",
+			func(params *types.Params) {
+				params.AllowedDeployers = []string{suite.address.Hex()}
+			},
+			&deployer,
+			create2Data,
+			false,
+			true,
+		},
+		{
+			"fail - call to a denied contract",
+			func(params *types.Params) {
+				params.DeniedContracts = []string{deployer.Hex()}
+			},
+			&deployer,
+			create2Data,
+			true,
+			false,
+		},
+		{
+			"fail - blocked sender",
+			func(params *types.Params) {
+				params.BlockedSenders = []string{suite.address.Hex()}
+			},
+			&deployer,
+			create2Data,
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.app.EvmKeeper.InstallPredeploysByName(suite.ctx, types.PredeployCreate2Deployer))
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			tc.malleate(&params)
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			args, err := json.Marshal(&types.TransactionArgs{
+				From: &suite.address,
+				To:   tc.to,
+				Data: (*hexutil.Bytes)(&tc.data),
+			})
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			if tc.expVMError {
+				// the factory reverts when its create fails
+				suite.Require().NotEmpty(res.VmError)
+			} else {
+				suite.Require().Empty(res.VmError)
+			}
+		})
+	}
+}
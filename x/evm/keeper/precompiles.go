@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// WithPrecompiles registers the stateful precompiled contracts that can be enabled
+// through the ActivePrecompiles module parameter. Each precompile is registered at the
+// address returned by its Address method.
+// It panics if an address is registered twice or if it belongs to an Ethereum precompile.
+func (k *Keeper) WithPrecompiles(precompiles ...vm.PrecompiledContract) *Keeper {
+	if k.precompiles == nil {
+		k.precompiles = make(map[common.Address]vm.PrecompiledContract, len(precompiles))
+	}
+
+	for _, precompile := range precompiles {
+		address := precompile.Address()
+
+		if _, found := vm.PrecompiledContractsBLS[address]; found {
+			panic(fmt.Errorf("precompile address %s is reserved by an Ethereum precompile", address))
+		}
+		if _, found := vm.PrecompiledContractsBerlin[address]; found {
+			panic(fmt.Errorf("precompile address %s is reserved by an Ethereum precompile", address))
+		}
+		if _, found := k.precompiles[address]; found {
+			panic(fmt.Errorf("precompile already registered for address %s", address))
+		}
+
+		k.precompiles[address] = precompile
+	}
+
+	return k
+}
+
+// GetPrecompile returns the stateful precompile registered at the given address.
+func (k Keeper) GetPrecompile(address common.Address) (vm.PrecompiledContract, bool) {
+	precompile, found := k.precompiles[address]
+	return precompile, found
+}
+
+// ValidateActivePrecompiles checks that every active precompile from the given
+// params has been registered on the keeper.
+func (k Keeper) ValidateActivePrecompiles(params types.Params) error {
+	for _, address := range params.ActivePrecompileAddresses() {
+		if _, found := k.precompiles[address]; !found {
+			return errorsmod.Wrapf(types.ErrInvalidPrecompile, "precompile %s is not registered", address)
+		}
+	}
+	return nil
+}
+
+// ActivePrecompiles returns the precompiled contracts available for an EVM instance:
+// the Ethereum precompiles enabled by the chain rules and the registered stateful
+// precompiles that are active in the module parameters. The returned addresses are
+// sorted to keep the access list preparation deterministic.
+func (k Keeper) ActivePrecompiles(
+	params types.Params,
+	rules params.Rules,
+) (map[common.Address]vm.PrecompiledContract, []common.Address) {
+	defaults := vm.DefaultPrecompiles(rules)
+	precompiles := make(map[common.Address]vm.PrecompiledContract, len(defaults)+len(params.ActivePrecompiles))
+	addresses := make([]common.Address, 0, len(defaults)+len(params.ActivePrecompiles))
+
+	for address, precompile := range defaults {
+		precompiles[address] = precompile
+		addresses = append(addresses, address)
+	}
+
+	for _, address := range params.ActivePrecompileAddresses() {
+		precompile, found := k.precompiles[address]
+		if !found {
+			// unregistered precompiles are rejected when the params are set
+			continue
+		}
+		precompiles[address] = precompile
+		addresses = append(addresses, address)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	return precompiles, addresses
+}
+
+// WithDynamicPrecompiles sets the resolver of the stateful precompiles that are
+// instantiated on demand from the chain state.
+// It panics if the resolver has already been set.
+func (k *Keeper) WithDynamicPrecompiles(dynamicPrecompiles types.DynamicPrecompiles) *Keeper {
+	if k.dynamicPrecompiles != nil {
+		panic("cannot set dynamic precompiles twice")
+	}
+
+	k.dynamicPrecompiles = dynamicPrecompiles
+	return k
+}
+
+// loadDynamicPrecompile adds the dynamic precompile deployed at the given address,
+// if any, to the precompiled contracts of the EVM instance.
+func (k Keeper) loadDynamicPrecompile(ctx sdk.Context, evm *vm.EVM, address common.Address) {
+	if k.dynamicPrecompiles == nil {
+		return
+	}
+
+	if _, found := evm.Precompile(address); found {
+		return
+	}
+
+	precompile, found := k.dynamicPrecompiles.GetDynamicPrecompile(ctx, address)
+	if !found {
+		return
+	}
+
+	// the active addresses are ignored by the rules argument
+	addresses := evm.ActivePrecompiles(params.Rules{})
+	precompiles := make(map[common.Address]vm.PrecompiledContract, len(addresses)+1)
+	for _, active := range addresses {
+		precompiles[active], _ = evm.Precompile(active)
+	}
+
+	precompiles[address] = precompile
+	evm.WithPrecompiles(precompiles, append(addresses[:len(addresses):len(addresses)], address))
+}
@@ -0,0 +1,296 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	cmn "github.com/HarryBin2002/kairoschain/v12/precompiles/common"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+const sendPrecompileABI = `[{"type":"function","name":"send","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`
+
+var sendPrecompileAddress = common.HexToAddress("0x0000000000000000000000000000000000000900")
+
+// sendPrecompile is a minimal stateful precompile that sends the EVM denom from
+// the caller to the given recipient through the bank keeper.
+type sendPrecompile struct {
+	cmn.Precompile
+	suite *KeeperTestSuite
+}
+
+func newSendPrecompile(suite *KeeperTestSuite) *sendPrecompile {
+	contractABI, err := abi.JSON(strings.NewReader(sendPrecompileABI))
+	suite.Require().NoError(err)
+
+	return &sendPrecompile{
+		Precompile: cmn.NewPrecompile(sendPrecompileAddress, contractABI),
+		suite:      suite,
+	}
+}
+
+func (p *sendPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.Execute(evm, contract, readOnly, p.send)
+}
+
+func (p *sendPrecompile) send(
+	ctx sdk.Context,
+	_ *statedb.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to := args[0].(common.Address)
+	amount := args[1].(*big.Int)
+
+	coins := sdk.NewCoins(sdk.NewCoin(p.suite.denom, sdkmath.NewIntFromBigInt(amount)))
+	if err := p.suite.app.BankKeeper.SendCoins(ctx, contract.CallerAddress.Bytes(), to.Bytes(), coins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (suite *KeeperTestSuite) enableSendPrecompile() *sendPrecompile {
+	precompile := newSendPrecompile(suite)
+	suite.app.EvmKeeper.WithPrecompiles(precompile)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ActivePrecompiles = []string{sendPrecompileAddress.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	return precompile
+}
+
+func (suite *KeeperTestSuite) TestWithPrecompiles() {
+	suite.Require().Panics(func() {
+		suite.app.EvmKeeper.WithPrecompiles(&sendPrecompile{
+			Precompile: cmn.NewPrecompile(common.BytesToAddress([]byte{0x01}), abi.ABI{}),
+		})
+	}, "ethereum precompile address")
+
+	precompile := newSendPrecompile(suite)
+	suite.Require().NotPanics(func() {
+		suite.app.EvmKeeper.WithPrecompiles(precompile)
+	})
+	suite.Require().Panics(func() {
+		suite.app.EvmKeeper.WithPrecompiles(precompile)
+	}, "duplicated precompile")
+
+	found, ok := suite.app.EvmKeeper.GetPrecompile(sendPrecompileAddress)
+	suite.Require().True(ok)
+	suite.Require().Equal(precompile, found)
+}
+
+func (suite *KeeperTestSuite) TestSetParamsActivePrecompiles() {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ActivePrecompiles = []string{sendPrecompileAddress.Hex()}
+
+	err := suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	suite.Require().ErrorIs(err, types.ErrInvalidPrecompile)
+
+	suite.app.EvmKeeper.WithPrecompiles(newSendPrecompile(suite))
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.Require().Equal(params.ActivePrecompiles, suite.app.EvmKeeper.GetParams(suite.ctx).ActivePrecompiles)
+}
+
+func (suite *KeeperTestSuite) TestActivePrecompiles() {
+	chainCfg := suite.app.EvmKeeper.GetParams(suite.ctx).ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	rules := chainCfg.Rules(big.NewInt(suite.ctx.BlockHeight()), types.IsMergeNetsplit(chainCfg, big.NewInt(suite.ctx.BlockHeight())))
+	defaults := vm.DefaultPrecompiles(rules)
+
+	precompiles, addresses := suite.app.EvmKeeper.ActivePrecompiles(suite.app.EvmKeeper.GetParams(suite.ctx), rules)
+	suite.Require().Len(precompiles, len(defaults))
+	suite.Require().Len(addresses, len(defaults))
+
+	suite.enableSendPrecompile()
+
+	precompiles, addresses = suite.app.EvmKeeper.ActivePrecompiles(suite.app.EvmKeeper.GetParams(suite.ctx), rules)
+	suite.Require().Len(precompiles, len(defaults)+1)
+	suite.Require().Equal(sendPrecompileAddress, addresses[len(addresses)-1])
+	suite.Require().Contains(precompiles, sendPrecompileAddress)
+}
+
+func (suite *KeeperTestSuite) TestApplyMessagePrecompile() {
+	precompile := suite.enableSendPrecompile()
+	suite.FundDefaultAddress(1_000_000)
+
+	recipient := common.BytesToAddress(crypto.Keccak256([]byte("recipient"))[:20])
+	newMsg := func(amount int64) ethtypes.Message {
+		input, err := precompile.Pack("send", recipient, big.NewInt(amount))
+		suite.Require().NoError(err)
+
+		return ethtypes.NewMessage(
+			suite.address, &sendPrecompileAddress, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+			big.NewInt(0), 100_000, big.NewInt(0), nil, nil, input, nil, true,
+		)
+	}
+
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, newMsg(1_000), nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(
+		int64(1_000),
+		suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), suite.denom).Amount.Int64(),
+	)
+	suite.Require().Greater(res.GasUsed, params.TxGas)
+
+	// failed native calls revert the transaction without consuming all the gas
+	res, err = suite.app.EvmKeeper.ApplyMessage(suite.ctx, newMsg(10_000_000), nil, true)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+	suite.Require().Less(res.GasUsed, uint64(100_000))
+	suite.Require().Equal(
+		int64(1_000),
+		suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), suite.denom).Amount.Int64(),
+	)
+}
+
+func (suite *KeeperTestSuite) TestStateDBRunPrecompile() {
+	recipient := common.BytesToAddress(crypto.Keccak256([]byte("recipient"))[:20])
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(suite.denom, sdkmath.NewInt(1_000)))
+
+	send := func(ctx sdk.Context) error {
+		return suite.app.BankKeeper.SendCoins(ctx, sender, recipient.Bytes(), coins)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func(db *statedb.StateDB)
+		expBalance int64
+	}{
+		{
+			"precompile call is committed",
+			func(db *statedb.StateDB) {
+				suite.Require().NoError(db.RunPrecompile(send))
+				suite.Require().Equal(big.NewInt(1_000), db.GetBalance(recipient))
+			},
+			1_000,
+		},
+		{
+			"precompile call is reverted with the snapshot",
+			func(db *statedb.StateDB) {
+				snapshot := db.Snapshot()
+				suite.Require().NoError(db.RunPrecompile(send))
+				suite.Require().Equal(big.NewInt(1_000), db.GetBalance(recipient))
+
+				db.RevertToSnapshot(snapshot)
+				suite.Require().Equal(big.NewInt(0), db.GetBalance(recipient))
+			},
+			0,
+		},
+		{
+			"failed precompile call is discarded",
+			func(db *statedb.StateDB) {
+				err := db.RunPrecompile(func(ctx sdk.Context) error {
+					suite.Require().NoError(send(ctx))
+					return types.ErrInvalidPrecompile
+				})
+				suite.Require().ErrorIs(err, types.ErrInvalidPrecompile)
+				suite.Require().Equal(big.NewInt(0), db.GetBalance(recipient))
+			},
+			0,
+		},
+		{
+			"EVM state changes are visible to the precompile call",
+			func(db *statedb.StateDB) {
+				db.AddBalance(recipient, big.NewInt(500))
+				suite.Require().NoError(db.RunPrecompile(func(ctx sdk.Context) error {
+					balance := suite.app.BankKeeper.GetBalance(ctx, recipient.Bytes(), suite.denom)
+					suite.Require().Equal(int64(500), balance.Amount.Int64())
+					return send(ctx)
+				}))
+				db.AddBalance(recipient, big.NewInt(500))
+				suite.Require().Equal(big.NewInt(2_000), db.GetBalance(recipient))
+			},
+			2_000,
+		},
+		{
+			"committed storage remains the value at the start of the tx",
+			func(db *statedb.StateDB) {
+				key := common.BigToHash(big.NewInt(1))
+				origin := common.BigToHash(big.NewInt(1))
+				suite.app.EvmKeeper.SetState(suite.ctx, recipient, key, origin.Bytes())
+
+				db.SetState(recipient, key, common.BigToHash(big.NewInt(2)))
+				suite.Require().NoError(db.RunPrecompile(send))
+				suite.Require().Equal(common.BigToHash(big.NewInt(2)), db.GetState(recipient, key))
+				suite.Require().Equal(origin, db.GetCommittedState(recipient, key))
+
+				// restoring the original value overwrites the one of the cache context
+				db.SetState(recipient, key, origin)
+				suite.Require().NoError(db.RunPrecompile(func(ctx sdk.Context) error {
+					suite.Require().Equal(origin.Bytes(), suite.app.EvmKeeper.GetState(ctx, recipient, key).Bytes())
+					return nil
+				}))
+				suite.Require().Equal(origin, db.GetState(recipient, key))
+				suite.Require().Equal(origin, db.GetCommittedState(recipient, key))
+			},
+			1_000,
+		},
+		{
+			"suicided account is kept until the commit",
+			func(db *statedb.StateDB) {
+				db.SetCode(recipient, []byte{0x1})
+				suite.Require().True(db.Suicide(recipient))
+
+				suite.Require().NoError(db.RunPrecompile(send))
+				suite.Require().True(db.HasSuicided(recipient))
+				suite.Require().True(db.Exist(recipient))
+				suite.Require().Equal([]byte{0x1}, db.GetCode(recipient))
+			},
+			0,
+		},
+		{
+			"suicide is reverted with the precompile call",
+			func(db *statedb.StateDB) {
+				db.SetCode(recipient, []byte{0x1})
+				snapshot := db.Snapshot()
+				suite.Require().True(db.Suicide(recipient))
+				suite.Require().NoError(db.RunPrecompile(send))
+
+				db.RevertToSnapshot(snapshot)
+				suite.Require().False(db.HasSuicided(recipient))
+			},
+			0,
+		},
+		{
+			"precompile calls are limited per transaction",
+			func(db *statedb.StateDB) {
+				for i := 0; i < statedb.MaxPrecompileCalls; i++ {
+					suite.Require().NoError(db.RunPrecompile(send))
+				}
+
+				err := db.RunPrecompile(send)
+				suite.Require().ErrorIs(err, types.ErrMaxPrecompileCalls)
+			},
+			1_000 * statedb.MaxPrecompileCalls,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.FundDefaultAddress(1_000_000)
+
+			db := suite.StateDB()
+			tc.malleate(db)
+			suite.Require().NoError(db.Commit())
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), suite.denom)
+			suite.Require().Equal(tc.expBalance, balance.Amount.Int64())
+		})
+	}
+}
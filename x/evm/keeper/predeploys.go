@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// InstallPredeploysByName installs the well-known predeploys with the given
+// names at their canonical addresses, along with their dependencies. It's
+// called at genesis, by the governance, and can be called from upgrade
+// handlers.
+//
+// Installing a predeploy is idempotent: if its code is already deployed at its
+// address, the state of the contract is kept as is. It fails if the address
+// holds a different code.
+func (k *Keeper) InstallPredeploysByName(ctx sdk.Context, names ...string) error {
+	if err := types.ValidatePredeployNames(names); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		dependencies := append([]string{}, types.GetPredeployDependencies(name)...)
+		for _, dependency := range append(dependencies, name) {
+			predeploy, _ := types.GetPredeploy(dependency)
+			if err := k.installPredeploy(ctx, predeploy); err != nil {
+				return errorsmod.Wrapf(err, "failed to install predeploy %s", dependency)
+			}
+		}
+	}
+	return nil
+}
+
+// GetPredeploys returns the installed predeploys, ordered by name, with the
+// code deployed on this chain.
+func (k Keeper) GetPredeploys(ctx sdk.Context) []types.Predeploy {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixPredeploy)
+	defer iterator.Close()
+
+	var predeploys []types.Predeploy
+	for ; iterator.Valid(); iterator.Next() {
+		name := string(iterator.Key()[len(types.KeyPrefixPredeploy):])
+		if predeploy, found := types.GetPredeploy(name); found {
+			predeploy.Code = hex.EncodeToString(predeploy.RuntimeCode(k.ChainID()))
+			predeploys = append(predeploys, predeploy)
+		}
+	}
+	return predeploys
+}
+
+// installPredeploy deploys the code and the storage of the predeploy, the
+// nonce of the contract is set to 1 as for the contracts created by a
+// transaction (EIP-161).
+func (k *Keeper) installPredeploy(ctx sdk.Context, predeploy types.Predeploy) error {
+	address := common.HexToAddress(predeploy.Address)
+	code := predeploy.RuntimeCode(k.ChainID())
+
+	if acct := k.GetAccount(ctx, address); acct != nil && acct.IsContract() {
+		if common.BytesToHash(acct.CodeHash) != crypto.Keccak256Hash(code) {
+			return errorsmod.Wrapf(types.ErrInvalidPredeploy, "address %s already holds a different code", address)
+		}
+	} else {
+		stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+		stateDB.SetCode(address, code)
+		if stateDB.GetNonce(address) == 0 {
+			stateDB.SetNonce(address, 1)
+		}
+		for _, state := range predeploy.Storage {
+			stateDB.SetState(address, common.HexToHash(state.Key), common.HexToHash(state.Value))
+		}
+		if err := stateDB.Commit(); err != nil {
+			return err
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPredeploy)
+	store.Set([]byte(predeploy.Name), address.Bytes())
+	return nil
+}
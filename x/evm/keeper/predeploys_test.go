@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/HarryBin2002/kairoschain/v12/server/config"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestInstallPredeploysByName() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		names    []string
+		expPass  bool
+	}{
+		{
+			"pass - no predeploy",
+			func() {},
+			nil,
+			true,
+		},
+		{
+			"pass - install predeploys",
+			func() {},
+			[]string{types.PredeploySafeSingletonFactory, types.PredeployCreate2Deployer},
+			true,
+		},
+		{
+			"pass - install all predeploys",
+			func() {},
+			types.AvailablePredeploys(),
+			true,
+		},
+		{
+			"pass - already installed",
+			func() {
+				err := suite.app.EvmKeeper.InstallPredeploysByName(suite.ctx, types.PredeployCreate2Deployer)
+				suite.Require().NoError(err)
+			},
+			[]string{types.PredeployCreate2Deployer},
+			true,
+		},
+		{
+			"fail - unknown predeploy",
+			func() {},
+			[]string{"multicall"},
+			false,
+		},
+		{
+			"fail - address holds a different code",
+			func() {
+				predeploy, _ := types.GetPredeploy(types.PredeployCreate2Deployer)
+				vmdb := suite.StateDB()
+				vmdb.SetCode(common.HexToAddress(predeploy.Address), []byte{0x01})
+				suite.Require().NoError(vmdb.Commit())
+			},
+			[]string{types.PredeployCreate2Deployer},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := suite.app.EvmKeeper.InstallPredeploysByName(suite.ctx, tc.names...)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			for _, name := range tc.names {
+				predeploy, _ := types.GetPredeploy(name)
+				address := common.HexToAddress(predeploy.Address)
+				code := predeploy.RuntimeCode(suite.app.EvmKeeper.ChainID())
+				suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(code)))
+				suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
+			}
+
+			res, err := suite.queryClient.Predeploys(suite.ctx, &types.QueryPredeploysRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Predeploys, len(tc.names))
+			for _, predeploy := range res.Predeploys {
+				suite.Require().Contains(tc.names, predeploy.Name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreate2DeployerPredeploy() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.app.EvmKeeper.InstallPredeploysByName(suite.ctx, types.PredeployCreate2Deployer))
+
+	predeploy, _ := types.GetPredeploy(types.PredeployCreate2Deployer)
+	deployer := common.HexToAddress(predeploy.Address)
+
+	// the init code returns an empty runtime code
+	salt := common.HexToHash("0x01")
+	initCode := common.FromHex("0x60006000f3")
+	data := append(salt.Bytes(), initCode...)
+
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &suite.address,
+		To:   &deployer,
+		Data: (*hexutil.Bytes)(&data),
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+
+	expected := crypto.CreateAddress2(deployer, salt, crypto.Keccak256(initCode))
+	suite.Require().Equal(expected.Bytes(), res.Ret)
+}
+
+// callPredeploy calls the predeploy with the given name and returns the result.
+func (suite *KeeperTestSuite) callPredeploy(name string, data []byte) []byte {
+	predeploy, _ := types.GetPredeploy(name)
+	to := common.HexToAddress(predeploy.Address)
+
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &suite.address,
+		To:   &to,
+		Data: (*hexutil.Bytes)(&data),
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+	return res.Ret
+}
+
+func (suite *KeeperTestSuite) TestMulticall3Predeploy() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.app.EvmKeeper.InstallPredeploysByName(suite.ctx, types.PredeployMulticall3))
+
+	// getChainId()
+	ret := suite.callPredeploy(types.PredeployMulticall3, common.FromHex("0x3408e470"))
+	suite.Require().Equal(suite.app.EvmKeeper.ChainID(), new(big.Int).SetBytes(ret))
+}
+
+func (suite *KeeperTestSuite) TestPermit2Predeploy() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.app.EvmKeeper.InstallPredeploysByName(suite.ctx, types.PredeployPermit2))
+
+	predeploy, _ := types.GetPredeploy(types.PredeployPermit2)
+	expected := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte("Permit2")),
+		common.LeftPadBytes(suite.app.EvmKeeper.ChainID().Bytes(), 32),
+		common.LeftPadBytes(common.HexToAddress(predeploy.Address).Bytes(), 32),
+	)
+
+	// DOMAIN_SEPARATOR()
+	ret := suite.callPredeploy(types.PredeployPermit2, common.FromHex("0x3644e515"))
+	suite.Require().Equal(expected, ret)
+}
+
+func (suite *KeeperTestSuite) TestEntryPointV06Predeploy() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.app.EvmKeeper.InstallPredeploysByName(suite.ctx, types.PredeployEntryPointV06))
+
+	// the sender creator is installed along with the entry point
+	res, err := suite.queryClient.Predeploys(suite.ctx, &types.QueryPredeploysRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Predeploys, 2)
+	suite.Require().Equal(types.PredeployEntryPointV06, res.Predeploys[0].Name)
+	suite.Require().Equal(types.PredeploySenderCreatorV06, res.Predeploys[1].Name)
+
+	// getNonce(address,uint192)
+	data := append(common.FromHex("0x35567e1a"), common.LeftPadBytes(suite.address.Bytes(), 32)...)
+	data = append(data, make([]byte, 32)...)
+	ret := suite.callPredeploy(types.PredeployEntryPointV06, data)
+	suite.Require().Equal(make([]byte, 32), ret)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// BlockRandomHistory is the number of recent block random values kept in the
+// store, it matches the window of the BLOCKHASH opcode.
+const BlockRandomHistory = 256
+
+// CurrentBlockRandom returns the random value of the block from the context, which
+// is returned by the PREVRANDAO opcode.
+//
+// The random value of a block is the hash chain of the random value of the
+// previous block and the block hash. The block hash commits to the app hash
+// of the previous block, so the value can't be known before the block is
+// proposed. The value isn't stored when the context is on top of the state of
+// the previous block (eg: tracing), in which case it's derived again.
+func (k Keeper) CurrentBlockRandom(ctx sdk.Context) common.Hash {
+	height := ctx.BlockHeight()
+	if random, found := k.GetBlockRandom(ctx, height); found {
+		return random
+	}
+
+	parent, _ := k.GetBlockRandom(ctx, height-1)
+	blockHash := k.GetHashFn(ctx)(uint64(height)) // #nosec G701 -- block height is positive
+	return crypto.Keccak256Hash(parent.Bytes(), blockHash.Bytes())
+}
+
+// GetBlockRandom returns the random value of the block at the given height,
+// only the values of the recent blocks are available.
+func (k Keeper) GetBlockRandom(ctx sdk.Context, height int64) (common.Hash, bool) {
+	if height <= 0 {
+		return common.Hash{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockRandom)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height)))
+	if len(bz) == 0 {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
+
+// SetBlockRandom stores the random value of the block from the context and
+// deletes the value which left the history window.
+func (k Keeper) SetBlockRandom(ctx sdk.Context, random common.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockRandom)
+	height := ctx.BlockHeight()
+	store.Set(sdk.Uint64ToBigEndian(uint64(height)), random.Bytes())
+
+	if pruned := height - BlockRandomHistory; pruned > 0 {
+		store.Delete(sdk.Uint64ToBigEndian(uint64(pruned)))
+	}
+}
+
+// EmitBlockRandomEvent emits the random value of the block, it's returned by
+// the JSON-RPC as the block mix hash.
+func (k Keeper) EmitBlockRandomEvent(ctx sdk.Context, random common.Hash) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockRandom,
+			sdk.NewAttribute(types.AttributeKeyBlockRandom, random.Hex()),
+		),
+	)
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// The transactions and the receipts executed by the EVM are collected in the
+// transient store during the block, and their Merkle-Patricia roots are
+// committed at the end of the block, so that the inclusion of a transaction
+// or a receipt can be proven against the block header. The transactions
+// which exceed the block gas limit are reverted and are not part of the roots.
+
+// SetTxReceiptTransient sets the consensus encodings of the transaction and
+// its receipt at the given index of the block.
+func (k Keeper) SetTxReceiptTransient(ctx sdk.Context, index uint64, tx *ethtypes.Transaction, receipt *ethtypes.Receipt) error {
+	txBz, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	receiptBz, err := receipt.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	prefix.NewStore(store, types.KeyPrefixTransientTx).Set(sdk.Uint64ToBigEndian(index), txBz)
+	prefix.NewStore(store, types.KeyPrefixTransientReceipt).Set(sdk.Uint64ToBigEndian(index), receiptBz)
+	return nil
+}
+
+// DeriveBlockRoots returns the transactions root and the receipts root of the
+// transactions executed in the current block.
+func (k Keeper) DeriveBlockRoots(ctx sdk.Context) (txRoot, receiptRoot common.Hash) {
+	txs := k.encodedListTransient(ctx, types.KeyPrefixTransientTx)
+	receipts := k.encodedListTransient(ctx, types.KeyPrefixTransientReceipt)
+	return ethtypes.DeriveSha(txs, trie.NewStackTrie(nil)), ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil))
+}
+
+// GetBlockRoots returns the transactions root and the receipts root of the
+// last block, they are empty if not committed yet.
+func (k Keeper) GetBlockRoots(ctx sdk.Context) (txRoot, receiptRoot common.Hash) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixBlockRoots)
+	if len(bz) != 2*common.HashLength {
+		return common.Hash{}, common.Hash{}
+	}
+	return common.BytesToHash(bz[:common.HashLength]), common.BytesToHash(bz[common.HashLength:])
+}
+
+// SetBlockRoots stores the transactions root and the receipts root of the
+// block, replacing the roots of the previous block.
+func (k Keeper) SetBlockRoots(ctx sdk.Context, txRoot, receiptRoot common.Hash) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixBlockRoots, append(txRoot.Bytes(), receiptRoot.Bytes()...))
+}
+
+// EmitBlockRootsEvent emits the transactions root and the receipts root of the
+// block, they are returned by the JSON-RPC in the block header.
+func (k Keeper) EmitBlockRootsEvent(ctx sdk.Context, txRoot, receiptRoot common.Hash) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockRoots,
+			sdk.NewAttribute(types.AttributeKeyTransactionsRoot, txRoot.Hex()),
+			sdk.NewAttribute(types.AttributeKeyReceiptsRoot, receiptRoot.Hex()),
+		),
+	)
+}
+
+// encodedListTransient returns the encodings stored under the prefix of the
+// transient store, ordered by index.
+func (k Keeper) encodedListTransient(ctx sdk.Context, keyPrefix []byte) encodedList {
+	iterator := sdk.KVStorePrefixIterator(ctx.TransientStore(k.transientKey), keyPrefix)
+	defer iterator.Close()
+
+	var list encodedList
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, iterator.Value())
+	}
+	return list
+}
+
+// encodedList is a list of consensus encoded transactions or receipts, it
+// implements ethtypes.DerivableList.
+type encodedList [][]byte
+
+// Len implements ethtypes.DerivableList.
+func (l encodedList) Len() int {
+	return len(l)
+}
+
+// EncodeIndex implements ethtypes.DerivableList.
+func (l encodedList) EncodeIndex(i int, w *bytes.Buffer) {
+	w.Write(l[i])
+}
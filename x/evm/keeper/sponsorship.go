@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// ----------------------------------------------------------------------------
+// Gas Sponsorship
+// A sponsor, set as the fee granter of an Ethereum transaction, pays its gas
+// if it has granted a fee allowance to the sender or if the transaction calls
+// one of its sponsored contracts within the sponsorship limits. The leftover
+// gas is refunded to the sponsor.
+// ----------------------------------------------------------------------------
+
+// SetSponsorship replaces the contracts sponsored by an account and the limits
+// of the sponsored transactions. An empty list removes the sponsorship.
+func (k Keeper) SetSponsorship(ctx sdk.Context, sponsor sdk.AccAddress, contracts []common.Address, limits types.SponsorshipLimits) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SponsoredContractsPrefix(sponsor))
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if len(contracts) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.SponsorshipLimitsKey(sponsor))
+		return
+	}
+
+	for _, contract := range contracts {
+		store.Set(contract.Bytes(), []byte{1})
+	}
+	k.setSponsorshipLimits(ctx, sponsor, limits)
+}
+
+// GetSponsorshipLimits returns the limits of the transactions sponsored by an
+// account, if it sponsors contracts.
+func (k Keeper) GetSponsorshipLimits(ctx sdk.Context, sponsor sdk.AccAddress) (types.SponsorshipLimits, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SponsorshipLimitsKey(sponsor))
+	if len(bz) == 0 {
+		return types.SponsorshipLimits{}, false
+	}
+
+	var limits types.SponsorshipLimits
+	k.cdc.MustUnmarshal(bz, &limits)
+	return limits, true
+}
+
+func (k Keeper) setSponsorshipLimits(ctx sdk.Context, sponsor sdk.AccAddress, limits types.SponsorshipLimits) {
+	ctx.KVStore(k.storeKey).Set(types.SponsorshipLimitsKey(sponsor), k.cdc.MustMarshal(&limits))
+}
+
+// UseSponsorship checks that the account sponsors the called contract and that
+// the transaction, with the given gas limit, effective gas price and tip, is
+// within the sponsorship limits. The fee is then deducted from the spend limit.
+func (k Keeper) UseSponsorship(
+	ctx sdk.Context,
+	sponsor sdk.AccAddress,
+	contract common.Address,
+	gasLimit uint64,
+	gasPrice, gasTip *big.Int,
+	fee sdkmath.Int,
+) error {
+	limits, found := k.GetSponsorshipLimits(ctx, sponsor)
+	if !found || !k.IsSponsoredContract(ctx, sponsor, contract) {
+		return errorsmod.Wrapf(types.ErrInvalidSponsorship, "%s does not sponsor the calls to %s", sponsor, contract)
+	}
+
+	limits, err := limits.Accept(gasLimit, gasPrice, gasTip, fee)
+	if err != nil {
+		return err
+	}
+
+	k.setSponsorshipLimits(ctx, sponsor, limits)
+	return nil
+}
+
+// refundSponsorship adds the refunded leftover gas back to the spend limit of
+// the sponsor, unless the sponsorship has been removed meanwhile.
+func (k Keeper) refundSponsorship(ctx sdk.Context, sponsor sdk.AccAddress, amount sdkmath.Int) {
+	limits, found := k.GetSponsorshipLimits(ctx, sponsor)
+	if !found {
+		return
+	}
+
+	limits.SpendLimit = limits.SpendLimit.Add(amount)
+	k.setSponsorshipLimits(ctx, sponsor, limits)
+}
+
+// refundFeeAllowance adds the refunded leftover gas back to the fee allowance
+// the sponsor has granted to the sender, which was charged the full gas limit
+// of the transaction. Unlimited allowances are left untouched. An allowance
+// revoked when its spend limit was exhausted cannot be restored: the refund
+// then only goes to the balance of the sponsor.
+func (k Keeper) refundFeeAllowance(ctx sdk.Context, sponsor, grantee sdk.AccAddress, refund sdk.Coins) error {
+	allowance, err := k.feeGrantKeeper.GetAllowance(ctx, sponsor, grantee)
+	if err != nil {
+		// the allowance has been revoked
+		return nil
+	}
+
+	allowance, err = addToAllowance(allowance, refund)
+	if err != nil {
+		return err
+	}
+	return k.feeGrantKeeper.UpdateAllowance(ctx, sponsor, grantee, allowance)
+}
+
+// addToAllowance adds the coins to the spend limits of a fee allowance.
+func addToAllowance(allowance feegrant.FeeAllowanceI, coins sdk.Coins) (feegrant.FeeAllowanceI, error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		if !a.SpendLimit.Empty() {
+			a.SpendLimit = a.SpendLimit.Add(coins...)
+		}
+	case *feegrant.PeriodicAllowance:
+		if !a.Basic.SpendLimit.Empty() {
+			a.Basic.SpendLimit = a.Basic.SpendLimit.Add(coins...)
+		}
+		a.PeriodCanSpend = a.PeriodCanSpend.Add(coins...)
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		if inner, err = addToAllowance(inner, coins); err != nil {
+			return nil, err
+		}
+		if err := a.SetAllowance(inner); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsorship, "unsupported fee allowance type %T", allowance)
+	}
+	return allowance, nil
+}
+
+// GetSponsoredContracts returns the contracts sponsored by an account, ordered
+// by address.
+func (k Keeper) GetSponsoredContracts(ctx sdk.Context, sponsor sdk.AccAddress) []common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SponsoredContractsPrefix(sponsor))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var contracts []common.Address
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, common.BytesToAddress(iterator.Key()))
+	}
+	return contracts
+}
+
+// IsSponsoredContract returns true if the account sponsors the calls to the
+// given contract.
+func (k Keeper) IsSponsoredContract(ctx sdk.Context, sponsor sdk.AccAddress, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SponsoredContractsPrefix(sponsor))
+	return store.Has(contract.Bytes())
+}
+
+// GetAllSponsorships returns the sponsored contracts of all the sponsors.
+func (k Keeper) GetAllSponsorships(ctx sdk.Context) []types.Sponsorship {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixSponsoredContract)
+	defer iterator.Close()
+
+	var sponsorships []types.Sponsorship
+	for ; iterator.Valid(); iterator.Next() {
+		// key layout: prefix | len(sponsor) | sponsor | contract
+		key := iterator.Key()[len(types.KeyPrefixSponsoredContract):]
+		sponsor := sdk.AccAddress(key[1 : 1+key[0]])
+		contract := common.BytesToAddress(key[1+key[0]:])
+
+		if n := len(sponsorships); n > 0 && sponsorships[n-1].Sponsor == sponsor.String() {
+			sponsorships[n-1].Contracts = append(sponsorships[n-1].Contracts, contract.Hex())
+			continue
+		}
+		limits, _ := k.GetSponsorshipLimits(ctx, sponsor)
+		sponsorships = append(sponsorships, types.NewSponsorship(sponsor, []common.Address{contract}, limits))
+	}
+	return sponsorships
+}
+
+// SetTxSponsorTransient records the sponsor paying the gas of the transaction
+// sent by an account with the given nonce.
+func (k Keeper) SetTxSponsorTransient(ctx sdk.Context, from common.Address, nonce uint64, sponsor types.TxSponsor) {
+	store := ctx.TransientStore(k.transientKey)
+
+	// the value is a flag set for the fee allowances, followed by the sponsor
+	flag := byte(0)
+	if sponsor.FeeAllowance {
+		flag = 1
+	}
+	store.Set(types.TxSponsorKey(from, nonce), append([]byte{flag}, sponsor.Address...))
+}
+
+// GetTxSponsorTransient returns the sponsor paying the gas of the transaction
+// sent by an account with the given nonce, if any.
+func (k Keeper) GetTxSponsorTransient(ctx sdk.Context, from common.Address, nonce uint64) (types.TxSponsor, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.TxSponsorKey(from, nonce))
+	if len(bz) < 2 {
+		return types.TxSponsor{}, false
+	}
+	return types.TxSponsor{
+		Address:      sdk.AccAddress(bz[1:]),
+		FeeAllowance: bz[0] == 1,
+	}, true
+}
@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/keeper"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+var testSponsorshipLimits = types.NewSponsorshipLimits(
+	1000000,
+	sdkmath.NewInt(1000000000000),
+	sdkmath.NewInt(1000000000),
+	sdkmath.NewInt(1000000000000000000),
+)
+
+func (suite *KeeperTestSuite) TestSponsorship() {
+	suite.SetupTest()
+
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	other := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract1, contract2 := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+
+	suite.Require().Empty(suite.app.EvmKeeper.GetSponsoredContracts(suite.ctx, sponsor))
+	suite.Require().Empty(suite.app.EvmKeeper.GetAllSponsorships(suite.ctx))
+
+	suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{contract1, contract2}, testSponsorshipLimits)
+	suite.app.EvmKeeper.SetSponsorship(suite.ctx, other, []common.Address{contract2}, testSponsorshipLimits)
+	suite.Require().ElementsMatch([]common.Address{contract1, contract2}, suite.app.EvmKeeper.GetSponsoredContracts(suite.ctx, sponsor))
+	suite.Require().True(suite.app.EvmKeeper.IsSponsoredContract(suite.ctx, sponsor, contract1))
+	suite.Require().False(suite.app.EvmKeeper.IsSponsoredContract(suite.ctx, other, contract1))
+	suite.Require().Len(suite.app.EvmKeeper.GetAllSponsorships(suite.ctx), 2)
+
+	// the list is replaced
+	suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{contract2}, testSponsorshipLimits)
+	suite.Require().Equal([]common.Address{contract2}, suite.app.EvmKeeper.GetSponsoredContracts(suite.ctx, sponsor))
+	suite.Require().False(suite.app.EvmKeeper.IsSponsoredContract(suite.ctx, sponsor, contract1))
+
+	// an empty list removes the sponsorship
+	suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, nil, testSponsorshipLimits)
+	suite.Require().Empty(suite.app.EvmKeeper.GetSponsoredContracts(suite.ctx, sponsor))
+	_, found := suite.app.EvmKeeper.GetSponsorshipLimits(suite.ctx, sponsor)
+	suite.Require().False(found)
+	suite.Require().Equal(
+		[]types.Sponsorship{types.NewSponsorship(other, []common.Address{contract2}, testSponsorshipLimits)},
+		suite.app.EvmKeeper.GetAllSponsorships(suite.ctx),
+	)
+}
+
+func (suite *KeeperTestSuite) TestSetSponsoredContracts() {
+	suite.SetupTest()
+
+	sponsor := sdk.AccAddress(suite.address.Bytes())
+	contract := utiltx.GenerateAddress()
+
+	_, err := suite.app.EvmKeeper.SetSponsoredContracts(suite.ctx, &types.MsgSetSponsoredContracts{
+		Sponsor:   "invalid",
+		Contracts: []string{contract.Hex()},
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.app.EvmKeeper.SetSponsoredContracts(suite.ctx, &types.MsgSetSponsoredContracts{
+		Sponsor:   sponsor.String(),
+		Contracts: []string{contract.Hex()},
+		Limits:    testSponsorshipLimits,
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.SponsoredContracts(suite.ctx, &types.QuerySponsoredContractsRequest{Sponsor: sponsor.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contract.Hex()}, res.Contracts)
+	suite.Require().Equal(testSponsorshipLimits.SpendLimit.String(), res.Limits.SpendLimit.String())
+
+	_, err = suite.app.EvmKeeper.SetSponsoredContracts(suite.ctx, &types.MsgSetSponsoredContracts{
+		Sponsor: sponsor.String(),
+	})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.SponsoredContracts(suite.ctx, &types.QuerySponsoredContractsRequest{Sponsor: sponsor.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Contracts)
+
+	_, err = suite.queryClient.SponsoredContracts(suite.ctx, &types.QuerySponsoredContractsRequest{Sponsor: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestUseSponsorship() {
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+	limits := testSponsorshipLimits
+
+	testCases := []struct {
+		name     string
+		contract common.Address
+		gasLimit uint64
+		gasPrice *big.Int
+		gasTip   *big.Int
+		fee      sdkmath.Int
+		expPass  bool
+	}{
+		{"pass", contract, limits.MaxGasPerTx, limits.MaxFeePerGas.BigInt(), limits.MaxPriorityFeePerGas.BigInt(), limits.SpendLimit, true},
+		{"fail - contract not sponsored", utiltx.GenerateAddress(), 21000, big.NewInt(1), big.NewInt(0), sdkmath.NewInt(21000), false},
+		{"fail - gas limit too high", contract, limits.MaxGasPerTx + 1, big.NewInt(1), big.NewInt(0), sdkmath.NewInt(21000), false},
+		{"fail - gas price too high", contract, 21000, limits.MaxFeePerGas.AddRaw(1).BigInt(), big.NewInt(0), sdkmath.NewInt(21000), false},
+		{"fail - gas tip too high", contract, 21000, big.NewInt(1), limits.MaxPriorityFeePerGas.AddRaw(1).BigInt(), sdkmath.NewInt(21000), false},
+		{"fail - spend limit exceeded", contract, 21000, big.NewInt(1), big.NewInt(0), limits.SpendLimit.AddRaw(1), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{contract}, limits)
+
+			err := suite.app.EvmKeeper.UseSponsorship(suite.ctx, sponsor, tc.contract, tc.gasLimit, tc.gasPrice, tc.gasTip, tc.fee)
+			updated, _ := suite.app.EvmKeeper.GetSponsorshipLimits(suite.ctx, sponsor)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(limits.SpendLimit.Sub(tc.fee).String(), updated.SpendLimit.String())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(limits.SpendLimit.String(), updated.SpendLimit.String())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundGasToSponsor() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	defer func() { suite.mintFeeCollector = false }()
+
+	keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	ethCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	m, err := newNativeMessage(
+		suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		suite.ctx.BlockHeight(),
+		suite.address,
+		ethCfg,
+		suite.signer,
+		signer,
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.app.EvmKeeper.SetSponsorship(suite.ctx, sponsor, []common.Address{utiltx.GenerateAddress()}, testSponsorshipLimits)
+	suite.app.EvmKeeper.SetTxSponsorTransient(suite.ctx, m.From(), m.Nonce(), types.TxSponsor{Address: sponsor})
+
+	senderBalance := suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address)
+	refund := keeper.GasToRefund(params.TxGas, params.TxGas, params.RefundQuotient)
+
+	err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, refund, types.DefaultEVMDenom)
+	suite.Require().NoError(err)
+
+	expRefund := new(big.Int).Mul(new(big.Int).SetUint64(refund), m.GasPrice())
+	suite.Require().Equal(expRefund.String(), suite.app.EvmKeeper.GetBalance(suite.ctx, common.BytesToAddress(sponsor)).String())
+	suite.Require().Equal(senderBalance.String(), suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address).String())
+
+	// the refund is given back to the spend limit
+	limits, _ := suite.app.EvmKeeper.GetSponsorshipLimits(suite.ctx, sponsor)
+	suite.Require().Equal(testSponsorshipLimits.SpendLimit.Add(sdkmath.NewIntFromBigInt(expRefund)).String(), limits.SpendLimit.String())
+}
+
+func (suite *KeeperTestSuite) TestRefundGasToFeeAllowance() {
+	spendLimit := sdk.NewCoins(sdk.NewCoin(types.DefaultEVMDenom, sdkmath.NewInt(1000000)))
+
+	testCases := []struct {
+		name      string
+		allowance func() feegrant.FeeAllowanceI
+		expLimit  func(refund sdk.Coins) feegrant.FeeAllowanceI
+	}{
+		{
+			"basic allowance",
+			func() feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{SpendLimit: spendLimit}
+			},
+			func(refund sdk.Coins) feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{SpendLimit: spendLimit.Add(refund...)}
+			},
+		},
+		{
+			"unlimited basic allowance",
+			func() feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{}
+			},
+			func(sdk.Coins) feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{}
+			},
+		},
+		{
+			"periodic allowance",
+			func() feegrant.FeeAllowanceI {
+				return &feegrant.PeriodicAllowance{
+					Basic:            feegrant.BasicAllowance{SpendLimit: spendLimit},
+					Period:           time.Hour,
+					PeriodSpendLimit: spendLimit,
+					PeriodCanSpend:   spendLimit,
+					PeriodReset:      suite.ctx.BlockTime().Add(time.Hour),
+				}
+			},
+			func(refund sdk.Coins) feegrant.FeeAllowanceI {
+				return &feegrant.PeriodicAllowance{
+					Basic:            feegrant.BasicAllowance{SpendLimit: spendLimit.Add(refund...)},
+					Period:           time.Hour,
+					PeriodSpendLimit: spendLimit,
+					PeriodCanSpend:   spendLimit.Add(refund...),
+					PeriodReset:      suite.ctx.BlockTime().Add(time.Hour),
+				}
+			},
+		},
+		{
+			"allowed message allowance",
+			func() feegrant.FeeAllowanceI {
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, []string{sdk.MsgTypeURL(&types.MsgEthereumTx{})})
+				suite.Require().NoError(err)
+				return allowance
+			},
+			func(refund sdk.Coins) feegrant.FeeAllowanceI {
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit.Add(refund...)}, []string{sdk.MsgTypeURL(&types.MsgEthereumTx{})})
+				suite.Require().NoError(err)
+				return allowance
+			},
+		},
+		{
+			"revoked allowance",
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			defer func() { suite.mintFeeCollector = false }()
+
+			keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+			ethCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+			signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+			m, err := newNativeMessage(
+				suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				suite.ctx.BlockHeight(),
+				suite.address,
+				ethCfg,
+				suite.signer,
+				signer,
+				ethtypes.AccessListTxType,
+				nil,
+				nil,
+			)
+			suite.Require().NoError(err)
+
+			sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			grantee := sdk.AccAddress(suite.address.Bytes())
+			if tc.allowance != nil {
+				suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, sponsor, grantee, tc.allowance()))
+			}
+			suite.app.EvmKeeper.SetTxSponsorTransient(suite.ctx, m.From(), m.Nonce(), types.TxSponsor{Address: sponsor, FeeAllowance: true})
+
+			refund := keeper.GasToRefund(params.TxGas, params.TxGas, params.RefundQuotient)
+			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, refund, types.DefaultEVMDenom)
+			suite.Require().NoError(err)
+
+			expRefund := new(big.Int).Mul(new(big.Int).SetUint64(refund), m.GasPrice())
+			suite.Require().Equal(expRefund.String(), suite.app.EvmKeeper.GetBalance(suite.ctx, common.BytesToAddress(sponsor)).String())
+
+			allowance, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, sponsor, grantee)
+			if tc.expLimit == nil {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			refundedCoins := sdk.NewCoins(sdk.NewCoin(types.DefaultEVMDenom, sdkmath.NewIntFromBigInt(expRefund)))
+			suite.Require().Equal(tc.expLimit(refundedCoins), allowance)
+		})
+	}
+}
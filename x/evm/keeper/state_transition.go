@@ -6,13 +6,16 @@ import (
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	evertypes "github.com/HarryBin2002/kairoschain/v12/types"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/interpreter"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -25,9 +28,9 @@ import (
 // coinbase address to make it available for the COINBASE opcode, even though there is no
 // beneficiary of the coinbase transaction (since we're not mining).
 //
-// NOTE: the RANDOM opcode is currently not supported since it requires
-// RANDAO implementation. See https://github.com/evmos/ethermint/pull/1520#pullrequestreview-1200504697
-// for more information.
+// NOTE: the PREVRANDAO opcode returns the random value derived from the block
+// hashes, see BlockRandom. It's only available from the merge netsplit block
+// height, the DIFFICULTY opcode returns 0 before it or if it's unset.
 
 func (k *Keeper) NewEVM(
 	ctx sdk.Context,
@@ -46,7 +49,11 @@ func (k *Keeper) NewEVM(
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
-		Random:      nil, // not supported
+	}
+	isMerge := types.IsMergeNetsplit(cfg.ChainConfig, blockCtx.BlockNumber)
+	if isMerge {
+		random := k.CurrentBlockRandom(ctx)
+		blockCtx.Random = &random
 	}
 
 	txCtx := core.NewEVMTxContext(msg)
@@ -54,7 +61,17 @@ func (k *Keeper) NewEVM(
 		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
 	}
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
-	return vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	evm := vm.NewEVMWithHooks(k.OpCodeHooks(ctx, cfg.Params), blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+
+	rules := cfg.ChainConfig.Rules(blockCtx.BlockNumber, isMerge)
+	evm.WithPrecompiles(k.ActivePrecompiles(cfg.Params, rules))
+
+	// the extra EIPs are validated by the module params, so no error is
+	// expected
+	if err := interpreter.Enable(evm); err != nil {
+		panic(err)
+	}
+	return evm
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -181,7 +198,9 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		bloomReceipt = ethtypes.BytesToBloom(bloom.Bytes())
 	}
 
-	cumulativeGasUsed := res.GasUsed
+	// the gas used by the previous messages of the cosmos tx isn't consumed
+	// from the block gas meter yet
+	cumulativeGasUsed := k.GetTransientGasUsed(ctx) + res.GasUsed
 	if ctx.BlockGasMeter() != nil {
 		limit := ctx.BlockGasMeter().Limit()
 		cumulativeGasUsed += ctx.BlockGasMeter().GasConsumed()
@@ -209,22 +228,30 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		TransactionIndex:  txConfig.TxIndex,
 	}
 
+	// the fees paid out of the fee collector by the post processing hooks, e.g.
+	// the developer revenue, the payouts are reverted along with a failed tx
+	paidOut := sdkmath.ZeroInt()
+
 	if !res.Failed() {
 		receipt.Status = ethtypes.ReceiptStatusSuccessful
 		// Only call hooks if tx executed successfully.
-		if err = k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
+		paid, err := k.PostTxProcessing(tmpCtx, msg, receipt)
+		if err != nil {
 			// If hooks return error, revert the whole tx.
 			res.VmError = types.ErrPostTxProcessing.Error()
 			k.Logger(ctx).Error("tx post processing failed", "error", err)
 
 			// If the tx failed in post processing hooks, we should clear the logs
 			res.Logs = nil
-		} else if commit != nil {
-			// PostTxProcessing is successful, commit the tmpCtx
-			commit()
-			// Since the post-processing can alter the log, we need to update the result
-			res.Logs = types.NewLogsFromEth(receipt.Logs)
-			ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
+		} else {
+			paidOut = paid.AmountOf(cfg.Params.EvmDenom)
+			if commit != nil {
+				// PostTxProcessing is successful, commit the tmpCtx
+				commit()
+				// Since the post-processing can alter the log, we need to update the result
+				res.Logs = types.NewLogsFromEth(receipt.Logs)
+				ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
+			}
 		}
 	}
 
@@ -233,12 +260,49 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
+	// burn the base fee part of the fee paid for the gas used, the tip is left
+	// untouched. Both are computed from the fee of the tx: the burn can't take
+	// more than the fee left once the payouts of the hooks are deducted.
+	if cfg.BaseFee != nil && cfg.BaseFee.Sign() > 0 {
+		gasUsed := new(big.Int).SetUint64(res.GasUsed)
+		price := math.BigMin(msg.GasPrice(), cfg.BaseFee)
+		baseFeePaid := new(big.Int).Mul(price, gasUsed)
+		if baseFeePaid.Sign() > 0 {
+			feesLeft := sdkmath.NewIntFromBigInt(new(big.Int).Mul(msg.GasPrice(), gasUsed)).Sub(paidOut)
+			feesLeft = sdkmath.MaxInt(feesLeft, sdkmath.ZeroInt())
+
+			_, err = k.feeMarketKeeper.BurnBaseFee(
+				ctx,
+				sdk.Coins{sdk.NewCoin(cfg.Params.EvmDenom, sdkmath.NewIntFromBigInt(baseFeePaid))},
+				sdk.Coins{sdk.NewCoin(cfg.Params.EvmDenom, feesLeft)},
+			)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "failed to burn the base fee paid by %s", msg.From())
+			}
+		}
+	}
+
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, receipt.Bloom.Big())
 		k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(receipt.Logs)))
 	}
 
+	// the receipt committed to the block receipts root reflects the outcome of
+	// the post processing, and its bloom only covers its own logs
+	blockReceipt := &ethtypes.Receipt{
+		Type:              tx.Type(),
+		CumulativeGasUsed: cumulativeGasUsed,
+		Logs:              types.LogsToEthereum(res.Logs),
+	}
+	if !res.Failed() {
+		blockReceipt.Status = ethtypes.ReceiptStatusSuccessful
+	}
+	blockReceipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{blockReceipt})
+	if err = k.SetTxReceiptTransient(ctx, uint64(txConfig.TxIndex), tx, blockReceipt); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set transient tx receipt")
+	}
+
 	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
 
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
@@ -319,7 +383,17 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	// return error if the sender, deployer or recipient are restricted through governance
+	if err := cfg.Params.AccessLists().ValidateMessage(msg.From(), msg.To()); err != nil {
+		return nil, err
+	}
+
 	stateDB := statedb.New(ctx, k, txConfig)
+	if cfg.Overrides != nil {
+		if err := stateDB.ApplyStateOverrides(cfg.Overrides); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+		}
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -352,10 +426,14 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From(), msg.To(), vm.ActivePrecompiles(rules), msg.AccessList())
+	height := big.NewInt(ctx.BlockHeight())
+	if rules := cfg.ChainConfig.Rules(height, types.IsMergeNetsplit(cfg.ChainConfig, height)); rules.IsBerlin {
+		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
+	// the transient storage is scoped to the transaction (EIP-1153)
+	stateDB.ClearTransientStorage()
+
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+	"encoding/json"
 	"fmt"
 	"github.com/HarryBin2002/kairoschain/v12/constants"
+	"github.com/HarryBin2002/kairoschain/v12/server/config"
 	"github.com/HarryBin2002/kairoschain/v12/testutil"
 	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
 	cointypes "github.com/HarryBin2002/kairoschain/v12/types"
@@ -17,8 +20,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 	"math"
 	"math/big"
@@ -530,6 +535,36 @@ func (suite *KeeperTestSuite) TestEVMConfig() {
 	suite.Require().Equal(types.DefaultParams().ChainConfig.EthereumConfig(big.NewInt(constants.TestnetEIP155ChainId)), cfg.ChainConfig)
 }
 
+func (suite *KeeperTestSuite) TestNewEVMRandom() {
+	proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+	cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(constants.TestnetEIP155ChainId))
+	suite.Require().NoError(err)
+	msg := ethtypes.NewMessage(suite.address, &common.Address{}, 0, big.NewInt(0), params.TxGas, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false)
+
+	height := suite.ctx.BlockHeight()
+	testCases := []struct {
+		name       string
+		mergeBlock *big.Int
+		expRandom  bool
+	}{
+		{"merge netsplit block unset", nil, false},
+		{"merge netsplit block not reached", big.NewInt(height + 1), false},
+		{"merge netsplit block reached", big.NewInt(height), true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cfg.ChainConfig.MergeNetsplitBlock = tc.mergeBlock
+			evm := suite.app.EvmKeeper.NewEVM(suite.ctx, msg, cfg, nil, suite.StateDB())
+			if tc.expRandom {
+				suite.Require().NotNil(evm.Context.Random)
+				suite.Require().Equal(suite.app.EvmKeeper.CurrentBlockRandom(suite.ctx), *evm.Context.Random)
+			} else {
+				suite.Require().Nil(evm.Context.Random)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestContractDeployment() {
 	contractAddress := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(10000000000000))
 	db := suite.StateDB()
@@ -757,6 +792,52 @@ func (suite *KeeperTestSuite) TestApplyTransaction() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestApplyTransactionBaseFeeBurn() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	chainCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, chainCfg)
+	suite.Require().Positive(baseFee.Sign())
+
+	// the leftover gas is refunded from the fee collector
+	err := testutil.FundModuleAccount(
+		suite.ctx,
+		suite.app.BankKeeper,
+		authtypes.FeeCollectorName,
+		sdk.NewCoins(cointypes.NewBaseCoinInt64(1_000_000_000_000_000)),
+	)
+	suite.Require().NoError(err)
+	suite.FundDefaultAddress(1_000_000)
+
+	randomAddr, _ := utiltx.NewAddrKey()
+	tip := big.NewInt(2)
+	ethMsg := types.NewTx(&types.EvmTxArgs{
+		Nonce:     getNonce(suite.address.Bytes()),
+		GasLimit:  50000,
+		GasFeeCap: new(big.Int).Add(baseFee, tip),
+		GasTipCap: tip,
+		ChainID:   chainCfg.ChainID,
+		Amount:    big.NewInt(1),
+		To:        &randomAddr,
+	})
+	ethMsg.From = suite.address.Hex()
+	err = ethMsg.Sign(ethtypes.MakeSigner(chainCfg, big.NewInt(suite.ctx.BlockHeight())), suite.signer)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithGasMeter(cointypes.NewInfiniteGasMeterWithLimit(ethMsg.GetGas()))
+	res, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, ethMsg.AsTransaction())
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+
+	// only the base fee part of the fee is burned, the tip is left out
+	expBurned := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(res.GasUsed))
+	burned := suite.app.FeeMarketKeeper.GetBurnedSupply(suite.ctx)
+	suite.Require().Equal(expBurned.String(), burned.AmountOf(keeperParams.EvmDenom).String())
+}
+
 func (suite *KeeperTestSuite) TestApplyMessage() {
 	var (
 		msg          core.Message
@@ -1222,6 +1303,137 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestShanghaiCancunInstructions() {
+	contract := utiltx.GenerateAddress()
+	callee := utiltx.GenerateAddress()
+
+	// returnOf returns the code returning the word pushed by the given opcodes
+	returnOf := func(ops ...byte) hexutil.Bytes {
+		return append(ops, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3)
+	}
+	// callOf returns the code returning the success of a call to the callee
+	// with the given opcode
+	callOf := func(op byte) hexutil.Bytes {
+		ops := []byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00}
+		if op == byte(vm.CALL) {
+			ops = append(ops, 0x60, 0x00)
+		}
+		ops = append(ops, 0x73)
+		ops = append(ops, callee.Bytes()...)
+		return returnOf(append(ops, 0x5a, op)...)
+	}
+	tstore := hexutil.Bytes{0x60, 0x2a, 0x60, 0x01, 0x5d, 0x00}
+
+	testCases := []struct {
+		name        string
+		code        hexutil.Bytes
+		calleeCode  hexutil.Bytes
+		disableFork func(*types.Params)
+		expRet      common.Hash
+		expVMError  string
+	}{
+		{
+			"PUSH0",
+			hexutil.Bytes{0x60, 0x2a, 0x5f, 0x52, 0x60, 0x20, 0x5f, 0xf3},
+			nil,
+			nil,
+			common.BigToHash(big.NewInt(42)),
+			"",
+		},
+		{
+			"PUSH0 before shanghai",
+			hexutil.Bytes{0x60, 0x2a, 0x5f, 0x52, 0x60, 0x20, 0x5f, 0xf3},
+			nil,
+			func(params *types.Params) {
+				maxInt := sdkmath.NewInt(math.MaxInt64)
+				params.ChainConfig.ShanghaiBlock, params.ChainConfig.CancunBlock = &maxInt, &maxInt
+				// PUSH0 can also be enabled through the extra EIPs
+				params.ExtraEIPs = nil
+			},
+			common.Hash{},
+			"invalid opcode: PUSH0",
+		},
+		{
+			"TSTORE and TLOAD",
+			returnOf(0x60, 0x2a, 0x60, 0x01, 0x5d, 0x60, 0x01, 0x5c),
+			nil,
+			nil,
+			common.BigToHash(big.NewInt(42)),
+			"",
+		},
+		{
+			"TLOAD of another transaction",
+			returnOf(0x60, 0x01, 0x5c),
+			nil,
+			nil,
+			common.Hash{},
+			"",
+		},
+		{
+			"TSTORE in a call",
+			callOf(byte(vm.CALL)),
+			tstore,
+			nil,
+			common.BigToHash(big.NewInt(1)),
+			"",
+		},
+		{
+			"TSTORE in a static call",
+			callOf(byte(vm.STATICCALL)),
+			tstore,
+			nil,
+			common.Hash{},
+			"",
+		},
+		{
+			"TLOAD before cancun",
+			returnOf(0x60, 0x01, 0x5c),
+			nil,
+			func(params *types.Params) {
+				maxInt := sdkmath.NewInt(math.MaxInt64)
+				params.ChainConfig.CancunBlock = &maxInt
+			},
+			common.Hash{},
+			"invalid opcode: TLOAD",
+		},
+		{
+			"MCOPY",
+			hexutil.Bytes{0x60, 0x2a, 0x60, 0x20, 0x52, 0x60, 0x20, 0x60, 0x20, 0x60, 0x00, 0x5e, 0x60, 0x20, 0x60, 0x00, 0xf3},
+			nil,
+			nil,
+			common.BigToHash(big.NewInt(42)),
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.disableFork != nil {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				tc.disableFork(&params)
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			}
+
+			overrides := types.StateOverride{contract: {Code: &tc.code}}
+			if tc.calleeCode != nil {
+				overrides[callee] = types.OverrideAccount{Code: &tc.calleeCode}
+			}
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			req.Overrides, err = json.Marshal(overrides)
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expVMError, res.VmError)
+			if tc.expVMError == "" {
+				suite.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, cfg *params.ChainConfig, gasPrice *big.Int) (core.Message, error) {
 	ethMsg, err := suite.createContractMsgTx(nonce, signer, gasPrice)
 	if err != nil {
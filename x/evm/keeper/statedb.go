@@ -139,7 +139,9 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 	return nil
 }
 
-// SetState update contract storage, delete if value is empty.
+// SetState update contract storage, delete if value is empty. The key is marked
+// dirty so that the storage trie of the account is updated at the end of the
+// block.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 	action := "updated"
@@ -149,6 +151,8 @@ func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash,
 	} else {
 		store.Set(key.Bytes(), value)
 	}
+
+	ctx.TransientStore(k.transientKey).Set(types.DirtyStorageKey(addr, key), []byte{1})
 	k.Logger(ctx).Debug(
 		fmt.Sprintf("state %s", action),
 		"ethereum-address", addr.Hex(),
@@ -177,7 +181,7 @@ func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 // DeleteAccount handles contract's suicide call:
 // - clear balance
 // - remove code
-// - remove states
+// - remove states and storage trie
 // - remove auth account
 func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
@@ -198,10 +202,12 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	}
 
 	// clear storage
+	storage := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 	k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
-		k.SetState(ctx, addr, key, nil)
+		storage.Delete(key.Bytes())
 		return true
 	})
+	k.deleteStorageTrie(ctx, addr)
 
 	// remove auth account
 	k.accountKeeper.RemoveAccount(ctx, acct)
@@ -18,9 +18,13 @@ import (
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 )
 
 func (suite *KeeperTestSuite) TestCreateAccount() {
@@ -976,3 +980,116 @@ func (suite *KeeperTestSuite) TestDeleteAccount() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestStorageTrie() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	addr := suite.address
+	suite.Require().Equal(ethtypes.EmptyRootHash, k.GetStorageRoot(suite.ctx, addr))
+
+	// the reference trie is built in memory the same way as in Ethereum
+	expTrie := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+	setState := func(key, value common.Hash) {
+		hashedKey := crypto.Keccak256(key.Bytes())
+		if value == (common.Hash{}) {
+			k.SetState(suite.ctx, addr, key, nil)
+			expTrie.Delete(hashedKey)
+		} else {
+			k.SetState(suite.ctx, addr, key, value.Bytes())
+			enc, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value.Bytes()))
+			suite.Require().NoError(err)
+			expTrie.Update(hashedKey, enc)
+		}
+	}
+	updateTrie := func() {
+		suite.Require().NoError(k.UpdateStorageTries(suite.ctx))
+		suite.Require().Equal(expTrie.Hash(), k.GetStorageRoot(suite.ctx, addr))
+	}
+
+	// the trie is updated once with all the keys written in the block
+	for i := int64(1); i <= 50; i++ {
+		setState(common.BigToHash(big.NewInt(i)), common.BigToHash(big.NewInt(i*1000)))
+	}
+	suite.Require().Equal(ethtypes.EmptyRootHash, k.GetStorageRoot(suite.ctx, addr))
+	updateTrie()
+
+	// rewriting the same value leaves the trie unchanged
+	setState(common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(1000)))
+	updateTrie()
+
+	// the proofs are verified against the storage root
+	for _, tc := range []struct {
+		key      common.Hash
+		expValue int64
+	}{
+		{common.BigToHash(big.NewInt(7)), 7000},
+		{common.BigToHash(big.NewInt(100)), 0},
+	} {
+		proof, err := k.GetStorageProof(suite.ctx, addr, tc.key)
+		suite.Require().NoError(err)
+		proofDB := memorydb.New()
+		for _, node := range proof {
+			suite.Require().NoError(proofDB.Put(crypto.Keccak256(node), node))
+		}
+
+		enc, err := trie.VerifyProof(k.GetStorageRoot(suite.ctx, addr), crypto.Keccak256(tc.key.Bytes()), proofDB)
+		suite.Require().NoError(err)
+		var value []byte
+		if enc != nil {
+			suite.Require().NoError(rlp.DecodeBytes(enc, &value))
+		}
+		suite.Require().Equal(tc.expValue, new(big.Int).SetBytes(value).Int64())
+	}
+
+	// updates and deletions
+	for i := int64(1); i <= 50; i++ {
+		value := common.Hash{}
+		if i%2 == 0 {
+			value = common.BigToHash(big.NewInt(i))
+		}
+		setState(common.BigToHash(big.NewInt(i)), value)
+		if i%10 == 0 {
+			updateTrie()
+		}
+	}
+
+	// the replaced trie nodes are deleted
+	_, nodes, err := expTrie.Commit(false)
+	suite.Require().NoError(err)
+	expDB := trie.NewDatabase(rawdb.NewMemoryDatabase())
+	suite.Require().NoError(expDB.Update(trie.NewWithNodeSet(nodes)))
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.StorageTriePrefix(addr))
+	for _, hash := range expDB.Nodes() {
+		suite.Require().True(store.Has(hash.Bytes()))
+	}
+	iterator := store.Iterator(nil, nil)
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	iterator.Close()
+	suite.Require().Equal(len(expDB.Nodes()), count)
+
+	// the trie is rebuilt from the storage of the account when its nodes are
+	// missing
+	root := k.GetStorageRoot(suite.ctx, addr)
+	for _, hash := range expDB.Nodes() {
+		store.Delete(hash.Bytes())
+	}
+	k.SetState(suite.ctx, addr, common.BigToHash(big.NewInt(4)), common.BigToHash(big.NewInt(4)).Bytes())
+	suite.Require().NoError(k.UpdateStorageTries(suite.ctx))
+	suite.Require().Equal(root, k.GetStorageRoot(suite.ctx, addr))
+	for _, hash := range expDB.Nodes() {
+		suite.Require().True(store.Has(hash.Bytes()))
+	}
+
+	// the storage trie is deleted along with the account, including the keys
+	// written in the block
+	setState(common.BigToHash(big.NewInt(2)), common.BigToHash(big.NewInt(3)))
+	suite.Require().NoError(k.DeleteAccount(suite.ctx, addr))
+	suite.Require().NoError(k.UpdateStorageTries(suite.ctx))
+	suite.Require().Equal(ethtypes.EmptyRootHash, k.GetStorageRoot(suite.ctx, addr))
+	iterator = store.Iterator(nil, nil)
+	defer iterator.Close()
+	suite.Require().False(iterator.Valid())
+}
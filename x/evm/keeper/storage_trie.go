@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// The storage of each account is committed to by an Ethereum storage trie, so
+// that the storage proofs can be verified by the Ethereum light clients. The
+// trie nodes are stored in the module store keyed by hash, under the prefix of
+// the account. The storage keys written in a block are tracked in the
+// transient store, and the tries are updated once at the end of the block.
+
+// GetStorageRoot returns the root hash of the storage trie of the account, it's
+// the empty root hash if the account has no storage.
+func (k Keeper) GetStorageRoot(ctx sdk.Context, addr common.Address) common.Hash {
+	bz := ctx.KVStore(k.storeKey).Get(types.StorageRootKey(addr))
+	if len(bz) == 0 {
+		return ethtypes.EmptyRootHash
+	}
+	return common.BytesToHash(bz)
+}
+
+// GetStorageProof returns the RLP encoded trie nodes proving the storage key,
+// or its absence, against the storage root of the account.
+func (k Keeper) GetStorageProof(ctx sdk.Context, addr common.Address, key common.Hash) ([][]byte, error) {
+	tr, _, err := k.openStorageTrie(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var proof proofList
+	if err := tr.Prove(crypto.Keccak256(key.Bytes()), 0, &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// UpdateStorageTries updates the storage tries of the accounts with the
+// storage keys written in the current block, and clears the written keys.
+func (k Keeper) UpdateStorageTries(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientDirtyStorage)
+	iterator := store.Iterator(nil, nil)
+
+	var (
+		addrs []common.Address
+		keys  = make(map[common.Address][]common.Hash)
+		dirty [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		addr := common.BytesToAddress(iterator.Key()[:common.AddressLength])
+		if _, ok := keys[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+		keys[addr] = append(keys[addr], common.BytesToHash(iterator.Key()[common.AddressLength:]))
+		dirty = append(dirty, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range dirty {
+		store.Delete(key)
+	}
+	for _, addr := range addrs {
+		if err := k.updateStorageTrie(ctx, addr, keys[addr]); err != nil {
+			k.Logger(ctx).Error(
+				"failed to update the storage trie, rebuilding it",
+				"address", addr.Hex(), "error", err.Error(),
+			)
+			if err := k.rebuildStorageTrie(ctx, addr); err != nil {
+				return fmt.Errorf("failed to rebuild the storage trie of %s: %w", addr, err)
+			}
+		}
+	}
+	return nil
+}
+
+// rebuildStorageTrie replaces the storage trie of the account with one built
+// from its whole storage, it's used when the trie nodes are missing from the
+// store.
+func (k Keeper) rebuildStorageTrie(ctx sdk.Context, addr common.Address) error {
+	k.deleteStorageTrie(ctx, addr)
+
+	var keys []common.Hash
+	k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return k.updateStorageTrie(ctx, addr, keys)
+}
+
+// updateStorageTrie sets the current values of the storage keys in the storage
+// trie of the account, deleting the empty ones. The new trie nodes are written
+// to the store, and the replaced ones are deleted: they are the nodes on the
+// paths of the updated keys before the update, or their children, which aren't
+// referenced by the new nodes anymore.
+func (k Keeper) updateStorageTrie(ctx sdk.Context, addr common.Address, keys []common.Hash) error {
+	tr, db, err := k.openStorageTrie(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	// the storage values are RLP encoded without the leading zeroes
+	storage := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	hashedKeys := make([][]byte, 0, len(keys))
+	values := make([][]byte, 0, len(keys))
+	var pathNodes proofList
+	for _, key := range keys {
+		hashedKey := crypto.Keccak256(key.Bytes())
+		var value []byte
+		if v := common.TrimLeftZeroes(storage.Get(key.Bytes())); len(v) != 0 {
+			if value, err = rlp.EncodeToBytes(v); err != nil {
+				return err
+			}
+		}
+
+		prev, err := tr.TryGet(hashedKey)
+		if err != nil {
+			return fmt.Errorf("failed to read the storage trie of %s: %w", addr, err)
+		}
+		// the nodes on the path of an unchanged key may still be referenced
+		if bytes.Equal(prev, value) {
+			continue
+		}
+		if err := tr.Prove(hashedKey, 0, &pathNodes); err != nil {
+			return err
+		}
+		hashedKeys = append(hashedKeys, hashedKey)
+		values = append(values, value)
+	}
+
+	for i, hashedKey := range hashedKeys {
+		if len(values[i]) == 0 {
+			err = tr.TryDelete(hashedKey)
+		} else {
+			err = tr.TryUpdate(hashedKey, values[i])
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	root, nodes, err := tr.Commit(false)
+	if err != nil {
+		return err
+	}
+	if root == ethtypes.EmptyRootHash {
+		k.deleteStorageTrie(ctx, addr)
+		return nil
+	}
+	// the trie is unchanged
+	if nodes == nil {
+		return nil
+	}
+	if err := db.Update(trie.NewWithNodeSet(nodes)); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StorageTriePrefix(addr))
+	live := make(map[common.Hash]bool)
+	for _, hash := range db.Nodes() {
+		node, err := db.Node(hash)
+		if err != nil {
+			return err
+		}
+		store.Set(hash.Bytes(), node)
+
+		live[hash] = true
+		for _, child := range nodeChildren(node) {
+			live[child] = true
+		}
+	}
+
+	for _, node := range pathNodes {
+		stale := append(nodeChildren(node), crypto.Keccak256Hash(node))
+		for _, hash := range stale {
+			if !live[hash] {
+				store.Delete(hash.Bytes())
+			}
+		}
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.StorageRootKey(addr), root.Bytes())
+	return nil
+}
+
+// deleteStorageTrie deletes the storage trie of the account.
+func (k Keeper) deleteStorageTrie(ctx sdk.Context, addr common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.StorageRootKey(addr))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.StorageTriePrefix(addr))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// openStorageTrie opens the storage trie of the account at its current root,
+// along with the trie database reading the trie nodes from the store.
+func (k Keeper) openStorageTrie(ctx sdk.Context, addr common.Address) (*trie.Trie, *trie.Database, error) {
+	db := trie.NewDatabase(storageTrieDB{
+		store: prefix.NewStore(ctx.KVStore(k.storeKey), types.StorageTriePrefix(addr)),
+	})
+	tr, err := trie.New(crypto.Keccak256Hash(addr.Bytes()), k.GetStorageRoot(ctx, addr), db)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open the storage trie of %s: %w", addr, err)
+	}
+	return tr, db, nil
+}
+
+// nodeChildren returns the hashes of the children of the RLP encoded trie
+// node, the children smaller than a hash are embedded in the node and have no
+// children stored separately.
+func nodeChildren(node []byte) []common.Hash {
+	elems, _, err := rlp.SplitList(node)
+	if err != nil {
+		return nil
+	}
+
+	var children []common.Hash
+	switch count, _ := rlp.CountValues(elems); count {
+	case 2:
+		// short node, the value of a leaf is not a child. The flag of the
+		// compact encoded key is 2 or 3 for a leaf.
+		key, rest, err := rlp.SplitString(elems)
+		if err != nil || len(key) == 0 || key[0]>>4 >= 2 {
+			return nil
+		}
+		if kind, val, _, err := rlp.Split(rest); err == nil && kind == rlp.String && len(val) == common.HashLength {
+			children = append(children, common.BytesToHash(val))
+		}
+	case 17:
+		// full node, the last element is the value
+		for i := 0; i < 16; i++ {
+			kind, val, rest, err := rlp.Split(elems)
+			if err != nil {
+				return children
+			}
+			if kind == rlp.String && len(val) == common.HashLength {
+				children = append(children, common.BytesToHash(val))
+			}
+			elems = rest
+		}
+	}
+	return children
+}
+
+// storageTrieDB is the disk database of the storage trie of an account. The
+// trie nodes are written by the keeper, so only the reads are implemented.
+type storageTrieDB struct {
+	ethdb.KeyValueStore
+	store sdk.KVStore
+}
+
+// Has implements ethdb.KeyValueReader.
+func (db storageTrieDB) Has(key []byte) (bool, error) {
+	return db.store.Has(key), nil
+}
+
+// Get implements ethdb.KeyValueReader.
+func (db storageTrieDB) Get(key []byte) ([]byte, error) {
+	bz := db.store.Get(key)
+	if bz == nil {
+		return nil, errors.New("trie node not found")
+	}
+	return bz, nil
+}
+
+// proofList collects the trie nodes of a proof, from the root to the leaf.
+type proofList [][]byte
+
+// Put implements ethdb.KeyValueWriter.
+func (p *proofList) Put(_ []byte, value []byte) error {
+	*p = append(*p, common.CopyBytes(value))
+	return nil
+}
+
+// Delete implements ethdb.KeyValueWriter.
+func (p *proofList) Delete([]byte) error {
+	panic("not supported")
+}
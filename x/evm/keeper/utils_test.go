@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/HarryBin2002/kairoschain/v12/server/config"
 	"github.com/HarryBin2002/kairoschain/v12/testutil"
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
@@ -40,6 +41,19 @@ func (suite *KeeperTestSuite) StateDB() *statedb.StateDB {
 	return statedb.New(suite.ctx, suite.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.ctx.HeaderHash().Bytes())))
 }
 
+// deductTxFee funds the sender with the fee of the tx and deducts it to the fee
+// collector, as the ante handler does before the EVM module settles it.
+func (suite *KeeperTestSuite) deductTxFee(t require.TestingT, msg *evmtypes.MsgEthereumTx) {
+	fee := msg.GetEffectiveFee(suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx))
+	if fee == nil || fee.Sign() == 0 {
+		return
+	}
+	from := common.HexToAddress(msg.From)
+	fees := sdk.Coins{sdk.NewCoin(suite.EvmDenom(), sdkmath.NewIntFromBigInt(fee))}
+	require.NoError(t, testutil.FundAccount(suite.ctx, suite.app.BankKeeper, from.Bytes(), fees))
+	require.NoError(t, suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, from))
+}
+
 // DeployTestContract deploy a test erc20 contract and returns the contract address
 func (suite *KeeperTestSuite) DeployTestContract(t require.TestingT, owner common.Address, supply *big.Int) common.Address {
 	ctx := sdk.WrapSDKContext(suite.ctx)
@@ -89,6 +103,7 @@ func (suite *KeeperTestSuite) DeployTestContract(t require.TestingT, owner commo
 	erc20DeployTx.From = suite.address.Hex()
 	err = erc20DeployTx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
 	require.NoError(t, err)
+	suite.deductTxFee(t, erc20DeployTx)
 	rsp, err := suite.app.EvmKeeper.EthereumTx(ctx, erc20DeployTx)
 	require.NoError(t, err)
 	require.Empty(t, rsp.VmError)
@@ -139,6 +154,7 @@ func (suite *KeeperTestSuite) TransferERC20Token(t require.TestingT, contractAdd
 	ercTransferTx.From = suite.address.Hex()
 	err = ercTransferTx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
 	require.NoError(t, err)
+	suite.deductTxFee(t, ercTransferTx)
 	rsp, err := suite.app.EvmKeeper.EthereumTx(ctx, ercTransferTx)
 	require.NoError(t, err)
 	require.Empty(t, rsp.VmError)
@@ -191,6 +207,7 @@ func (suite *KeeperTestSuite) DeployTestMessageCall(t require.TestingT) common.A
 	erc20DeployTx.From = suite.address.Hex()
 	err = erc20DeployTx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
 	require.NoError(t, err)
+	suite.deductTxFee(t, erc20DeployTx)
 	rsp, err := suite.app.EvmKeeper.EthereumTx(ctx, erc20DeployTx)
 	require.NoError(t, err)
 	require.Empty(t, rsp.VmError)
@@ -0,0 +1,112 @@
+package v6
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 5 to
+// version 6. Specifically, it builds the storage trie of every account from
+// its storage, storing the trie nodes and the trie root.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// the storage keys are prefixed by the account address, so the accounts are
+	// collected first to not write to the store while iterating over it
+	var addresses []common.Address
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixStorage)
+	for ; iterator.Valid(); iterator.Next() {
+		address := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixStorage) : len(types.KeyPrefixStorage)+common.AddressLength])
+		if len(addresses) == 0 || addresses[len(addresses)-1] != address {
+			addresses = append(addresses, address)
+		}
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		if err := buildStorageTrie(store, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildStorageTrie builds the storage trie of the account, the stack trie
+// requires the keys to be inserted in order so the slots are sorted by hashed
+// key first.
+func buildStorageTrie(store sdk.KVStore, address common.Address) error {
+	type slot struct {
+		hashedKey []byte
+		value     []byte
+	}
+
+	var slots []slot
+	iterator := sdk.KVStorePrefixIterator(store, types.AddressStoragePrefix(address))
+	for ; iterator.Valid(); iterator.Next() {
+		value := common.TrimLeftZeroes(iterator.Value())
+		if len(value) == 0 {
+			continue
+		}
+
+		enc, err := rlp.EncodeToBytes(value)
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		key := iterator.Key()[len(types.KeyPrefixStorage)+common.AddressLength:]
+		slots = append(slots, slot{hashedKey: crypto.Keccak256(key), value: enc})
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		return bytes.Compare(slots[i].hashedKey, slots[j].hashedKey) < 0
+	})
+
+	st := trie.NewStackTrie(nodeWriter{prefix.NewStore(store, types.StorageTriePrefix(address))})
+	for _, slot := range slots {
+		if err := st.TryUpdate(slot.hashedKey, slot.value); err != nil {
+			return err
+		}
+	}
+	root, err := st.Commit()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.StorageRootKey(address), root.Bytes())
+	return nil
+}
+
+// nodeWriter writes the trie nodes to the store.
+type nodeWriter struct {
+	store sdk.KVStore
+}
+
+// Put implements ethdb.KeyValueWriter.
+func (w nodeWriter) Put(key []byte, value []byte) error {
+	w.store.Set(common.CopyBytes(key), common.CopyBytes(value))
+	return nil
+}
+
+// Delete implements ethdb.KeyValueWriter.
+func (w nodeWriter) Delete(key []byte) error {
+	w.store.Delete(key)
+	return nil
+}
@@ -0,0 +1,68 @@
+package v6_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	v6 "github.com/HarryBin2002/kairoschain/v12/x/evm/migrations/v6"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	addresses := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+	expTries := make([]*trie.Trie, len(addresses))
+	for i, address := range addresses[:2] {
+		expTrie := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+		for j := int64(0); j < 20; j++ {
+			key := common.BigToHash(big.NewInt(j))
+			value := common.BigToHash(big.NewInt(j * int64(i+1)))
+			kvStore.Set(types.StateKey(address, key.Bytes()), value.Bytes())
+
+			// the zero values are not in the trie
+			if j == 0 {
+				continue
+			}
+			enc, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value.Bytes()))
+			require.NoError(t, err)
+			expTrie.Update(crypto.Keccak256(key.Bytes()), enc)
+		}
+		expTries[i] = expTrie
+	}
+	// an account with only zero values has no storage trie
+	kvStore.Set(types.StateKey(addresses[2], common.Hash{}.Bytes()), common.Hash{}.Bytes())
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey))
+
+	for i, address := range addresses[:2] {
+		expRoot, nodes, err := expTries[i].Commit(false)
+		require.NoError(t, err)
+		require.Equal(t, expRoot.Bytes(), kvStore.Get(types.StorageRootKey(address)))
+
+		// all the trie nodes are stored
+		expDB := trie.NewDatabase(rawdb.NewMemoryDatabase())
+		require.NoError(t, expDB.Update(trie.NewWithNodeSet(nodes)))
+		store := prefix.NewStore(kvStore, types.StorageTriePrefix(address))
+		for _, hash := range expDB.Nodes() {
+			expNode, err := expDB.Node(hash)
+			require.NoError(t, err)
+			require.Equal(t, expNode, store.Get(hash.Bytes()))
+		}
+	}
+	require.Nil(t, kvStore.Get(types.StorageRootKey(addresses[2])))
+}
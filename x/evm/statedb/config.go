@@ -44,4 +44,7 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides are the state overrides applied before the execution,
+	// only used by `eth_call` and `eth_estimateGas`.
+	Overrides types.StateOverride
 }
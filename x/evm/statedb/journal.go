@@ -122,6 +122,10 @@ type (
 		account            *common.Address
 		prevcode, prevhash []byte
 	}
+	storageResetChange struct {
+		account                                      *common.Address
+		prevfake, prevorigin, prevpending, prevdirty Storage
+	}
 
 	// Changes to other state values.
 	refundChange struct {
@@ -137,6 +141,19 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes to the transient storage
+	transientStorageChange struct {
+		account       *common.Address
+		key, prevalue common.Hash
+	}
+
+	// Changes performed by stateful precompiles
+	precompileCallChange struct {
+		layers       int
+		stateObjects map[common.Address]*stateObject
+		suicided     map[common.Address]struct{}
+	}
 )
 
 func (ch createObjectChange) Revert(s *StateDB) {
@@ -191,6 +208,18 @@ func (ch codeChange) Dirtied() *common.Address {
 	return ch.account
 }
 
+func (ch storageResetChange) Revert(s *StateDB) {
+	obj := s.getStateObject(*ch.account)
+	obj.fakeStorage = ch.prevfake
+	obj.originStorage = ch.prevorigin
+	obj.pendingStorage = ch.prevpending
+	obj.dirtyStorage = ch.prevdirty
+}
+
+func (ch storageResetChange) Dirtied() *common.Address {
+	return ch.account
+}
+
 func (ch storageChange) Revert(s *StateDB) {
 	s.getStateObject(*ch.account).setState(ch.key, ch.prevalue)
 }
@@ -239,3 +268,21 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch transientStorageChange) Revert(s *StateDB) {
+	s.setTransientState(*ch.account, ch.key, ch.prevalue)
+}
+
+func (ch transientStorageChange) Dirtied() *common.Address {
+	return nil
+}
+
+func (ch precompileCallChange) Revert(s *StateDB) {
+	s.cacheLayers = s.cacheLayers[:ch.layers]
+	s.stateObjects = ch.stateObjects
+	s.suicided = ch.suicided
+}
+
+func (ch precompileCallChange) Dirtied() *common.Address {
+	return nil
+}
@@ -0,0 +1,37 @@
+package statedb
+
+import (
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+// ApplyStateOverrides overrides the accounts of the StateDB with the given
+// nonces, codes, balances and storages, it's used by `eth_call` and
+// `eth_estimateGas` to execute a message against a modified state.
+func (s *StateDB) ApplyStateOverrides(overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			s.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			s.SetBalance(addr, (*account.Balance).ToInt())
+		}
+		// replace the entire storage
+		if account.State != nil {
+			s.SetStorage(addr, Storage(*account.State))
+		}
+		// apply the storage changes on top of the existing storage
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				s.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
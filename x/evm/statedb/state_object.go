@@ -55,8 +55,15 @@ type stateObject struct {
 	code    []byte
 
 	// state storage
+	// originStorage caches the values at the start of the transaction.
 	originStorage Storage
-	dirtyStorage  Storage
+	// pendingStorage caches the values of the latest cache context, it's only
+	// set when the object is reloaded after a stateful precompile call.
+	pendingStorage Storage
+	dirtyStorage   Storage
+	// fakeStorage replaces the committed storage when set,
+	// it's only used by the state overrides of `eth_call`.
+	fakeStorage Storage
 
 	address common.Address
 
@@ -73,13 +80,24 @@ func newObject(db *StateDB, address common.Address, account Account) *stateObjec
 	if account.CodeHash == nil {
 		account.CodeHash = emptyCodeHash
 	}
-	return &stateObject{
+	obj := &stateObject{
 		db:            db,
 		address:       address,
 		account:       account,
 		originStorage: make(Storage),
 		dirtyStorage:  make(Storage),
 	}
+	if len(db.cacheLayers) > 0 {
+		// the objects are dropped by the precompile calls, keep the values of
+		// the start of the transaction and read the current ones from the
+		// latest cache context
+		if origin, found := db.originStorages[address]; found {
+			obj.originStorage = origin
+		}
+		obj.pendingStorage = make(Storage)
+		_, obj.suicided = db.suicided[address]
+	}
+	return obj
 }
 
 // empty returns whether the account is considered empty.
@@ -139,7 +157,7 @@ func (s *stateObject) Code() []byte {
 	if bytes.Equal(s.CodeHash(), emptyCodeHash) {
 		return nil
 	}
-	code := s.db.keeper.GetCode(s.db.ctx, common.BytesToHash(s.CodeHash()))
+	code := s.db.keeper.GetCode(s.db.CacheContext(), common.BytesToHash(s.CodeHash()))
 	s.code = code
 	return code
 }
@@ -195,23 +213,45 @@ func (s *stateObject) Nonce() uint64 {
 	return s.account.Nonce
 }
 
-// GetCommittedState query the committed state
+// GetCommittedState query the committed state, which is the value at the start
+// of the transaction, even after a stateful precompile call.
 func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
-	// If no live objects are available, load it from keeper
+	if s.fakeStorage != nil {
+		value := s.fakeStorage[key]
+		s.originStorage[key] = value
+		return value
+	}
+	// If no live objects are available, load it from keeper. The precompile
+	// calls don't write to the transaction context until the commit.
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
 	return value
 }
 
+// getPendingState returns the value before the dirty changes, which is the
+// value of the latest cache context once the object has been reloaded after a
+// stateful precompile call.
+func (s *stateObject) getPendingState(key common.Hash) common.Hash {
+	if s.pendingStorage == nil {
+		return s.GetCommittedState(key)
+	}
+	if value, cached := s.pendingStorage[key]; cached {
+		return value
+	}
+	value := s.db.keeper.GetState(s.db.CacheContext(), s.Address(), key)
+	s.pendingStorage[key] = value
+	return value
+}
+
 // GetState query the current state (including dirty state)
 func (s *stateObject) GetState(key common.Hash) common.Hash {
 	if value, dirty := s.dirtyStorage[key]; dirty {
 		return value
 	}
-	return s.GetCommittedState(key)
+	return s.getPendingState(key)
 }
 
 // SetState sets the contract state
@@ -233,3 +273,23 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire storage of the account with the given one,
+// it's only meant to be used by the state overrides.
+func (s *stateObject) SetStorage(storage Storage) {
+	s.db.journal.append(storageResetChange{
+		account:     &s.address,
+		prevfake:    s.fakeStorage,
+		prevorigin:  s.originStorage,
+		prevpending: s.pendingStorage,
+		prevdirty:   s.dirtyStorage,
+	})
+	s.fakeStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.fakeStorage[key] = value
+	}
+	// drop the cached and pending values of the replaced storage
+	s.originStorage = make(Storage)
+	s.pendingStorage = nil
+	s.dirtyStorage = make(Storage)
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -22,7 +23,22 @@ type revision struct {
 	journalIndex int
 }
 
-var _ vm.StateDB = &StateDB{}
+// cacheLayer is a branch of the transaction context holding the native state
+// changes performed by a stateful precompile call.
+type cacheLayer struct {
+	ctx   sdk.Context
+	write func()
+}
+
+var (
+	_ vm.StateDB = &StateDB{}
+	_ ExtStateDB = &StateDB{}
+)
+
+// MaxPrecompileCalls is the maximum number of stateful precompile calls in a
+// transaction. Every call adds a cache layer, which makes the following reads
+// and writes of the state more expensive, so the number of layers is bounded.
+const MaxPrecompileCalls = 7
 
 // preventCommit is a flag to prevent committing state changes to the underlying storage.
 // This is used for testing purposes to simulate cases where Commit() is failed.
@@ -55,6 +71,26 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// Transient storage
+	transientStorage transientStorage
+
+	// Stack of cache contexts created by the stateful precompile calls of
+	// the current transaction, the last one being the most recent.
+	cacheLayers []cacheLayer
+
+	// Storage values at the start of the transaction of the state objects
+	// dropped by the stateful precompile calls.
+	originStorages map[common.Address]Storage
+
+	// Accounts suicided before a stateful precompile call, their state
+	// objects are dropped by the call but the accounts are only deleted when
+	// the state is committed.
+	suicided map[common.Address]struct{}
+
+	// Number of stateful precompile calls of the current transaction,
+	// including the failed and reverted ones.
+	precompileCalls int
 }
 
 // New creates a new state from a given trie.
@@ -66,6 +102,11 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		originStorages: make(map[common.Address]Storage),
+		suicided:       make(map[common.Address]struct{}),
+
+		transientStorage: newTransientStorage(),
+
 		txConfig: txConfig,
 	}
 }
@@ -80,6 +121,72 @@ func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
 }
 
+// CacheContext returns the context that holds the latest state of the
+// transaction, including the changes performed by stateful precompiles.
+func (s *StateDB) CacheContext() sdk.Context {
+	if len(s.cacheLayers) == 0 {
+		return s.ctx
+	}
+	return s.cacheLayers[len(s.cacheLayers)-1].ctx
+}
+
+// AppendJournalEntry appends a modification entry to the state journal, so that
+// it's reverted together with the rest of the EVM state changes.
+func (s *StateDB) AppendJournalEntry(entry JournalEntry) {
+	s.journal.append(entry)
+}
+
+// RunPrecompile executes the native logic of a stateful precompile against a
+// new branch of the cache context.
+//
+// The dirty EVM state is written to the branch before fn is called, so that
+// the native modules observe the balances and accounts as seen by the EVM. If fn
+// succeeds, the branch becomes the latest cache context and the live state
+// objects are dropped, so they are reloaded with the changes made by fn. Their
+// committed storage values are carried forward, as they must remain the values
+// of the start of the transaction.
+// A journal entry is appended to discard the branch and restore the previous
+// state objects when the EVM reverts to an earlier snapshot.
+//
+// An error is returned without calling fn once the transaction made
+// MaxPrecompileCalls calls.
+func (s *StateDB) RunPrecompile(fn func(ctx sdk.Context) error) error {
+	if s.precompileCalls >= MaxPrecompileCalls {
+		return errorsmod.Wrapf(types.ErrMaxPrecompileCalls, "limit of %d calls", MaxPrecompileCalls)
+	}
+	s.precompileCalls++
+
+	ctx, write := s.CacheContext().CacheContext()
+
+	if err := s.commitTo(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), false); err != nil {
+		return errorsmod.Wrap(err, "failed to write dirty state before precompile call")
+	}
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	s.journal.append(precompileCallChange{
+		layers:       len(s.cacheLayers),
+		stateObjects: s.stateObjects,
+		suicided:     s.suicided,
+	})
+	s.cacheLayers = append(s.cacheLayers, cacheLayer{ctx: ctx, write: write})
+	suicided := make(map[common.Address]struct{}, len(s.suicided))
+	for addr := range s.suicided {
+		suicided[addr] = struct{}{}
+	}
+	for addr, obj := range s.stateObjects {
+		s.originStorages[addr] = obj.originStorage
+		if obj.suicided {
+			suicided[addr] = struct{}{}
+		}
+	}
+	s.suicided = suicided
+	s.stateObjects = make(map[common.Address]*stateObject)
+	return nil
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -217,7 +324,7 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 		return obj
 	}
 	// If no live objects are available, load it from keeper
-	account := s.keeper.GetAccount(s.ctx, addr)
+	account := s.keeper.GetAccount(s.CacheContext(), addr)
 	if account == nil {
 		return nil
 	}
@@ -277,7 +384,19 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
-	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
+	if so.fakeStorage != nil {
+		for _, key := range so.fakeStorage.SortedKeys() {
+			value, dirty := so.dirtyStorage[key]
+			if !dirty {
+				value = so.fakeStorage[key]
+			}
+			if !cb(key, value) {
+				return nil
+			}
+		}
+		return nil
+	}
+	s.keeper.ForEachStorage(s.CacheContext(), addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
 		}
@@ -313,6 +432,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(new(big.Int).Set(amount))
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -337,6 +464,15 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage of account with the given one,
+// the slots absent from it read as empty.
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -419,6 +555,40 @@ func (s *StateDB) SlotInAccessList(addr common.Address, slot common.Hash) (addre
 	return s.accessList.Contains(addr, slot)
 }
 
+// SetTransientState sets transient storage for a given account. It
+// adds the change to the journal so that it can be rolled back
+// to its previous value if there is a revert.
+func (s *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := s.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+	s.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+	s.setTransientState(addr, key, value)
+}
+
+// setTransientState is a lower level setter for transient storage. It
+// is called during a revert to prevent modifications to the journal.
+func (s *StateDB) setTransientState(addr common.Address, key, value common.Hash) {
+	s.transientStorage.Set(addr, key, value)
+}
+
+// GetTransientState gets transient storage for a given account.
+func (s *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transientStorage.Get(addr, key)
+}
+
+// ClearTransientStorage discards the transient storage, it must be called at
+// the beginning of every transaction as the transient storage is scoped to the
+// transaction.
+func (s *StateDB) ClearTransientStorage() {
+	s.transientStorage = newTransientStorage()
+}
+
 // Snapshot returns an identifier for the current revision of the state.
 func (s *StateDB) Snapshot() int {
 	id := s.nextRevisionID
@@ -449,32 +619,80 @@ func (s *StateDB) Commit() error {
 	if preventCommit {
 		return fmt.Errorf("failed to commit state changes")
 	}
+	if err := s.commitTo(s.CacheContext(), true); err != nil {
+		return err
+	}
+
+	// write the precompile cache layers down to the transaction context
+	for i := len(s.cacheLayers) - 1; i >= 0; i-- {
+		s.cacheLayers[i].write()
+	}
+	s.cacheLayers = nil
+	return nil
+}
+
+// commitTo writes the dirty state objects to the given context. The suicided
+// accounts are only deleted when the state is final, as they remain readable
+// until the end of the transaction.
+func (s *StateDB) commitTo(ctx sdk.Context, final bool) error {
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
-		if obj.suicided {
-			if err := s.keeper.DeleteAccount(s.ctx, obj.Address()); err != nil {
+		if obj == nil {
+			// already written to the cache context before a precompile call
+			if _, suicided := s.suicided[addr]; final && suicided {
+				if err := s.keeper.DeleteAccount(ctx, addr); err != nil {
+					return errorsmod.Wrap(err, "failed to delete account")
+				}
+			}
+			continue
+		}
+		if obj.suicided && final {
+			if err := s.keeper.DeleteAccount(ctx, obj.Address()); err != nil {
 				return errorsmod.Wrap(err, "failed to delete account")
 			}
 		} else {
 			if obj.code != nil && obj.dirtyCode {
-				s.keeper.SetCode(s.ctx, obj.CodeHash(), obj.code)
+				s.keeper.SetCode(ctx, obj.CodeHash(), obj.code)
 			}
-			if err := s.keeper.SetAccount(s.ctx, obj.Address(), obj.account); err != nil {
+			if err := s.keeper.SetAccount(ctx, obj.Address(), obj.account); err != nil {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
+			if obj.fakeStorage != nil {
+				s.replaceStorage(ctx, obj)
+			}
 			for _, key := range obj.dirtyStorage.SortedKeys() {
 				value := obj.dirtyStorage[key]
 				// Skip noop changes, persist actual changes
-				if value == obj.originStorage[key] {
+				if value == obj.getPendingState(key) {
 					continue
 				}
-				s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
+				s.keeper.SetState(ctx, obj.Address(), key, value.Bytes())
 			}
 		}
 	}
 	return nil
 }
 
+// replaceStorage overwrites the account storage in the given context with the
+// fake storage of the state object.
+func (s *StateDB) replaceStorage(ctx sdk.Context, obj *stateObject) {
+	var keys []common.Hash
+	s.keeper.ForEachStorage(ctx, obj.Address(), func(key, _ common.Hash) bool {
+		keys = append(keys, key)
+		return true
+	})
+	for _, key := range keys {
+		s.keeper.SetState(ctx, obj.Address(), key, nil)
+	}
+	for _, key := range obj.fakeStorage.SortedKeys() {
+		value := obj.fakeStorage[key]
+		if value == (common.Hash{}) {
+			continue
+		}
+		s.keeper.SetState(ctx, obj.Address(), key, value.Bytes())
+	}
+}
+
 // ToggleStateDBPreventCommit toggles the flag to prevent committing state changes to the underlying storage.
 // This is used for testing purposes to simulate cases where Commit() is failed.
 func (s *StateDB) ToggleStateDBPreventCommit(prevent bool) {
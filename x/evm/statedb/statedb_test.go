@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -244,6 +246,48 @@ func (suite *StateDBTestSuite) TestState() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestStateOverrides() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	snapshot := db.Snapshot()
+	nonce := hexutil.Uint64(5)
+	balance := (*hexutil.Big)(big.NewInt(100))
+	state := map[common.Hash]common.Hash{key2: value2}
+	suite.Require().NoError(db.ApplyStateOverrides(types.StateOverride{
+		address: {Nonce: &nonce, Balance: &balance, State: &state},
+	}))
+	suite.Require().Equal(uint64(5), db.GetNonce(address))
+	suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(value2, db.GetCommittedState(address, key2))
+	suite.Require().Equal(statedb.Storage{key2: value2}, CollectContractStorage(db))
+
+	// the storage replacement is reverted with the snapshot
+	db.RevertToSnapshot(snapshot)
+	suite.Require().Equal(value1, db.GetState(address, key1))
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key2))
+
+	// the state diff is applied on top of the existing storage
+	suite.Require().NoError(db.ApplyStateOverrides(types.StateOverride{
+		address: {StateDiff: &state},
+	}))
+	suite.Require().Equal(value1, db.GetState(address, key1))
+	suite.Require().Equal(value2, db.GetState(address, key2))
+
+	suite.Require().Error(db.ApplyStateOverrides(types.StateOverride{
+		address: {State: &state, StateDiff: &state},
+	}))
+}
+
 func (suite *StateDBTestSuite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
@@ -463,6 +507,31 @@ func (suite *StateDBTestSuite) TestAccessList() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	value2 := common.BigToHash(big.NewInt(3))
+
+	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+	db.SetTransientState(address, key, value1)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+
+	// the transient storage is reverted with the rest of the state
+	rev := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+	db.RevertToSnapshot(rev)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	// the transient storage is never committed
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(db.Keeper().(*MockKeeper).accounts)
+
+	db.ClearTransientStorage()
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
 func (suite *StateDBTestSuite) TestLog() {
 	txHash := common.BytesToHash([]byte("tx"))
 	// use a non-default tx config
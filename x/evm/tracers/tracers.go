@@ -0,0 +1,126 @@
+// Package tracers is a registry of the native go tracers available to the
+// tracing queries of the EVM module.
+//
+// It ships the callTracer (with the onlyTopCall and withLog options), the
+// flatCallTracer, the prestateTracer (with the diffMode option), the
+// 4byteTracer and the muxTracer. Custom tracers can be plugged in by app code
+// with Register, the tracers unknown to the registry are looked up in the
+// go-ethereum tracers (e.g. the javascript tracers).
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// Constructor is the constructor signature of a named tracer, cfg is the
+// json encoded tracer config, it's nil if no config was given.
+type Constructor func(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error)
+
+var (
+	mu           sync.RWMutex
+	constructors = make(map[string]Constructor)
+)
+
+// Register makes a tracer available by the provided name, a tracer
+// registered with the same name is replaced.
+func Register(name string, ctor Constructor) {
+	if name == "" {
+		panic("tracer name cannot be empty")
+	}
+	if ctor == nil {
+		panic(fmt.Sprintf("tracer %s has a nil constructor", name))
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	constructors[name] = ctor
+}
+
+// Names returns the sorted names of the registered tracers.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(constructors))
+	for name := range constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New returns a new instance of the tracer registered by the given name,
+// falling back to the go-ethereum tracers if it's not registered.
+func New(name string, ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	mu.RLock()
+	ctor, ok := constructors[name]
+	mu.RUnlock()
+	if ok {
+		return ctor(ctx, cfg)
+	}
+	return tracers.New(name, ctx, cfg)
+}
+
+// noopTracer implements the EVMLogger methods unused by the tracers that
+// embed it.
+type noopTracer struct{}
+
+func (noopTracer) CaptureTxStart(uint64) {}
+
+func (noopTracer) CaptureTxEnd(uint64) {}
+
+func (noopTracer) CaptureStart(*vm.EVM, common.Address, common.Address, bool, []byte, uint64, *big.Int) {
+}
+
+func (noopTracer) CaptureEnd([]byte, uint64, time.Duration, error) {}
+
+func (noopTracer) CaptureEnter(vm.OpCode, common.Address, common.Address, []byte, uint64, *big.Int) {
+}
+
+func (noopTracer) CaptureExit([]byte, uint64, error) {}
+
+func (noopTracer) CaptureState(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, []byte, int, error) {
+}
+
+func (noopTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {}
+
+// memoryCopyPadded returns a copy of the memory region, padded with zeros
+// if it exceeds the current memory size.
+func memoryCopyPadded(m *vm.Memory, offset, size int64) ([]byte, error) {
+	if offset < 0 || size < 0 {
+		return nil, errors.New("offset or size must not be negative")
+	}
+	if int(offset+size) < m.Len() { // slice fully inside memory
+		return m.GetCopy(offset, size), nil
+	}
+	paddingNeeded := int(offset+size) - m.Len()
+	if paddingNeeded > 1024*1024 {
+		return nil, fmt.Errorf("reached limit for padding memory slice: %d", paddingNeeded)
+	}
+	cpy := make([]byte, size)
+	if overlap := int64(m.Len()) - offset; overlap > 0 {
+		copy(cpy, m.GetPtr(offset, overlap))
+	}
+	return cpy, nil
+}
+
+// isPrecompiled returns whether the address is one of the standard precompiles
+// active on the current block. The stateful precompiles are not included as
+// they are called like any other contract.
+func isPrecompiled(env *vm.EVM, addr common.Address) bool {
+	rules := env.ChainConfig().Rules(env.Context.BlockNumber, env.Context.Random != nil)
+	for _, p := range vm.DefaultActivePrecompiles(rules) {
+		if p == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	names := Names()
+	for _, name := range []string{CallTracer, FlatCallTracer, PrestateTracer, FourByteTracer, MuxTracer} {
+		require.Contains(t, names, name)
+	}
+
+	require.Panics(t, func() { Register("", newFourByteTracer) })
+	require.Panics(t, func() { Register("nilTracer", nil) })
+
+	var called bool
+	Register("customTracer", func(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+		called = true
+		require.JSONEq(t, `{"key":"value"}`, string(cfg))
+		return newFourByteTracer(ctx, cfg)
+	})
+	require.Contains(t, Names(), "customTracer")
+
+	tracer, err := New("customTracer", &tracers.Context{}, json.RawMessage(`{"key":"value"}`))
+	require.NoError(t, err)
+	require.NotNil(t, tracer)
+	require.True(t, called)
+
+	_, err = New("unknownTracer", &tracers.Context{}, nil)
+	require.Error(t, err)
+}
+
+func TestTracerConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfg    string
+		expErr bool
+	}{
+		{CallTracer, `{"onlyTopCall":true,"withLog":true}`, false},
+		{CallTracer, `{"withLog":"yes"}`, true},
+		{FlatCallTracer, `{"convertParityErrors":true,"includePrecompiles":true}`, false},
+		{PrestateTracer, `{"diffMode":true}`, false},
+		{PrestateTracer, `[]`, true},
+		{MuxTracer, `{"callTracer":{"withLog":true},"4byteTracer":null}`, false},
+		{MuxTracer, `{"unknownTracer":{}}`, true},
+		{MuxTracer, `{"callTracer":{"withLog":1}}`, true},
+	}
+
+	for _, tc := range testCases {
+		_, err := New(tc.name, &tracers.Context{}, json.RawMessage(tc.cfg))
+		if tc.expErr {
+			require.Error(t, err, tc.name+" "+tc.cfg)
+		} else {
+			require.NoError(t, err, tc.name+" "+tc.cfg)
+		}
+	}
+}
+
+func TestFlatFromNested(t *testing.T) {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	created := common.HexToAddress("0x3")
+	blockHash := common.HexToHash("0x10")
+	txHash := common.HexToHash("0x20")
+
+	root := callFrame{
+		Type:    vm.CALL,
+		From:    from,
+		To:      &to,
+		Gas:     100,
+		GasUsed: 50,
+		Value:   big.NewInt(0),
+		Error:   vm.ErrInvalidJump.Error(),
+		Calls: []callFrame{
+			{Type: vm.CREATE, From: to, To: &created, Gas: 40, GasUsed: 30, Value: big.NewInt(1)},
+			{Type: vm.STATICCALL, From: to, To: &created, Gas: 10, GasUsed: 5, Value: big.NewInt(0), Error: "invalid opcode: INVALID"},
+		},
+	}
+
+	flat, err := flatFromNested(&root, []int{}, true, &tracers.Context{BlockHash: blockHash, TxHash: txHash, TxIndex: 2}, 5)
+	require.NoError(t, err)
+	require.Len(t, flat, 3)
+
+	require.Equal(t, "call", flat[0].Type)
+	require.Equal(t, "call", flat[0].Action.CallType)
+	require.Equal(t, 2, flat[0].Subtraces)
+	require.Equal(t, []int{}, flat[0].TraceAddress)
+	require.Equal(t, "Bad jump destination", flat[0].Error)
+	require.Nil(t, flat[0].Result)
+	require.Equal(t, blockHash, *flat[0].BlockHash)
+	require.Equal(t, txHash, *flat[0].TransactionHash)
+	require.Equal(t, uint64(2), flat[0].TransactionPosition)
+	require.Equal(t, uint64(5), flat[0].BlockNumber)
+
+	require.Equal(t, "create", flat[1].Type)
+	require.Equal(t, []int{0}, flat[1].TraceAddress)
+	require.Equal(t, created, *flat[1].Result.Address)
+
+	require.Equal(t, "staticcall", flat[2].Action.CallType)
+	require.Equal(t, []int{1}, flat[2].TraceAddress)
+	require.Equal(t, "Bad instruction", flat[2].Error)
+
+	_, err = flatFromNested(&callFrame{Type: vm.ADD}, []int{}, false, nil, 0)
+	require.Error(t, err)
+}
+
+func TestClearFailedLogs(t *testing.T) {
+	log := callLog{Address: common.HexToAddress("0x1")}
+	root := callFrame{
+		Logs: []callLog{log},
+		Calls: []callFrame{
+			{Error: vm.ErrExecutionReverted.Error(), Logs: []callLog{log}, Calls: []callFrame{{Logs: []callLog{log}}}},
+			{Logs: []callLog{log}},
+		},
+	}
+
+	clearFailedLogs(&root, false)
+	require.Len(t, root.Logs, 1)
+	require.Nil(t, root.Calls[0].Logs)
+	require.Nil(t, root.Calls[0].Calls[0].Logs)
+	require.Len(t, root.Calls[1].Logs, 1)
+}
+
+func TestCallFrameProcessOutput(t *testing.T) {
+	to := common.HexToAddress("0x1")
+	// Error(string) with the "reason" message
+	revert := common.FromHex("0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000006726561736f6e0000000000000000000000000000000000000000000000000000")
+
+	frame := callFrame{Type: vm.CALL, To: &to}
+	frame.processOutput(revert, vm.ErrExecutionReverted)
+	require.Equal(t, vm.ErrExecutionReverted.Error(), frame.Error)
+	require.Equal(t, "reason", frame.RevertReason)
+	require.Equal(t, revert, frame.Output)
+
+	frame = callFrame{Type: vm.CREATE, To: &to}
+	frame.processOutput([]byte{1}, errors.New("out of gas"))
+	require.Nil(t, frame.To)
+	require.Nil(t, frame.Output)
+
+	frame = callFrame{Type: vm.CALL, To: &to}
+	frame.processOutput([]byte{1}, nil)
+	require.Empty(t, frame.Error)
+	require.Equal(t, []byte{1}, frame.Output)
+}
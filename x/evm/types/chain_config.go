@@ -43,6 +43,12 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	}
 }
 
+// IsMergeNetsplit returns whether the merge netsplit block of the Ethereum
+// ChainConfig is reached at the given height, it's never reached if unset.
+func IsMergeNetsplit(cfg *params.ChainConfig, height *big.Int) bool {
+	return cfg.MergeNetsplitBlock != nil && cfg.MergeNetsplitBlock.Cmp(height) <= 0
+}
+
 // DefaultChainConfig returns default evm parameters.
 func DefaultChainConfig() ChainConfig {
 	homesteadBlock := sdk.ZeroInt()
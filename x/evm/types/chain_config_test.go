@@ -1,12 +1,14 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 var defaultEIP150Hash = common.Hash{}.String()
@@ -379,3 +381,22 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestIsMergeNetsplit(t *testing.T) {
+	testCases := []struct {
+		name       string
+		mergeBlock *big.Int
+		height     int64
+		expMerge   bool
+	}{
+		{"unset", nil, 10, false},
+		{"not reached", big.NewInt(11), 10, false},
+		{"reached at the height", big.NewInt(10), 10, true},
+		{"reached before the height", big.NewInt(0), 10, true},
+	}
+
+	for _, tc := range testCases {
+		cfg := &params.ChainConfig{MergeNetsplitBlock: tc.mergeBlock}
+		require.Equal(t, tc.expMerge, IsMergeNetsplit(cfg, big.NewInt(tc.height)), tc.name)
+	}
+}
@@ -23,7 +23,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName          = "ethermint/MsgUpdateParams"
+	installPredeploysName     = "ethermint/MsgInstallPredeploys"
+	setSponsoredContractsName = "ethermint/MsgSetSponsoredContracts"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -42,6 +44,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgInstallPredeploys{},
+		&MsgSetSponsoredContracts{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -89,4 +93,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgInstallPredeploys{}, installPredeploysName, nil)
+	cdc.RegisterConcrete(&MsgSetSponsoredContracts{}, setSponsoredContractsName, nil)
 }
@@ -27,6 +27,13 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrInvalidPrecompile
+	codeErrInvalidPredeploy
+	codeErrInvalidSponsorship
+	codeErrDeployerNotAllowed
+	codeErrContractDenied
+	codeErrSenderBlocked
+	codeErrMaxPrecompileCalls
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -76,6 +83,27 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrInvalidPrecompile returns an error if an active precompile is not registered on the keeper
+	ErrInvalidPrecompile = errorsmod.Register(ModuleName, codeErrInvalidPrecompile, "invalid precompiled contract")
+
+	// ErrInvalidPredeploy returns an error if a predeploy is unknown or can't be installed
+	ErrInvalidPredeploy = errorsmod.Register(ModuleName, codeErrInvalidPredeploy, "invalid predeploy")
+
+	// ErrInvalidSponsorship returns an error if a sponsorship is invalid or doesn't cover a transaction
+	ErrInvalidSponsorship = errorsmod.Register(ModuleName, codeErrInvalidSponsorship, "invalid sponsorship")
+
+	// ErrDeployerNotAllowed returns an error if an address outside of the allowed deployers creates a contract
+	ErrDeployerNotAllowed = errorsmod.Register(ModuleName, codeErrDeployerNotAllowed, "deployer is not allowed to create contracts")
+
+	// ErrContractDenied returns an error if a denied contract is called
+	ErrContractDenied = errorsmod.Register(ModuleName, codeErrContractDenied, "contract cannot be called")
+
+	// ErrSenderBlocked returns an error if a blocked sender sends a transaction
+	ErrSenderBlocked = errorsmod.Register(ModuleName, codeErrSenderBlocked, "sender is blocked")
+
+	// ErrMaxPrecompileCalls returns an error if a transaction calls the stateful precompiles too many times
+	ErrMaxPrecompileCalls = errorsmod.Register(ModuleName, codeErrMaxPrecompileCalls, "max calls to precompiles reached")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -2,9 +2,11 @@ package types
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxLog      = "tx_log"
+	EventTypeEthereumTx  = TypeMsgEthereumTx
+	EventTypeBlockBloom  = "block_bloom"
+	EventTypeBlockRandom = "block_random"
+	EventTypeBlockRoots  = "block_roots"
+	EventTypeTxLog       = "tx_log"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -18,6 +20,9 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyBlockRandom      = "random"
+	AttributeKeyTransactionsRoot = "transactionsRoot"
+	AttributeKeyReceiptsRoot     = "receiptsRoot"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
@@ -40,6 +40,19 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// active_precompiles defines the hex addresses of the stateful precompiled
+	// contracts that are enabled on the EVM
+	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty" yaml:"active_precompiles"`
+	// allowed_deployers defines the hex addresses allowed to deploy contracts
+	// with CREATE or CREATE2, either from a transaction or from a contract. Any
+	// address can deploy contracts if empty.
+	AllowedDeployers []string `protobuf:"bytes,8,rep,name=allowed_deployers,json=allowedDeployers,proto3" json:"allowed_deployers,omitempty" yaml:"allowed_deployers"`
+	// denied_contracts defines the hex addresses of the contracts that cannot
+	// be called, either from a transaction or from a contract.
+	DeniedContracts []string `protobuf:"bytes,9,rep,name=denied_contracts,json=deniedContracts,proto3" json:"denied_contracts,omitempty" yaml:"denied_contracts"`
+	// blocked_senders defines the hex addresses that cannot send EVM
+	// transactions.
+	BlockedSenders []string `protobuf:"bytes,10,rep,name=blocked_senders,json=blockedSenders,proto3" json:"blocked_senders,omitempty" yaml:"blocked_senders"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +130,34 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetActivePrecompiles() []string {
+	if m != nil {
+		return m.ActivePrecompiles
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedDeployers() []string {
+	if m != nil {
+		return m.AllowedDeployers
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedContracts() []string {
+	if m != nil {
+		return m.DeniedContracts
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedSenders() []string {
+	if m != nil {
+		return m.BlockedSenders
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -263,6 +304,203 @@ func (m *State) GetValue() string {
 	return ""
 }
 
+// Predeploy defines a well-known contract which is installed at its canonical
+// address, with the same code and storage on every network.
+type Predeploy struct {
+	// name is the unique name of the predeploy
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the hex formatted canonical address of the contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// code is the hex formatted runtime bytecode of the contract
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// storage is the initial storage of the contract
+	Storage Storage `protobuf:"bytes,4,rep,name=storage,proto3,castrepeated=Storage" json:"storage"`
+}
+
+func (m *Predeploy) Reset()         { *m = Predeploy{} }
+func (m *Predeploy) String() string { return proto.CompactTextString(m) }
+func (*Predeploy) ProtoMessage()    {}
+func (*Predeploy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+}
+func (m *Predeploy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Predeploy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Predeploy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Predeploy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Predeploy.Merge(m, src)
+}
+func (m *Predeploy) XXX_Size() int {
+	return m.Size()
+}
+func (m *Predeploy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Predeploy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Predeploy proto.InternalMessageInfo
+
+func (m *Predeploy) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Predeploy) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Predeploy) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Predeploy) GetStorage() Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+// Sponsorship defines the contracts whose calls are paid for by a sponsor
+// account. The sponsor pays the gas of any Ethereum transaction calling one of
+// the contracts, if it is set as the fee granter of the transaction and if the
+// transaction is within the sponsorship limits.
+type Sponsorship struct {
+	// sponsor is the bech32 address of the account paying the gas
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contracts is the list of hex formatted contract addresses sponsored
+	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// limits defines the transactions paid for by the sponsor
+	Limits SponsorshipLimits `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetLimits() SponsorshipLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return SponsorshipLimits{}
+}
+
+// SponsorshipLimits defines the limits of the transactions whose gas is paid
+// for by a sponsor.
+type SponsorshipLimits struct {
+	// max_gas_per_tx is the highest gas limit of a sponsored transaction
+	MaxGasPerTx uint64 `protobuf:"varint,1,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// max_fee_per_gas is the highest effective gas price paid by the sponsor
+	MaxFeePerGas github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fee_per_gas"`
+	// max_priority_fee_per_gas is the highest effective gas tip paid by the
+	// sponsor, on top of the base fee
+	MaxPriorityFeePerGas github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_priority_fee_per_gas"`
+	// spend_limit is the amount of EVM denom the sponsor still pays for. It
+	// decreases with the gas paid for the sponsored transactions and increases
+	// with the leftover gas refunded.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=spend_limit,json=spendLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"spend_limit"`
+}
+
+func (m *SponsorshipLimits) Reset()         { *m = SponsorshipLimits{} }
+func (m *SponsorshipLimits) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipLimits) ProtoMessage()    {}
+func (*SponsorshipLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+}
+func (m *SponsorshipLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipLimits.Merge(m, src)
+}
+func (m *SponsorshipLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipLimits proto.InternalMessageInfo
+
+func (m *SponsorshipLimits) GetMaxGasPerTx() uint64 {
+	if m != nil {
+		return m.MaxGasPerTx
+	}
+	return 0
+}
+
 // Log represents an protobuf compatible Ethereum Log that defines a contract
 // log event. These events are generated by the LOG opcode and stored/indexed by
 // the node.
@@ -296,7 +534,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +638,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +698,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,6 +808,9 @@ func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
+	proto.RegisterType((*Predeploy)(nil), "ethermint.evm.v1.Predeploy")
+	proto.RegisterType((*Sponsorship)(nil), "ethermint.evm.v1.Sponsorship")
+	proto.RegisterType((*SponsorshipLimits)(nil), "ethermint.evm.v1.SponsorshipLimits")
 	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
@@ -578,99 +819,121 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5d, 0x6f, 0xdb, 0xb6,
-	0x1a, 0xce, 0x87, 0x92, 0xc8, 0xb4, 0xe3, 0x28, 0x8c, 0x9b, 0xfa, 0xb4, 0x38, 0x51, 0xa0, 0x8b,
-	0x83, 0x00, 0xa7, 0x8d, 0x9b, 0x14, 0xc1, 0x29, 0x5a, 0x9c, 0x01, 0x71, 0xe2, 0xb5, 0xc9, 0xb6,
-	0x2e, 0x60, 0x3a, 0x0c, 0x18, 0x30, 0x08, 0xb4, 0xc4, 0xda, 0x6a, 0x24, 0xd1, 0x20, 0x29, 0xd7,
-	0xde, 0xf6, 0x03, 0xb6, 0xbb, 0xfd, 0x82, 0xa1, 0x3f, 0xa7, 0xd8, 0x55, 0x2f, 0x87, 0x5d, 0x08,
-	0x43, 0x7a, 0x97, 0x4b, 0xff, 0x82, 0x41, 0x24, 0xad, 0xd8, 0x4e, 0xb0, 0xcd, 0xbe, 0xb2, 0x9e,
-	0xe7, 0x7d, 0xf9, 0x3c, 0x2f, 0x5f, 0x52, 0x26, 0x05, 0xee, 0x11, 0xd1, 0x26, 0x2c, 0x0a, 0x62,
-	0x51, 0x23, 0xdd, 0xa8, 0xd6, 0xdd, 0xcb, 0x7e, 0x76, 0x3b, 0x8c, 0x0a, 0x0a, 0xad, 0x3c, 0xb6,
-	0x9b, 0x91, 0xdd, 0xbd, 0x7b, 0x95, 0x16, 0x6d, 0x51, 0x19, 0xac, 0x65, 0x4f, 0x2a, 0xcf, 0xf9,
-	0x69, 0x11, 0x2c, 0x9f, 0x61, 0x86, 0x23, 0x0e, 0xf7, 0x40, 0x81, 0x74, 0x23, 0xd7, 0x27, 0x31,
-	0x8d, 0xaa, 0xf3, 0xdb, 0xf3, 0x3b, 0x85, 0x7a, 0x65, 0x90, 0xda, 0x56, 0x1f, 0x47, 0xe1, 0x53,
-	0x27, 0x0f, 0x39, 0xc8, 0x24, 0xdd, 0xe8, 0x38, 0x7b, 0x84, 0xff, 0x07, 0xab, 0x24, 0xc6, 0xcd,
-	0x90, 0xb8, 0x1e, 0x23, 0x58, 0x90, 0xea, 0xc2, 0xf6, 0xfc, 0x8e, 0x59, 0xaf, 0x0e, 0x52, 0xbb,
-	0xa2, 0x87, 0x8d, 0x86, 0x1d, 0x54, 0x52, 0xf8, 0x48, 0x42, 0xf8, 0x3f, 0x50, 0x1c, 0xc6, 0x71,
-	0x18, 0x56, 0x17, 0xe5, 0xe0, 0xcd, 0x41, 0x6a, 0xc3, 0xf1, 0xc1, 0x38, 0x0c, 0x1d, 0x04, 0xf4,
-	0x50, 0x1c, 0x86, 0xf0, 0x10, 0x00, 0xd2, 0x13, 0x0c, 0xbb, 0x24, 0xe8, 0xf0, 0xaa, 0xb1, 0xbd,
-	0xb8, 0xb3, 0x58, 0x77, 0x2e, 0x53, 0xbb, 0xd0, 0xc8, 0xd8, 0xc6, 0xc9, 0x19, 0x1f, 0xa4, 0xf6,
-	0xba, 0x16, 0xc9, 0x13, 0x1d, 0x54, 0x90, 0xa0, 0x11, 0x74, 0x38, 0xfc, 0x16, 0x94, 0xbc, 0x36,
-	0x0e, 0x62, 0xd7, 0xa3, 0xf1, 0xeb, 0xa0, 0x55, 0x5d, 0xda, 0x9e, 0xdf, 0x29, 0xee, 0xff, 0x7b,
-	0x77, 0xb2, 0x6f, 0xbb, 0x47, 0x59, 0xd6, 0x91, 0x4c, 0xaa, 0xdf, 0x7f, 0x9f, 0xda, 0x73, 0x83,
-	0xd4, 0xde, 0x50, 0xd2, 0xa3, 0x02, 0x0e, 0x2a, 0x7a, 0xd7, 0x99, 0x70, 0x1f, 0xdc, 0xc1, 0x61,
-	0x48, 0xdf, 0xba, 0x49, 0x9c, 0x35, 0x9a, 0x78, 0x82, 0xf8, 0xae, 0xe8, 0xf1, 0xea, 0x72, 0x36,
-	0x49, 0xb4, 0x21, 0x83, 0x5f, 0x5d, 0xc7, 0x5e, 0xf5, 0xb8, 0xf3, 0xcb, 0x3a, 0x28, 0x8e, 0xb8,
-	0xc1, 0x08, 0xac, 0xb5, 0x69, 0x44, 0xb8, 0x20, 0xd8, 0x77, 0x9b, 0x21, 0xf5, 0x2e, 0xf4, 0xb2,
-	0x1c, 0xff, 0x9e, 0xda, 0xff, 0x69, 0x05, 0xa2, 0x9d, 0x34, 0x77, 0x3d, 0x1a, 0xd5, 0x3c, 0xca,
-	0x23, 0xca, 0xf5, 0xcf, 0x43, 0xee, 0x5f, 0xd4, 0x44, 0xbf, 0x43, 0xf8, 0xee, 0x49, 0x2c, 0x06,
-	0xa9, 0xbd, 0xa9, 0x8a, 0x9d, 0x90, 0x72, 0x50, 0x39, 0x67, 0xea, 0x19, 0x01, 0xfb, 0xa0, 0xec,
-	0x63, 0xea, 0xbe, 0xa6, 0xec, 0x42, 0xbb, 0x2d, 0x48, 0xb7, 0xf3, 0x7f, 0xee, 0x76, 0x99, 0xda,
-	0xa5, 0xe3, 0xc3, 0x2f, 0x3f, 0xa5, 0xec, 0x42, 0x6a, 0x0e, 0x52, 0xfb, 0x8e, 0x72, 0x1f, 0x57,
-	0x76, 0x50, 0xc9, 0xc7, 0x34, 0x4f, 0x83, 0x5f, 0x03, 0x2b, 0x4f, 0xe0, 0x49, 0xa7, 0x43, 0x99,
-	0xd0, 0xbb, 0xe1, 0xe1, 0x65, 0x6a, 0x97, 0xb5, 0xe4, 0xb9, 0x8a, 0x0c, 0x52, 0xfb, 0xee, 0x84,
-	0xa8, 0x1e, 0xe3, 0xa0, 0xb2, 0x96, 0xd5, 0xa9, 0x90, 0x83, 0x12, 0x09, 0x3a, 0x7b, 0x07, 0x8f,
-	0xf4, 0x8c, 0x0c, 0x39, 0xa3, 0xb3, 0xa9, 0x66, 0x54, 0x6c, 0x9c, 0x9c, 0xed, 0x1d, 0x3c, 0x1a,
-	0x4e, 0x48, 0xaf, 0xfd, 0xa8, 0xac, 0x83, 0x8a, 0x0a, 0xaa, 0xd9, 0x9c, 0x00, 0x0d, 0xdd, 0x36,
-	0xe6, 0x6d, 0xb9, 0xb3, 0x0a, 0xf5, 0x9d, 0xcb, 0xd4, 0x06, 0x4a, 0xe9, 0x05, 0xe6, 0xed, 0xeb,
-	0x75, 0x69, 0xf6, 0xbf, 0xc3, 0xb1, 0x08, 0x92, 0x68, 0xa8, 0x05, 0xd4, 0xe0, 0x2c, 0x2b, 0xaf,
-	0xff, 0x40, 0xd7, 0xbf, 0x3c, 0x73, 0xfd, 0x07, 0xb7, 0xd5, 0x7f, 0x30, 0x5e, 0xbf, 0xca, 0xc9,
-	0x4d, 0x9f, 0x68, 0xd3, 0x95, 0x99, 0x4d, 0x9f, 0xdc, 0x66, 0xfa, 0x64, 0xdc, 0x54, 0xe5, 0x64,
-	0x9b, 0x7d, 0xa2, 0x13, 0x55, 0x73, 0xf6, 0xcd, 0x7e, 0xa3, 0xa9, 0xe5, 0x9c, 0x51, 0x76, 0x3f,
-	0x80, 0x8a, 0x47, 0x63, 0x2e, 0x32, 0x2e, 0xa6, 0x9d, 0x90, 0x68, 0xcf, 0x82, 0xf4, 0x3c, 0x99,
-	0xca, 0xf3, 0xbe, 0xfe, 0x37, 0xb8, 0x45, 0xcf, 0x41, 0x1b, 0xe3, 0xb4, 0x72, 0xef, 0x00, 0xab,
-	0x43, 0x04, 0x61, 0xbc, 0x99, 0xb0, 0x96, 0x76, 0x06, 0xd2, 0xb9, 0x31, 0x95, 0xb3, 0x7e, 0x0f,
-	0x26, 0xb5, 0x1c, 0xb4, 0x76, 0x4d, 0x29, 0xc7, 0x37, 0xa0, 0x1c, 0x64, 0x65, 0x34, 0x93, 0x50,
-	0xfb, 0x15, 0xa5, 0xdf, 0xd1, 0x54, 0x7e, 0xfa, 0x65, 0x1e, 0x57, 0x72, 0xd0, 0xea, 0x90, 0x50,
-	0x5e, 0x09, 0x80, 0x51, 0x12, 0x30, 0xb7, 0x15, 0x62, 0x2f, 0x20, 0x4c, 0xfb, 0x95, 0xa4, 0xdf,
-	0xf3, 0xa9, 0xfc, 0xfe, 0xa5, 0xfc, 0x6e, 0xaa, 0x39, 0xc8, 0xca, 0xc8, 0xe7, 0x8a, 0x53, 0xb6,
-	0x3e, 0x28, 0x35, 0x09, 0x0b, 0x83, 0x58, 0x1b, 0xae, 0x4a, 0xc3, 0xc3, 0xa9, 0x0c, 0xf5, 0x3e,
-	0x1d, 0xd5, 0x71, 0x50, 0x51, 0xc1, 0xdc, 0x25, 0xa4, 0xb1, 0x4f, 0x87, 0x2e, 0xeb, 0xb3, 0xbb,
-	0x8c, 0xea, 0x38, 0xa8, 0xa8, 0xa0, 0x72, 0xe9, 0x81, 0x0d, 0xcc, 0x18, 0x7d, 0x3b, 0xd1, 0x43,
-	0x28, 0xcd, 0x5e, 0x4c, 0x65, 0x76, 0x4f, 0x99, 0xdd, 0x22, 0xe7, 0xa0, 0x75, 0xc9, 0x8e, 0x75,
-	0x31, 0x01, 0xb0, 0xc5, 0x70, 0x7f, 0xc2, 0xb8, 0x32, 0xfb, 0xe2, 0xdd, 0x54, 0x73, 0x90, 0x95,
-	0x91, 0x63, 0xb6, 0xdf, 0x83, 0x4a, 0x44, 0x58, 0x8b, 0xb8, 0x31, 0x11, 0xbc, 0x13, 0x06, 0x42,
-	0x1b, 0xdf, 0x99, 0xfd, 0x7d, 0xbc, 0x4d, 0xcf, 0x41, 0x50, 0xd2, 0x2f, 0x35, 0x9b, 0xbf, 0x1c,
-	0xbc, 0x8d, 0xe3, 0x56, 0x1b, 0x07, 0xda, 0x76, 0x73, 0xf6, 0x97, 0x63, 0x5c, 0xc9, 0x41, 0xab,
-	0x43, 0x22, 0xdf, 0x3f, 0x1e, 0x8e, 0xbd, 0x64, 0xb8, 0x7f, 0xee, 0xce, 0xbe, 0x7f, 0x46, 0x75,
-	0xb2, 0xeb, 0x87, 0x84, 0xd2, 0xe5, 0xd4, 0x30, 0xcb, 0xd6, 0xda, 0xa9, 0x61, 0xae, 0x59, 0xd6,
-	0xa9, 0x61, 0x5a, 0xd6, 0xfa, 0xa9, 0x61, 0x6e, 0x58, 0x15, 0xb4, 0xda, 0xa7, 0x21, 0x75, 0xbb,
-	0x8f, 0xd5, 0x20, 0x54, 0x24, 0x6f, 0x31, 0xd7, 0xff, 0x91, 0xa8, 0xec, 0x61, 0x81, 0xc3, 0x3e,
-	0xd7, 0xad, 0x42, 0x96, 0x6a, 0xe0, 0xc8, 0xa9, 0x5d, 0x03, 0x4b, 0xe7, 0x22, 0xbb, 0xb8, 0x59,
-	0x60, 0xf1, 0x82, 0xf4, 0xd5, 0x6d, 0x04, 0x65, 0x8f, 0xb0, 0x02, 0x96, 0xba, 0x38, 0x4c, 0xd4,
-	0x0d, 0xb0, 0x80, 0x14, 0x70, 0x7e, 0x5d, 0x00, 0x8b, 0x9f, 0xd3, 0x16, 0xac, 0x82, 0x15, 0xec,
-	0xfb, 0x8c, 0x70, 0xae, 0xc7, 0x0c, 0x21, 0xdc, 0x04, 0xcb, 0x82, 0x76, 0x02, 0x8f, 0x57, 0x17,
-	0xb6, 0x17, 0x77, 0x0a, 0x48, 0x23, 0x08, 0x81, 0xe1, 0x63, 0x81, 0xe5, 0x2d, 0xa0, 0x84, 0xe4,
-	0x33, 0xdc, 0x07, 0x25, 0x59, 0x87, 0x1b, 0x27, 0x51, 0x93, 0x30, 0x79, 0x98, 0x1b, 0xf5, 0xb5,
-	0xab, 0xd4, 0x2e, 0x4a, 0xfe, 0xa5, 0xa4, 0xd1, 0x28, 0x80, 0x0f, 0xc0, 0x8a, 0xe8, 0x8d, 0x9e,
-	0xc3, 0x1b, 0x57, 0xa9, 0xbd, 0x26, 0x18, 0x8e, 0x39, 0xf6, 0x44, 0x40, 0xe3, 0xec, 0x98, 0x45,
-	0xcb, 0xa2, 0x27, 0x8f, 0xdb, 0x1a, 0x30, 0x45, 0xcf, 0x0d, 0x62, 0x9f, 0xf4, 0xe4, 0x51, 0x6b,
-	0xd4, 0x2b, 0x57, 0xa9, 0x6d, 0x8d, 0xa4, 0x9f, 0x64, 0x31, 0xb4, 0x22, 0x7a, 0xf2, 0x01, 0x3e,
-	0x00, 0x40, 0x95, 0x24, 0x1d, 0xd4, 0x41, 0xb9, 0x7a, 0x95, 0xda, 0x05, 0xc9, 0x4a, 0xed, 0xeb,
-	0x47, 0xe8, 0x80, 0x25, 0xa5, 0x6d, 0x4a, 0xed, 0xd2, 0x55, 0x6a, 0x9b, 0x21, 0x6d, 0x29, 0x4d,
-	0x15, 0xca, 0x5a, 0xc5, 0x48, 0x44, 0xbb, 0xc4, 0x97, 0x67, 0x91, 0x89, 0x86, 0xd0, 0xc1, 0xa0,
-	0x78, 0xe8, 0x79, 0x84, 0xf3, 0x57, 0x49, 0x27, 0x24, 0x7f, 0xd1, 0xd3, 0x7d, 0x50, 0xe2, 0x82,
-	0x32, 0xdc, 0x22, 0xee, 0x05, 0xe9, 0xeb, 0xce, 0xaa, 0x3e, 0x69, 0xfe, 0x33, 0xd2, 0xe7, 0x68,
-	0x14, 0x3c, 0x35, 0x7e, 0x7c, 0x67, 0xcf, 0x39, 0xef, 0x0c, 0x50, 0x7c, 0xc5, 0xb0, 0x47, 0xf4,
-	0x0d, 0x34, 0x5b, 0x9d, 0x0c, 0x32, 0x6d, 0xa1, 0x51, 0xe6, 0x2d, 0x82, 0x88, 0xd0, 0x44, 0xe8,
-	0xf5, 0x1e, 0xc2, 0x6c, 0x04, 0x23, 0xa4, 0x47, 0x3c, 0xb9, 0x72, 0x06, 0xd2, 0x08, 0x1e, 0x80,
-	0x55, 0x3f, 0xe0, 0xf2, 0x3a, 0xcf, 0x05, 0xf6, 0x2e, 0xe4, 0x6a, 0x98, 0x75, 0xeb, 0x2a, 0xb5,
-	0x4b, 0x3a, 0x70, 0x9e, 0xf1, 0x68, 0x0c, 0xc1, 0x67, 0x60, 0xed, 0x7a, 0x98, 0xac, 0x56, 0x5d,
-	0xa0, 0xeb, 0xf0, 0x2a, 0xb5, 0xcb, 0x79, 0xaa, 0x8c, 0xa0, 0x09, 0x9c, 0xed, 0x49, 0x9f, 0x34,
-	0x93, 0x96, 0x6c, 0xb7, 0x89, 0x14, 0xc8, 0xd8, 0x30, 0x88, 0x02, 0x21, 0xdb, 0xbb, 0x84, 0x14,
-	0x80, 0xcf, 0x40, 0x81, 0x76, 0x09, 0x63, 0x81, 0x4f, 0xb8, 0x3c, 0x8a, 0xff, 0xee, 0x5b, 0x00,
-	0x5d, 0xe7, 0x67, 0x93, 0xd3, 0x9f, 0x2a, 0x11, 0x89, 0x28, 0xeb, 0xcb, 0xb3, 0x55, 0x4f, 0x4e,
-	0x05, 0xbe, 0x90, 0x3c, 0x1a, 0x43, 0xb0, 0x0e, 0xa0, 0x1e, 0xc6, 0x88, 0x48, 0x58, 0xec, 0xca,
-	0x1d, 0x5f, 0x92, 0x63, 0xe5, 0xbe, 0x53, 0x51, 0x24, 0x83, 0xc7, 0x58, 0x60, 0x74, 0x83, 0x81,
-	0x9f, 0x00, 0xa8, 0xd6, 0xc4, 0x7d, 0xc3, 0x69, 0xfe, 0x31, 0xa3, 0x8e, 0x3e, 0xe9, 0xaf, 0xa2,
-	0xba, 0x66, 0x4b, 0xa1, 0x53, 0x4e, 0xf5, 0x2c, 0x4e, 0x0d, 0xd3, 0xb0, 0x96, 0x4e, 0x0d, 0x73,
-	0xc5, 0x32, 0xf3, 0xfe, 0xe9, 0x59, 0xa0, 0x8d, 0x21, 0x1e, 0x29, 0xaf, 0xde, 0x78, 0x7f, 0xb9,
-	0x35, 0xff, 0xe1, 0x72, 0x6b, 0xfe, 0x8f, 0xcb, 0xad, 0xf9, 0x9f, 0x3f, 0x6e, 0xcd, 0x7d, 0xf8,
-	0xb8, 0x35, 0xf7, 0xdb, 0xc7, 0xad, 0xb9, 0x6f, 0xfe, 0x3b, 0xf2, 0xff, 0xd5, 0xe0, 0x1e, 0x8e,
-	0xeb, 0x8d, 0x1a, 0xe9, 0xea, 0x0f, 0xd4, 0xee, 0xde, 0x7e, 0xad, 0x27, 0x3f, 0x53, 0xe5, 0x1f,
-	0x59, 0x73, 0x59, 0x7e, 0x7e, 0x3e, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x73, 0x9e, 0x51, 0x24,
-	0xc4, 0x0e, 0x00, 0x00,
+	// 1810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0xb7, 0x6c, 0xd9, 0x96, 0x86, 0xb2, 0x44, 0x8f, 0x95, 0x44, 0x4d, 0x76, 0x4d, 0x83, 0x0b,
+	0x14, 0x3e, 0xec, 0x5a, 0xb1, 0x03, 0xa3, 0xc1, 0x2e, 0x5a, 0xc0, 0xb4, 0x9d, 0xc4, 0x6e, 0x76,
+	0xd7, 0x18, 0x67, 0x51, 0xa0, 0x40, 0x41, 0x8c, 0xc8, 0x89, 0xc4, 0x35, 0xc9, 0x21, 0x66, 0x46,
+	0x8a, 0xd4, 0xf6, 0x03, 0xec, 0xb1, 0xfd, 0x02, 0xc5, 0x9e, 0x7b, 0xe9, 0xd7, 0x08, 0x7a, 0xca,
+	0xb1, 0xe8, 0x81, 0x2d, 0x9c, 0x9b, 0x8f, 0xfa, 0x04, 0xc5, 0xfc, 0xd1, 0x5f, 0x1b, 0x45, 0xac,
+	0x93, 0xf8, 0x7e, 0xef, 0xcd, 0xef, 0xf7, 0xe6, 0xf1, 0x0d, 0xf5, 0x48, 0xf0, 0x98, 0x88, 0x0e,
+	0x61, 0x49, 0x94, 0x8a, 0x26, 0xe9, 0x25, 0xcd, 0xde, 0xbe, 0xfc, 0xd9, 0xcb, 0x18, 0x15, 0x14,
+	0xda, 0x63, 0xdf, 0x9e, 0x04, 0x7b, 0xfb, 0x8f, 0xeb, 0x6d, 0xda, 0xa6, 0xca, 0xd9, 0x94, 0x57,
+	0x3a, 0xce, 0xfd, 0xc7, 0x2a, 0x58, 0xbb, 0xc0, 0x0c, 0x27, 0x1c, 0xee, 0x83, 0x32, 0xe9, 0x25,
+	0x7e, 0x48, 0x52, 0x9a, 0x34, 0x0a, 0x3b, 0x85, 0xdd, 0xb2, 0x57, 0x1f, 0xe6, 0x8e, 0x3d, 0xc0,
+	0x49, 0xfc, 0xb5, 0x3b, 0x76, 0xb9, 0xa8, 0x44, 0x7a, 0xc9, 0x89, 0xbc, 0x84, 0xbf, 0x06, 0x1b,
+	0x24, 0xc5, 0xad, 0x98, 0xf8, 0x01, 0x23, 0x58, 0x90, 0xc6, 0xf2, 0x4e, 0x61, 0xb7, 0xe4, 0x35,
+	0x86, 0xb9, 0x53, 0x37, 0xcb, 0xa6, 0xdd, 0x2e, 0xaa, 0x68, 0xfb, 0x58, 0x99, 0xf0, 0x57, 0xc0,
+	0x1a, 0xf9, 0x71, 0x1c, 0x37, 0x56, 0xd4, 0xe2, 0x87, 0xc3, 0xdc, 0x81, 0xb3, 0x8b, 0x71, 0x1c,
+	0xbb, 0x08, 0x98, 0xa5, 0x38, 0x8e, 0xe1, 0x11, 0x00, 0xa4, 0x2f, 0x18, 0xf6, 0x49, 0x94, 0xf1,
+	0x46, 0x71, 0x67, 0x65, 0x77, 0xc5, 0x73, 0xaf, 0x73, 0xa7, 0x7c, 0x2a, 0xd1, 0xd3, 0xb3, 0x0b,
+	0x3e, 0xcc, 0x9d, 0x4d, 0x43, 0x32, 0x0e, 0x74, 0x51, 0x59, 0x19, 0xa7, 0x51, 0xc6, 0xe1, 0x1f,
+	0x40, 0x25, 0xe8, 0xe0, 0x28, 0xf5, 0x03, 0x9a, 0xbe, 0x8d, 0xda, 0x8d, 0xd5, 0x9d, 0xc2, 0xae,
+	0x75, 0xf0, 0xf9, 0xde, 0x7c, 0xdd, 0xf6, 0x8e, 0x65, 0xd4, 0xb1, 0x0a, 0xf2, 0x9e, 0xbc, 0xcf,
+	0x9d, 0xa5, 0x61, 0xee, 0x6c, 0x69, 0xea, 0x69, 0x02, 0x17, 0x59, 0xc1, 0x24, 0x12, 0x1e, 0x80,
+	0x07, 0x38, 0x8e, 0xe9, 0x3b, 0xbf, 0x9b, 0xca, 0x42, 0x93, 0x40, 0x90, 0xd0, 0x17, 0x7d, 0xde,
+	0x58, 0x93, 0x9b, 0x44, 0x5b, 0xca, 0xf9, 0xc3, 0xc4, 0xf7, 0xa6, 0xcf, 0xe1, 0x6b, 0x00, 0x71,
+	0x20, 0xa2, 0x1e, 0xf1, 0x33, 0x46, 0x02, 0x9a, 0x64, 0x51, 0x4c, 0x78, 0x63, 0x7d, 0x67, 0x65,
+	0xb7, 0xec, 0x7d, 0x3e, 0xcc, 0x9d, 0x5f, 0x68, 0xd5, 0xdb, 0x31, 0x2e, 0xda, 0xd4, 0xe0, 0xc5,
+	0x04, 0x83, 0x67, 0x60, 0x53, 0x89, 0x90, 0xd0, 0x0f, 0x49, 0x16, 0xd3, 0x01, 0x61, 0xbc, 0x51,
+	0x52, 0x64, 0x9f, 0x0d, 0x73, 0xa7, 0x61, 0xc8, 0xe6, 0x43, 0x5c, 0x64, 0x1b, 0xec, 0x64, 0x04,
+	0xc1, 0x17, 0xc0, 0x0e, 0x49, 0x1a, 0x91, 0x50, 0xee, 0x55, 0x30, 0x1c, 0x08, 0xde, 0x28, 0x2b,
+	0xa6, 0x27, 0xc3, 0xdc, 0x79, 0xa4, 0x99, 0xe6, 0x23, 0x5c, 0x54, 0xd3, 0xd0, 0xf1, 0x08, 0x81,
+	0xc7, 0xa0, 0xd6, 0x8a, 0x69, 0x70, 0x45, 0x42, 0x9f, 0x93, 0x34, 0x94, 0x09, 0x01, 0x45, 0xf3,
+	0x78, 0x98, 0x3b, 0x0f, 0x35, 0xcd, 0x5c, 0x80, 0x8b, 0xaa, 0x06, 0xb9, 0x34, 0xc0, 0xdf, 0x36,
+	0x81, 0x35, 0x75, 0x4f, 0x60, 0x02, 0x6a, 0x1d, 0x9a, 0x10, 0x2e, 0x08, 0x0e, 0x7d, 0x15, 0x6b,
+	0x9a, 0xf7, 0xe4, 0xdf, 0xb9, 0xf3, 0xcb, 0x76, 0x24, 0x3a, 0xdd, 0xd6, 0x5e, 0x40, 0x93, 0x66,
+	0x40, 0x79, 0x42, 0xb9, 0xf9, 0xf9, 0x8a, 0x87, 0x57, 0x4d, 0x31, 0xc8, 0x08, 0xdf, 0x3b, 0x4b,
+	0xc5, 0x44, 0x7e, 0x8e, 0xca, 0x45, 0xd5, 0x31, 0xe2, 0x49, 0x00, 0x0e, 0x40, 0x35, 0xc4, 0xd4,
+	0x7f, 0x4b, 0xd9, 0x95, 0x51, 0x5b, 0x56, 0x6a, 0x97, 0x9f, 0xae, 0x76, 0x9d, 0x3b, 0x95, 0x93,
+	0xa3, 0xef, 0x5f, 0x50, 0x76, 0xa5, 0x38, 0x87, 0xb9, 0xf3, 0xc0, 0xd4, 0x70, 0x86, 0xd9, 0x45,
+	0x95, 0x10, 0xd3, 0x71, 0x18, 0xfc, 0x1d, 0xb0, 0xc7, 0x01, 0xbc, 0x9b, 0x65, 0x94, 0x09, 0x73,
+	0x66, 0xbe, 0xba, 0xce, 0x9d, 0xaa, 0xa1, 0xbc, 0xd4, 0x9e, 0xa9, 0x1b, 0x33, 0xb7, 0xc6, 0x45,
+	0x55, 0x43, 0x6b, 0x42, 0x21, 0x07, 0x15, 0x12, 0x65, 0xfb, 0x87, 0x4f, 0xcd, 0x8e, 0x8a, 0x6a,
+	0x47, 0x17, 0xf7, 0xda, 0x91, 0x75, 0x7a, 0x76, 0xb1, 0x7f, 0xf8, 0x74, 0xb4, 0x21, 0x73, 0x42,
+	0xa6, 0x69, 0x5d, 0x64, 0x69, 0x53, 0xef, 0xe6, 0x0c, 0x18, 0xd3, 0xef, 0x60, 0xde, 0x51, 0xe7,
+	0xaf, 0xec, 0xed, 0x5e, 0xe7, 0x0e, 0xd0, 0x4c, 0xaf, 0x30, 0xef, 0x4c, 0xb5, 0xc5, 0xe0, 0x8f,
+	0x38, 0x15, 0x51, 0x37, 0x19, 0x71, 0x01, 0xbd, 0x58, 0x46, 0x8d, 0xf3, 0x3f, 0x34, 0xf9, 0xaf,
+	0x2d, 0x9c, 0xff, 0xe1, 0x5d, 0xf9, 0x1f, 0xce, 0xe6, 0xaf, 0x63, 0xc6, 0xa2, 0xcf, 0x8d, 0xe8,
+	0xfa, 0xc2, 0xa2, 0xcf, 0xef, 0x12, 0x7d, 0x3e, 0x2b, 0xaa, 0x63, 0x64, 0xb3, 0xcf, 0x55, 0xa2,
+	0x51, 0x5a, 0xbc, 0xd9, 0x6f, 0x15, 0xb5, 0x3a, 0x46, 0xb4, 0xdc, 0x9f, 0x41, 0x3d, 0xa0, 0x29,
+	0x17, 0x12, 0x4b, 0x69, 0x16, 0x13, 0xa3, 0x59, 0x56, 0x9a, 0x67, 0xf7, 0xd2, 0x7c, 0x62, 0x9e,
+	0x99, 0x77, 0xf0, 0xb9, 0x68, 0x6b, 0x16, 0xd6, 0xea, 0x19, 0xb0, 0x33, 0x22, 0x08, 0xe3, 0xad,
+	0x2e, 0x6b, 0x1b, 0x65, 0xa0, 0x94, 0x4f, 0xef, 0xa5, 0x6c, 0xce, 0xc1, 0x3c, 0x97, 0x8b, 0x6a,
+	0x13, 0x48, 0x2b, 0xfe, 0x08, 0xaa, 0x91, 0x4c, 0xa3, 0xd5, 0x8d, 0x8d, 0x9e, 0xa5, 0xf4, 0x8e,
+	0xef, 0xa5, 0x67, 0x0e, 0xf3, 0x2c, 0x93, 0x8b, 0x36, 0x46, 0x80, 0xd6, 0xea, 0x02, 0x98, 0x74,
+	0x23, 0xe6, 0xb7, 0x63, 0x1c, 0x44, 0x84, 0x19, 0xbd, 0x8a, 0xd2, 0x7b, 0x79, 0x2f, 0x3d, 0xf3,
+	0xbf, 0x70, 0x9b, 0xcd, 0x45, 0xb6, 0x04, 0x5f, 0x6a, 0x4c, 0xcb, 0x86, 0xa0, 0xd2, 0x22, 0x2c,
+	0x8e, 0x52, 0x23, 0xb8, 0xa1, 0x04, 0x8f, 0xee, 0x25, 0x68, 0xfa, 0x74, 0x9a, 0xc7, 0x45, 0x96,
+	0x36, 0xc7, 0x2a, 0x31, 0x4d, 0x43, 0x3a, 0x52, 0xd9, 0x5c, 0x5c, 0x65, 0x9a, 0xc7, 0x45, 0x96,
+	0x36, 0xb5, 0x4a, 0x1f, 0x6c, 0x61, 0xc6, 0xe8, 0xbb, 0xb9, 0x1a, 0x42, 0x25, 0xf6, 0xea, 0x5e,
+	0x62, 0x8f, 0xcd, 0xdf, 0xe1, 0x6d, 0x3a, 0xf9, 0xe7, 0x2a, 0xd1, 0x99, 0x2a, 0x76, 0x01, 0x6c,
+	0x33, 0x3c, 0x98, 0x13, 0xae, 0x2f, 0x7e, 0xf3, 0x6e, 0xb3, 0xb9, 0xc8, 0x96, 0xe0, 0x8c, 0xec,
+	0x9f, 0x40, 0x3d, 0x21, 0xac, 0x4d, 0xfc, 0x94, 0x08, 0x9e, 0xc5, 0x91, 0x30, 0xc2, 0x0f, 0x16,
+	0x3f, 0x8f, 0x77, 0xf1, 0xb9, 0x08, 0x2a, 0xf8, 0x3b, 0x83, 0x8e, 0x0f, 0x07, 0xef, 0xe0, 0xb4,
+	0xdd, 0xc1, 0x91, 0x91, 0x7d, 0xb8, 0xf8, 0xe1, 0x98, 0x65, 0x72, 0xd1, 0xc6, 0x08, 0x18, 0xf7,
+	0x4f, 0x80, 0xd3, 0xa0, 0x3b, 0xea, 0x9f, 0x47, 0x8b, 0xf7, 0xcf, 0x34, 0x8f, 0x1c, 0xd2, 0x94,
+	0xa9, 0x54, 0xce, 0x8b, 0xa5, 0xaa, 0x5d, 0x3b, 0x2f, 0x96, 0x6a, 0xb6, 0x7d, 0x5e, 0x2c, 0xd9,
+	0xf6, 0xe6, 0x79, 0xb1, 0xb4, 0x65, 0xd7, 0xd1, 0xc6, 0x80, 0xc6, 0xd4, 0xef, 0x3d, 0xd3, 0x8b,
+	0x90, 0x45, 0xde, 0x61, 0x6e, 0x9e, 0x91, 0xa8, 0x1a, 0x60, 0x81, 0xe3, 0x01, 0x37, 0xa5, 0x42,
+	0xb6, 0x2e, 0xe0, 0xd4, 0xbf, 0x76, 0x13, 0xac, 0x5e, 0x0a, 0x39, 0xde, 0xda, 0x60, 0xe5, 0x8a,
+	0x0c, 0xf4, 0x34, 0x82, 0xe4, 0x25, 0xac, 0x83, 0xd5, 0x1e, 0x8e, 0xbb, 0x7a, 0x4e, 0x2e, 0x23,
+	0x6d, 0xb8, 0x7f, 0x2d, 0x80, 0xf2, 0x05, 0x23, 0x7a, 0x04, 0x83, 0x10, 0x14, 0x53, 0x9c, 0x10,
+	0xb3, 0x4c, 0x5d, 0xc3, 0x06, 0x58, 0xc7, 0x61, 0xc8, 0x08, 0xe7, 0x66, 0xe5, 0xc8, 0x94, 0xd1,
+	0x01, 0x0d, 0x89, 0x9a, 0x03, 0xca, 0x48, 0x5d, 0x43, 0x0f, 0xac, 0x73, 0x41, 0x19, 0x6e, 0x13,
+	0x35, 0x1a, 0x5b, 0x07, 0x8f, 0x6e, 0x4f, 0xb5, 0x2a, 0x43, 0xaf, 0x26, 0xe7, 0xd9, 0xbf, 0xff,
+	0xc7, 0x59, 0xbf, 0xd4, 0xf1, 0x68, 0xb4, 0xd0, 0xfd, 0xa9, 0x00, 0xac, 0xcb, 0x8c, 0xa6, 0x9c,
+	0x32, 0xde, 0x89, 0x32, 0x99, 0x01, 0xd7, 0xa6, 0x49, 0x6c, 0x64, 0xc2, 0xcf, 0x40, 0x79, 0x32,
+	0x15, 0x2e, 0xcb, 0x71, 0x0e, 0x4d, 0x00, 0x78, 0x04, 0xd6, 0xe2, 0x28, 0x89, 0x04, 0x57, 0x19,
+	0x5a, 0x07, 0x5f, 0xdc, 0x91, 0xca, 0x44, 0xe6, 0xb5, 0x0a, 0xf5, 0x8a, 0x32, 0x2d, 0x64, 0x16,
+	0xba, 0xef, 0x97, 0xc1, 0xe6, 0xad, 0x18, 0xf8, 0x05, 0xa8, 0x26, 0xb8, 0xef, 0xb7, 0x31, 0xf7,
+	0x33, 0xc2, 0x7c, 0xd1, 0x57, 0x79, 0x15, 0x91, 0x95, 0xe0, 0xfe, 0x4b, 0xcc, 0x2f, 0x08, 0x7b,
+	0xd3, 0x87, 0x3f, 0x80, 0x9a, 0x0c, 0x7a, 0x4b, 0x88, 0x0a, 0x6a, 0x63, 0x53, 0x3f, 0x6f, 0x4f,
+	0x2a, 0x7c, 0x7a, 0x37, 0xa1, 0x4a, 0x82, 0xfb, 0x2f, 0x08, 0xb9, 0x20, 0xec, 0x25, 0xe6, 0xf0,
+	0x2d, 0x68, 0x48, 0xda, 0x8c, 0x45, 0x94, 0x45, 0x62, 0x30, 0xc3, 0xbf, 0xb2, 0x10, 0x7f, 0x3d,
+	0xc1, 0xfd, 0x0b, 0x43, 0x37, 0xd1, 0xf9, 0x1e, 0x58, 0x3c, 0x23, 0x69, 0xe8, 0xab, 0x4a, 0x34,
+	0x8a, 0x0b, 0x51, 0x03, 0x45, 0xa1, 0xaa, 0xe6, 0xfe, 0x73, 0x19, 0xac, 0xbc, 0xa6, 0xed, 0xe9,
+	0x7e, 0x2a, 0xcc, 0xf6, 0xd3, 0x43, 0xb0, 0x26, 0x68, 0x16, 0x05, 0xa3, 0x5b, 0x69, 0x2c, 0xd9,
+	0x67, 0x21, 0x16, 0x58, 0x6d, 0xaf, 0x82, 0xd4, 0x35, 0x3c, 0x00, 0x15, 0xd5, 0xf1, 0x7e, 0xda,
+	0x4d, 0x5a, 0x84, 0xa9, 0xfc, 0x8a, 0x5e, 0xed, 0x26, 0x77, 0x2c, 0x85, 0x7f, 0xa7, 0x60, 0x34,
+	0x6d, 0xc0, 0x2f, 0xc1, 0xba, 0xe8, 0x4f, 0x4f, 0x7c, 0x5b, 0x37, 0xb9, 0x53, 0x13, 0x0c, 0xa7,
+	0x5c, 0xbe, 0xc2, 0xd0, 0x54, 0x0e, 0x74, 0x68, 0x4d, 0xf4, 0xe5, 0x2f, 0x6c, 0x82, 0x92, 0xe8,
+	0xfb, 0x51, 0x1a, 0x92, 0xbe, 0x1a, 0xea, 0x8a, 0x5e, 0xfd, 0x26, 0x77, 0xec, 0xa9, 0xf0, 0x33,
+	0xe9, 0x43, 0xeb, 0xa2, 0xaf, 0x2e, 0xe0, 0x97, 0x00, 0xe8, 0x94, 0x94, 0x82, 0x1e, 0xc9, 0x36,
+	0x6e, 0x72, 0xa7, 0xac, 0x50, 0xc5, 0x3d, 0xb9, 0x84, 0x2e, 0x58, 0xd5, 0xdc, 0x25, 0xc5, 0x5d,
+	0xb9, 0xc9, 0x9d, 0x52, 0x4c, 0xdb, 0x9a, 0x53, 0xbb, 0x64, 0xa9, 0x18, 0x49, 0x68, 0x8f, 0x84,
+	0x6a, 0xea, 0x29, 0xa1, 0x91, 0xe9, 0x62, 0x60, 0x1d, 0x05, 0x01, 0xe1, 0xfc, 0x4d, 0x37, 0x8b,
+	0xc9, 0xff, 0xa9, 0xe9, 0x01, 0xa8, 0x98, 0x63, 0xe5, 0x5f, 0x91, 0x81, 0xa9, 0xac, 0xae, 0x93,
+	0xc1, 0x7f, 0x4b, 0x06, 0x1c, 0x4d, 0x1b, 0x5f, 0x17, 0x7f, 0xfa, 0xd9, 0x59, 0x72, 0x7f, 0x2e,
+	0x02, 0xeb, 0x0d, 0xc3, 0x01, 0x31, 0xef, 0x3a, 0xf2, 0xee, 0x48, 0x73, 0x74, 0x08, 0x8d, 0x25,
+	0xb5, 0x45, 0x94, 0x10, 0xda, 0x15, 0xa3, 0xe7, 0x83, 0x31, 0xe5, 0x0a, 0x46, 0x48, 0x9f, 0x04,
+	0xea, 0xce, 0x15, 0x91, 0xb1, 0xe0, 0x21, 0xd8, 0x08, 0x23, 0xae, 0x5e, 0xaf, 0xb9, 0xc0, 0xc1,
+	0x95, 0xba, 0x1b, 0x25, 0xcf, 0xbe, 0xc9, 0x9d, 0x8a, 0x71, 0x5c, 0x4a, 0x1c, 0xcd, 0x58, 0xf0,
+	0x1b, 0x50, 0x9b, 0x2c, 0xd3, 0x8f, 0x18, 0xf5, 0x42, 0xeb, 0xc1, 0x9b, 0xdc, 0xa9, 0x8e, 0x43,
+	0x95, 0x07, 0xcd, 0xd9, 0xf2, 0xe9, 0x17, 0x92, 0x56, 0xb7, 0xad, 0xca, 0x5d, 0x42, 0xda, 0x90,
+	0xa8, 0x6e, 0x6f, 0x59, 0xde, 0x55, 0xa4, 0x0d, 0xf8, 0x0d, 0x28, 0xd3, 0x1e, 0x61, 0x2c, 0x0a,
+	0x09, 0x6f, 0x80, 0x4f, 0x78, 0x37, 0x47, 0x93, 0x78, 0xb9, 0x39, 0xf3, 0xe9, 0x20, 0x21, 0x09,
+	0x65, 0x83, 0x86, 0x35, 0xd9, 0x9c, 0x76, 0x7c, 0xab, 0x70, 0x34, 0x63, 0x41, 0x0f, 0x40, 0xb3,
+	0x8c, 0x11, 0xd1, 0x65, 0xa9, 0xaf, 0x3a, 0xbe, 0xa2, 0xd6, 0xaa, 0xbe, 0xd3, 0x5e, 0xa4, 0x9c,
+	0x27, 0x58, 0x60, 0x74, 0x0b, 0x81, 0xbf, 0x01, 0x50, 0xdf, 0x13, 0xff, 0x47, 0x4e, 0xc7, 0x1f,
+	0x17, 0xf4, 0x90, 0xa5, 0xf4, 0xb5, 0xd7, 0xe4, 0x6c, 0x6b, 0xeb, 0x9c, 0x53, 0xb3, 0x8b, 0xf3,
+	0x62, 0xa9, 0x68, 0xaf, 0x9e, 0x17, 0x4b, 0xeb, 0x76, 0x69, 0x5c, 0x3f, 0xb3, 0x0b, 0xb4, 0x35,
+	0xb2, 0xa7, 0xd2, 0xf3, 0xbe, 0x7d, 0x7f, 0xbd, 0x5d, 0xf8, 0x70, 0xbd, 0x5d, 0xf8, 0xef, 0xf5,
+	0x76, 0xe1, 0x2f, 0x1f, 0xb7, 0x97, 0x3e, 0x7c, 0xdc, 0x5e, 0xfa, 0xd7, 0xc7, 0xed, 0xa5, 0xdf,
+	0x3f, 0x9b, 0x7a, 0x40, 0xbc, 0xc2, 0x8c, 0x0d, 0xbc, 0x28, 0x3d, 0x78, 0xfa, 0xf4, 0xa0, 0x79,
+	0x85, 0x23, 0x46, 0xb9, 0xfa, 0x5a, 0xd1, 0xec, 0xed, 0x1f, 0x34, 0xfb, 0xea, 0xf3, 0x91, 0x7a,
+	0x62, 0xb4, 0xd6, 0xd4, 0x67, 0xa1, 0x67, 0xff, 0x1b, 0x00, 0x91, 0x22, 0xed, 0x4f, 0x5c, 0x12,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -693,6 +956,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedSenders) > 0 {
+		for iNdEx := len(m.BlockedSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedSenders[iNdEx])
+			copy(dAtA[i:], m.BlockedSenders[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DeniedContracts) > 0 {
+		for iNdEx := len(m.DeniedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedContracts[iNdEx])
+			copy(dAtA[i:], m.DeniedContracts[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DeniedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AllowedDeployers) > 0 {
+		for iNdEx := len(m.AllowedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDeployers[iNdEx])
+			copy(dAtA[i:], m.AllowedDeployers[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedDeployers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ActivePrecompiles) > 0 {
+		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivePrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActivePrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActivePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1055,7 +1354,7 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Log) Marshal() (dAtA []byte, err error) {
+func (m *Predeploy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1065,82 +1364,55 @@ func (m *Log) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+func (m *Predeploy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Predeploy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Removed {
-		i--
-		if m.Removed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Index != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x40
 	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TxIndex != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Data)))
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Code)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Topics[iNdEx])
-			copy(dAtA[i:], m.Topics[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.Topics[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
 		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1150,36 +1422,46 @@ func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccessTuple) MarshalTo(dAtA []byte) (int, error) {
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StorageKeys) > 0 {
-		for iNdEx := len(m.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.StorageKeys[iNdEx])
-			copy(dAtA[i:], m.StorageKeys[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.StorageKeys[iNdEx])))
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Contracts[iNdEx])))
 			i--
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Sponsor)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
+func (m *SponsorshipLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1189,44 +1471,226 @@ func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TraceConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *SponsorshipLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SponsorshipLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TracerJsonConfig) > 0 {
-		i -= len(m.TracerJsonConfig)
-		copy(dAtA[i:], m.TracerJsonConfig)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.TracerJsonConfig)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.EnableReturnData {
-		i--
-		if m.EnableReturnData {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x60
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	if m.EnableMemory {
-		i--
-		if m.EnableMemory {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxPriorityFeePerGas.Size()
+		i -= size
+		if _, err := m.MaxPriorityFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x58
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	if m.Overrides != nil {
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxFeePerGas.Size()
+		i -= size
+		if _, err := m.MaxFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Log) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Index != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageKeys) > 0 {
+		for iNdEx := len(m.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageKeys[iNdEx])
+			copy(dAtA[i:], m.StorageKeys[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.StorageKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TracerJsonConfig) > 0 {
+		i -= len(m.TracerJsonConfig)
+		copy(dAtA[i:], m.TracerJsonConfig)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TracerJsonConfig)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.EnableReturnData {
+		i--
+		if m.EnableReturnData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EnableMemory {
+		i--
+		if m.EnableMemory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Overrides != nil {
 		{
 			size, err := m.Overrides.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -1334,6 +1798,30 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if len(m.ActivePrecompiles) > 0 {
+		for _, s := range m.ActivePrecompiles {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.AllowedDeployers) > 0 {
+		for _, s := range m.AllowedDeployers {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.DeniedContracts) > 0 {
+		for _, s := range m.DeniedContracts {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.BlockedSenders) > 0 {
+		for _, s := range m.BlockedSenders {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1438,6 +1926,72 @@ func (m *State) Size() (n int) {
 	return n
 }
 
+func (m *Predeploy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = m.Limits.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *SponsorshipLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovEvm(uint64(m.MaxGasPerTx))
+	}
+	l = m.MaxFeePerGas.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.MaxPriorityFeePerGas.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
 func (m *Log) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1782,12 +2336,140 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDeployers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDeployers = append(m.AllowedDeployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedContracts = append(m.DeniedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedSenders = append(m.BlockedSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
@@ -2631,6 +3313,504 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Predeploy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Predeploy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Predeploy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsorshipLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorshipLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorshipLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriorityFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriorityFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Log) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -45,5 +45,20 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	if err := ValidatePredeployNames(gs.Predeploys); err != nil {
+		return err
+	}
+
+	seenSponsors := make(map[string]bool)
+	for _, sponsorship := range gs.Sponsorships {
+		if seenSponsors[sponsorship.Sponsor] {
+			return fmt.Errorf("duplicated sponsorship for %s", sponsorship.Sponsor)
+		}
+		if err := sponsorship.Validate(); err != nil {
+			return fmt.Errorf("invalid sponsorship for %s: %w", sponsorship.Sponsor, err)
+		}
+		seenSponsors[sponsorship.Sponsor] = true
+	}
+
 	return gs.Params.Validate()
 }
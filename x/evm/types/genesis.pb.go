@@ -29,6 +29,10 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// predeploys defines the names of the well-known predeploys to install.
+	Predeploys []string `protobuf:"bytes,3,rep,name=predeploys,proto3" json:"predeploys,omitempty"`
+	// sponsorships defines the contracts sponsored by each sponsor account.
+	Sponsorships []Sponsorship `protobuf:"bytes,4,rep,name=sponsorships,proto3" json:"sponsorships"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +82,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPredeploys() []string {
+	if m != nil {
+		return m.Predeploys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,27 +170,30 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4a, 0xc3, 0x40,
-	0x1c, 0xce, 0xd9, 0xd2, 0xda, 0xab, 0xa8, 0x1c, 0x82, 0xa1, 0xc3, 0xb5, 0x74, 0x2a, 0x08, 0x77,
-	0xb4, 0x82, 0xbb, 0x07, 0xc5, 0x55, 0xd2, 0xcd, 0xed, 0x9a, 0xfe, 0x48, 0x3b, 0x24, 0x17, 0x72,
-	0xd7, 0x43, 0x57, 0x47, 0x27, 0x9f, 0xc3, 0x27, 0xe9, 0xd8, 0xd1, 0x49, 0x25, 0x79, 0x11, 0xc9,
-	0x25, 0x2d, 0x68, 0xb6, 0x2f, 0xf9, 0xfe, 0xfd, 0xee, 0xc3, 0x14, 0xcc, 0x1a, 0xb2, 0x78, 0x93,
-	0x18, 0x0e, 0x36, 0xe6, 0x76, 0xca, 0x23, 0x48, 0x40, 0x6f, 0x34, 0x4b, 0x33, 0x65, 0x14, 0xb9,
-	0x3c, 0xf2, 0x0c, 0x6c, 0xcc, 0xec, 0x74, 0x30, 0x68, 0x38, 0x4a, 0xc2, 0xa9, 0x07, 0x57, 0x91,
-	0x8a, 0x94, 0x83, 0xbc, 0x44, 0xd5, 0xdf, 0xf1, 0x1b, 0xc2, 0x67, 0x0f, 0x55, 0xea, 0xc2, 0x48,
-	0x03, 0x44, 0xe0, 0x53, 0x19, 0x86, 0x6a, 0x9b, 0x18, 0xed, 0xa3, 0x51, 0x6b, 0xd2, 0x9f, 0x8d,
-	0xd8, 0xff, 0x1e, 0x56, 0x3b, 0xee, 0x2b, 0xa1, 0x68, 0xef, 0xbe, 0x86, 0x5e, 0x70, 0xf4, 0x91,
-	0x3b, 0xdc, 0x49, 0x65, 0x26, 0x63, 0xed, 0x9f, 0x8c, 0xd0, 0xa4, 0x3f, 0xf3, 0x9b, 0x09, 0x8f,
-	0x8e, 0xaf, 0x9d, 0xb5, 0x7a, 0xfc, 0x8a, 0xf0, 0xf9, 0xdf, 0x68, 0xe2, 0xe3, 0xae, 0x5c, 0xad,
-	0x32, 0xd0, 0xe5, 0x35, 0x68, 0xd2, 0x0b, 0x0e, 0x9f, 0x84, 0xe0, 0x76, 0xa8, 0x56, 0xe0, 0x2a,
-	0x7a, 0x81, 0xc3, 0x44, 0xe0, 0xae, 0x36, 0x2a, 0x93, 0x11, 0xf8, 0x2d, 0x77, 0xfb, 0x75, 0xb3,
-	0xd9, 0x3d, 0x53, 0x5c, 0x94, 0xc5, 0x1f, 0xdf, 0xc3, 0xee, 0xa2, 0xd2, 0x07, 0x07, 0xa3, 0x98,
-	0xef, 0x72, 0x8a, 0xf6, 0x39, 0x45, 0x3f, 0x39, 0x45, 0xef, 0x05, 0xf5, 0xf6, 0x05, 0xf5, 0x3e,
-	0x0b, 0xea, 0x3d, 0xdd, 0x44, 0x1b, 0xb3, 0xde, 0x2e, 0x59, 0xa8, 0x62, 0x3e, 0xd7, 0xa1, 0x4c,
-	0xc4, 0x9c, 0x83, 0xad, 0xf7, 0xb6, 0xd3, 0x19, 0x7f, 0x76, 0xab, 0x9b, 0x97, 0x14, 0xf4, 0xb2,
-	0xe3, 0xf6, 0xbd, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x81, 0xc8, 0xaa, 0xc5, 0x01, 0x00,
-	0x00,
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
+	0x10, 0xc7, 0xbb, 0x1f, 0x04, 0x3e, 0x16, 0xf2, 0x7d, 0x66, 0x63, 0x62, 0x43, 0xe2, 0xd2, 0x70,
+	0xea, 0xa9, 0x0b, 0x25, 0xf1, 0x6e, 0x2f, 0x78, 0x31, 0x31, 0xe5, 0xe6, 0x6d, 0x69, 0x37, 0x6d,
+	0xa3, 0xed, 0x36, 0xbb, 0x4b, 0x23, 0x57, 0x9f, 0xc0, 0xe7, 0xf0, 0x49, 0x38, 0x72, 0xf4, 0xa4,
+	0x06, 0x1e, 0xc1, 0x17, 0x30, 0xdd, 0x16, 0x14, 0xf1, 0x36, 0x3b, 0xf3, 0xff, 0xcd, 0xcc, 0x7f,
+	0x07, 0x62, 0xa6, 0x62, 0x26, 0xd2, 0x24, 0x53, 0x84, 0x15, 0x29, 0x29, 0xc6, 0x24, 0x62, 0x19,
+	0x93, 0x89, 0x74, 0x72, 0xc1, 0x15, 0x47, 0x27, 0xfb, 0xba, 0xc3, 0x8a, 0xd4, 0x29, 0xc6, 0xfd,
+	0xfe, 0x11, 0x51, 0x16, 0xb4, 0xba, 0x7f, 0x1a, 0xf1, 0x88, 0xeb, 0x90, 0x94, 0x51, 0x95, 0x1d,
+	0x7e, 0x00, 0xd8, 0x9b, 0x56, 0x5d, 0x67, 0x8a, 0x2a, 0x86, 0x3c, 0xf8, 0x97, 0x06, 0x01, 0x5f,
+	0x64, 0x4a, 0x9a, 0xc0, 0x6a, 0xd8, 0x5d, 0xd7, 0x72, 0x7e, 0xce, 0x71, 0x6a, 0xe2, 0xb2, 0x12,
+	0x7a, 0xcd, 0xd5, 0xeb, 0xc0, 0xf0, 0xf7, 0x1c, 0xba, 0x80, 0xad, 0x9c, 0x0a, 0x9a, 0x4a, 0xf3,
+	0x8f, 0x05, 0xec, 0xae, 0x6b, 0x1e, 0x77, 0xb8, 0xd1, 0xf5, 0x9a, 0xac, 0xd5, 0x08, 0x43, 0x98,
+	0x0b, 0x16, 0xb2, 0xfc, 0x9e, 0x2f, 0xa5, 0xd9, 0xb0, 0x1a, 0x76, 0xc7, 0xff, 0x96, 0x41, 0x53,
+	0xd8, 0x93, 0x39, 0xcf, 0x24, 0x17, 0x32, 0x4e, 0x72, 0x69, 0x36, 0xf5, 0x7e, 0xe7, 0xc7, 0xdd,
+	0x67, 0x5f, 0xaa, 0x7a, 0xc4, 0x01, 0x38, 0x7c, 0x04, 0xf0, 0xdf, 0xa1, 0x07, 0x64, 0xc2, 0x36,
+	0x0d, 0x43, 0xc1, 0x64, 0x69, 0x1b, 0xd8, 0x1d, 0x7f, 0xf7, 0x44, 0x08, 0x36, 0x03, 0x1e, 0x32,
+	0xed, 0xa5, 0xe3, 0xeb, 0x18, 0x79, 0xb0, 0x2d, 0x15, 0x17, 0x34, 0x62, 0x7a, 0xcd, 0xae, 0x7b,
+	0xf6, 0xcb, 0x12, 0xe5, 0x7f, 0x7a, 0xff, 0xcb, 0xf1, 0xcf, 0x6f, 0x83, 0xf6, 0xac, 0xd2, 0xfb,
+	0x3b, 0xd0, 0xbb, 0x5e, 0x6d, 0x30, 0x58, 0x6f, 0x30, 0x78, 0xdf, 0x60, 0xf0, 0xb4, 0xc5, 0xc6,
+	0x7a, 0x8b, 0x8d, 0x97, 0x2d, 0x36, 0x6e, 0x27, 0x51, 0xa2, 0xe2, 0xc5, 0xdc, 0x09, 0x78, 0x4a,
+	0xae, 0xa8, 0x10, 0x4b, 0x2f, 0xc9, 0xdc, 0xd1, 0xc8, 0x25, 0x77, 0x34, 0x11, 0x5c, 0x06, 0x31,
+	0x4d, 0x32, 0x52, 0x8c, 0x5d, 0xf2, 0xa0, 0xcf, 0xac, 0x96, 0x39, 0x93, 0xf3, 0x96, 0x3e, 0xe8,
+	0xe4, 0x73, 0x00, 0x67, 0xde, 0x39, 0xf3, 0x36, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +216,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Predeploys) > 0 {
+		for iNdEx := len(m.Predeploys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predeploys[iNdEx])
+			copy(dAtA[i:], m.Predeploys[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Predeploys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -298,6 +342,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Predeploys) > 0 {
+		for _, s := range m.Predeploys {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -426,6 +482,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predeploys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predeploys = append(m.Predeploys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
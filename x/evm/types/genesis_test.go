@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -83,6 +85,8 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	limits := NewSponsorshipLimits(1000000, sdkmath.NewInt(1000000000000), sdkmath.NewInt(1000000000), sdkmath.NewInt(1000000000000000000))
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -110,6 +114,71 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: true,
 		},
+		{
+			name: "valid predeploys",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				Predeploys: []string{PredeployCreate2Deployer, PredeploySafeSingletonFactory},
+			},
+			expPass: true,
+		},
+		{
+			name: "unknown predeploy",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				Predeploys: []string{"multicall"},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated predeploy",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				Predeploys: []string{PredeployCreate2Deployer, PredeployCreate2Deployer},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid sponsorships",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Sponsorships: []Sponsorship{
+					NewSponsorship(sdk.AccAddress(common.HexToAddress(suite.address).Bytes()), []common.Address{common.HexToAddress(suite.address)}, limits),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "duplicated sponsorship",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Sponsorships: []Sponsorship{
+					NewSponsorship(sdk.AccAddress(common.HexToAddress(suite.address).Bytes()), nil, limits),
+					NewSponsorship(sdk.AccAddress(common.HexToAddress(suite.address).Bytes()), nil, limits),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid sponsored contract",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Sponsorships: []Sponsorship{
+					NewSponsorship(sdk.AccAddress(common.HexToAddress(suite.address).Bytes()), []common.Address{{}}, limits),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid sponsorship limits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Sponsorships: []Sponsorship{
+					NewSponsorship(sdk.AccAddress(common.HexToAddress(suite.address).Bytes()), []common.Address{common.HexToAddress(suite.address)}, SponsorshipLimits{}),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name:     "empty genesis",
 			genState: &GenesisState{},
@@ -7,11 +7,14 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	feemarkettypes "github.com/HarryBin2002/kairoschain/v12/x/feemarket/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 )
 
 // AccountKeeper defines the expected account keeper interface
@@ -48,6 +51,14 @@ type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	CalculateBaseFee(ctx sdk.Context) *big.Int
+	BurnBaseFee(ctx sdk.Context, baseFeePaid, feesLeft sdk.Coins) (sdk.Coins, error)
+}
+
+// FeeGrantKeeper defines the expected fee grant keeper interface, used to give
+// the refunded gas back to the fee allowance of a sponsored transaction.
+type FeeGrantKeeper interface {
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	UpdateAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
 }
 
 // Event Hooks
@@ -56,7 +67,16 @@ type FeeMarketKeeper interface {
 // EvmHooks event hooks for evm tx processing
 type EvmHooks interface {
 	// Must be called after tx is processed successfully, if return an error, the whole transaction is reverted.
-	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
+	// It returns the fees paid out of the fee collector by the hook, e.g. the developer revenue.
+	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) (sdk.Coins, error)
+}
+
+// DynamicPrecompiles resolves the stateful precompiled contracts that are not
+// registered on the EVM keeper but instantiated on demand from the chain state.
+type DynamicPrecompiles interface {
+	// GetDynamicPrecompile returns the precompiled contract deployed at the given
+	// address, if any.
+	GetDynamicPrecompile(ctx sdk.Context, address common.Address) (vm.PrecompiledContract, bool)
 }
 
 type (
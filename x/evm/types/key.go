@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -26,6 +28,13 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixBlockRandom
+	prefixStorageRoot
+	prefixStorageTrie
+	prefixBlockRoots
+	prefixPredeploy
+	prefixSponsoredContract
+	prefixSponsorshipLimits
 )
 
 // prefix bytes for the EVM transient store
@@ -34,6 +43,10 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientTx
+	prefixTransientReceipt
+	prefixTransientTxSponsor
+	prefixTransientDirtyStorage
 )
 
 // KVStore key prefixes
@@ -41,6 +54,20 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+	// KeyPrefixBlockRandom is the prefix of the random values of the recent blocks
+	KeyPrefixBlockRandom = []byte{prefixBlockRandom}
+	// KeyPrefixStorageRoot is the prefix of the storage trie roots of the accounts
+	KeyPrefixStorageRoot = []byte{prefixStorageRoot}
+	// KeyPrefixStorageTrie is the prefix of the storage trie nodes of the accounts
+	KeyPrefixStorageTrie = []byte{prefixStorageTrie}
+	// KeyPrefixBlockRoots is the key of the transactions and receipts roots of the last block
+	KeyPrefixBlockRoots = []byte{prefixBlockRoots}
+	// KeyPrefixPredeploy is the prefix of the installed predeploys, keyed by name
+	KeyPrefixPredeploy = []byte{prefixPredeploy}
+	// KeyPrefixSponsoredContract is the prefix of the contracts sponsored by an account
+	KeyPrefixSponsoredContract = []byte{prefixSponsoredContract}
+	// KeyPrefixSponsorshipLimits is the prefix of the sponsorship limits of an account
+	KeyPrefixSponsorshipLimits = []byte{prefixSponsorshipLimits}
 )
 
 // Transient Store key prefixes
@@ -49,6 +76,12 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientTx      = []byte{prefixTransientTx}
+	KeyPrefixTransientReceipt = []byte{prefixTransientReceipt}
+	// KeyPrefixTransientTxSponsor is the prefix of the sponsors paying the gas of the transactions
+	KeyPrefixTransientTxSponsor = []byte{prefixTransientTxSponsor}
+	// KeyPrefixTransientDirtyStorage is the prefix of the storage keys written in the block
+	KeyPrefixTransientDirtyStorage = []byte{prefixTransientDirtyStorage}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -60,3 +93,39 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// StorageRootKey defines the key under which the storage trie root of an
+// account is stored.
+func StorageRootKey(address common.Address) []byte {
+	return append(KeyPrefixStorageRoot, address.Bytes()...)
+}
+
+// StorageTriePrefix returns a prefix to iterate over the storage trie nodes of
+// a given account, the nodes are keyed by hash.
+func StorageTriePrefix(address common.Address) []byte {
+	return append(KeyPrefixStorageTrie, address.Bytes()...)
+}
+
+// DirtyStorageKey defines the key under which a storage key of an account
+// written in the current block is stored.
+func DirtyStorageKey(address common.Address, key common.Hash) []byte {
+	return append(append(KeyPrefixTransientDirtyStorage, address.Bytes()...), key.Bytes()...)
+}
+
+// SponsoredContractsPrefix returns a prefix to iterate over the contracts
+// sponsored by an account.
+func SponsoredContractsPrefix(sponsor sdk.AccAddress) []byte {
+	return append(KeyPrefixSponsoredContract, address.MustLengthPrefix(sponsor)...)
+}
+
+// SponsorshipLimitsKey defines the key under which the sponsorship limits of an
+// account are stored.
+func SponsorshipLimitsKey(sponsor sdk.AccAddress) []byte {
+	return append(KeyPrefixSponsorshipLimits, address.MustLengthPrefix(sponsor)...)
+}
+
+// TxSponsorKey defines the key under which the sponsor of the transaction sent
+// by an account with a given nonce is stored.
+func TxSponsorKey(from common.Address, nonce uint64) []byte {
+	return append(append(KeyPrefixTransientTxSponsor, from.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
@@ -29,6 +29,8 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgInstallPredeploys{}
+	_ sdk.Msg    = &MsgSetSponsoredContracts{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -392,3 +394,57 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgInstallPredeploys message.
+func (m MsgInstallPredeploys) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgInstallPredeploys) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Names) == 0 {
+		return errorsmod.Wrap(ErrInvalidPredeploy, "predeploy names cannot be empty")
+	}
+
+	return ValidatePredeployNames(m.Names)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgInstallPredeploys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetSponsoredContracts message.
+func (m MsgSetSponsoredContracts) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sponsor)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetSponsoredContracts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	if err := ValidateSponsoredContracts(m.Contracts); err != nil {
+		return err
+	}
+
+	// the limits are ignored when removing the sponsorship
+	if len(m.Contracts) == 0 {
+		return nil
+	}
+	return m.Limits.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetSponsoredContracts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
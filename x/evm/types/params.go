@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"github.com/HarryBin2002/kairoschain/v12/constants"
 	"math/big"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -22,6 +25,8 @@ var (
 	DefaultEnableCall = true
 	// DefaultExtraEIPs defines the list of all EIPs that are enabled by default
 	DefaultExtraEIPs = []int64{3855}
+	// DefaultActivePrecompiles defines the stateful precompiles that are enabled by default (i.e none)
+	DefaultActivePrecompiles []string
 )
 
 // NewParams creates a new Params instance
@@ -46,6 +51,7 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           DefaultExtraEIPs,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		ActivePrecompiles:   DefaultActivePrecompiles,
 	}
 }
 
@@ -71,6 +77,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
+	if err := validateAddresses(p.AllowedDeployers); err != nil {
+		return fmt.Errorf("invalid allowed deployers: %w", err)
+	}
+
+	if err := validateAddresses(p.DeniedContracts); err != nil {
+		return fmt.Errorf("invalid denied contracts: %w", err)
+	}
+
+	if err := validateAddresses(p.BlockedSenders); err != nil {
+		return fmt.Errorf("invalid blocked senders: %w", err)
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -83,6 +105,91 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// IsActivePrecompile returns true if the given precompile address is
+// registered as an active precompile.
+func (p Params) IsActivePrecompile(address string) bool {
+	for _, precompile := range p.ActivePrecompiles {
+		if strings.EqualFold(precompile, address) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActivePrecompileAddresses returns the active precompiles as Ethereum addresses
+func (p Params) ActivePrecompileAddresses() []common.Address {
+	addresses := make([]common.Address, len(p.ActivePrecompiles))
+	for i, precompile := range p.ActivePrecompiles {
+		addresses[i] = common.HexToAddress(precompile)
+	}
+	return addresses
+}
+
+// AccessLists holds the allowed deployers, the denied contracts and the blocked
+// senders of the params parsed into address sets.
+type AccessLists struct {
+	allowedDeployers map[common.Address]struct{}
+	deniedContracts  map[common.Address]struct{}
+	blockedSenders   map[common.Address]struct{}
+}
+
+// AccessLists parses the access lists of the params, so that the addresses can
+// be looked up repeatedly without parsing them again.
+func (p Params) AccessLists() AccessLists {
+	return AccessLists{
+		allowedDeployers: newAddressSet(p.AllowedDeployers),
+		deniedContracts:  newAddressSet(p.DeniedContracts),
+		blockedSenders:   newAddressSet(p.BlockedSenders),
+	}
+}
+
+// IsDeployerAllowed returns true if the address can deploy contracts, which is
+// the case of any address if no allowed deployer is set.
+func (al AccessLists) IsDeployerAllowed(address common.Address) bool {
+	_, found := al.allowedDeployers[address]
+	return len(al.allowedDeployers) == 0 || found
+}
+
+// IsContractDenied returns true if the address is a contract that cannot be
+// called.
+func (al AccessLists) IsContractDenied(address common.Address) bool {
+	_, found := al.deniedContracts[address]
+	return found
+}
+
+// IsSenderBlocked returns true if the address cannot send transactions.
+func (al AccessLists) IsSenderBlocked(address common.Address) bool {
+	_, found := al.blockedSenders[address]
+	return found
+}
+
+// ValidateMessage checks that the sender of a message isn't blocked, that it is
+// allowed to deploy a contract if the message has no recipient and that the
+// recipient isn't a denied contract otherwise.
+func (al AccessLists) ValidateMessage(from common.Address, to *common.Address) error {
+	if al.IsSenderBlocked(from) {
+		return errorsmod.Wrapf(ErrSenderBlocked, "address %s", from)
+	}
+
+	if to == nil {
+		if !al.IsDeployerAllowed(from) {
+			return errorsmod.Wrapf(ErrDeployerNotAllowed, "address %s", from)
+		}
+	} else if al.IsContractDenied(*to) {
+		return errorsmod.Wrapf(ErrContractDenied, "address %s", *to)
+	}
+
+	return nil
+}
+
+func newAddressSet(addresses []string) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		set[common.HexToAddress(addr)] = struct{}{}
+	}
+	return set
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -115,6 +222,58 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+// ValidatePrecompiles checks that the precompile addresses are valid, non-zero
+// hex addresses without duplicates.
+func ValidatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid precompile slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(precompiles))
+	for _, precompile := range precompiles {
+		if !common.IsHexAddress(precompile) {
+			return fmt.Errorf("invalid precompile address %s", precompile)
+		}
+
+		address := common.HexToAddress(precompile)
+		if address == (common.Address{}) {
+			return fmt.Errorf("precompile cannot be the zero address")
+		}
+
+		if seen[address] {
+			return fmt.Errorf("duplicate precompile %s", precompile)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// validateAddresses checks that the addresses are valid hex addresses without
+// duplicates.
+func validateAddresses(i interface{}) error {
+	addresses, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid address slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(addresses))
+	for _, addr := range addresses {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid address %s", addr)
+		}
+
+		address := common.HexToAddress(addr)
+		if seen[address] {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
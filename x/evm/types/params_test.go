@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,80 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid active precompiles",
+			Params{
+				EvmDenom:          "stake",
+				ChainConfig:       DefaultChainConfig(),
+				ActivePrecompiles: []string{"0x0000000000000000000000000000000000000800", "0x0000000000000000000000000000000000000801"},
+			},
+			false,
+		},
+		{
+			"invalid active precompile address",
+			Params{
+				EvmDenom:          "stake",
+				ChainConfig:       DefaultChainConfig(),
+				ActivePrecompiles: []string{"0x800"},
+			},
+			true,
+		},
+		{
+			"zero active precompile address",
+			Params{
+				EvmDenom:          "stake",
+				ChainConfig:       DefaultChainConfig(),
+				ActivePrecompiles: []string{"0x0000000000000000000000000000000000000000"},
+			},
+			true,
+		},
+		{
+			"duplicate active precompiles",
+			Params{
+				EvmDenom:          "stake",
+				ChainConfig:       DefaultChainConfig(),
+				ActivePrecompiles: []string{"0x0000000000000000000000000000000000000800", "0x0000000000000000000000000000000000000800"},
+			},
+			true,
+		},
+		{
+			"valid access control",
+			Params{
+				EvmDenom:         "stake",
+				ChainConfig:      DefaultChainConfig(),
+				AllowedDeployers: []string{"0x0000000000000000000000000000000000000001"},
+				DeniedContracts:  []string{"0x0000000000000000000000000000000000000002"},
+				BlockedSenders:   []string{"0x0000000000000000000000000000000000000003"},
+			},
+			false,
+		},
+		{
+			"invalid allowed deployer address",
+			Params{
+				EvmDenom:         "stake",
+				ChainConfig:      DefaultChainConfig(),
+				AllowedDeployers: []string{"0x1"},
+			},
+			true,
+		},
+		{
+			"duplicate denied contracts",
+			Params{
+				EvmDenom:        "stake",
+				ChainConfig:     DefaultChainConfig(),
+				DeniedContracts: []string{"0x0000000000000000000000000000000000000002", "0x0000000000000000000000000000000000000002"},
+			},
+			true,
+		},
+		{
+			"invalid blocked sender address",
+			Params{
+				EvmDenom:       "stake",
+				ChainConfig:    DefaultChainConfig(),
+				BlockedSenders: []string{"kairos1"},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -62,6 +137,55 @@ func TestParamsEIPs(t *testing.T) {
 	require.Equal(t, []int{2929, 1884, 1344}, actual)
 }
 
+func TestParamsIsActivePrecompile(t *testing.T) {
+	params := DefaultParams()
+	params.ActivePrecompiles = []string{"0x0000000000000000000000000000000000000800"}
+
+	require.True(t, params.IsActivePrecompile("0x0000000000000000000000000000000000000800"))
+	require.False(t, params.IsActivePrecompile("0x0000000000000000000000000000000000000801"))
+	require.Equal(t, []common.Address{common.HexToAddress("0x800")}, params.ActivePrecompileAddresses())
+}
+
+func TestAccessListsValidateMessage(t *testing.T) {
+	deployer := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	blocked := common.HexToAddress("0x0000000000000000000000000000000000000003")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000004")
+
+	accessLists := DefaultParams().AccessLists()
+	require.True(t, accessLists.IsDeployerAllowed(other))
+	require.NoError(t, accessLists.ValidateMessage(other, nil))
+	require.NoError(t, accessLists.ValidateMessage(other, &contract))
+
+	params := DefaultParams()
+	params.AllowedDeployers = []string{deployer.Hex()}
+	params.DeniedContracts = []string{contract.Hex()}
+	params.BlockedSenders = []string{blocked.Hex()}
+	accessLists = params.AccessLists()
+
+	testCases := []struct {
+		name   string
+		from   common.Address
+		to     *common.Address
+		expErr error
+	}{
+		{"allowed deployer", deployer, nil, nil},
+		{"not allowed deployer", other, nil, ErrDeployerNotAllowed},
+		{"call", other, &deployer, nil},
+		{"call to denied contract", deployer, &contract, ErrContractDenied},
+		{"blocked sender", blocked, &deployer, ErrSenderBlocked},
+	}
+
+	for _, tc := range testCases {
+		err := accessLists.ValidateMessage(tc.from, tc.to)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateEVMDenom(false))
 	require.NoError(t, validateEVMDenom("inj"))
@@ -0,0 +1,177 @@
+package types
+
+import (
+	_ "embed" // embed the predeploy bytecodes
+	"fmt"
+	"math/big"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/HarryBin2002/kairoschain/v12/types"
+)
+
+const (
+	// PredeployCreate2Deployer is the deterministic deployment proxy, which
+	// deploys the init code of the call data with CREATE2 and the salt of the
+	// first 32 bytes.
+	PredeployCreate2Deployer = "create2_deployer"
+	// PredeploySafeSingletonFactory is the factory deploying the Safe
+	// singletons, it runs the same code as the deterministic deployment proxy.
+	PredeploySafeSingletonFactory = "safe_singleton_factory"
+	// PredeployMulticall3 aggregates the results of multiple contract calls.
+	PredeployMulticall3 = "multicall3"
+	// PredeployPermit2 is the Uniswap signature-based token approval contract.
+	PredeployPermit2 = "permit2"
+	// PredeployEntryPointV06 is the ERC-4337 EntryPoint v0.6, the singleton
+	// executing the bundles of user operations.
+	PredeployEntryPointV06 = "entrypoint_v06"
+	// PredeploySenderCreatorV06 is the helper deploying the accounts of the
+	// user operations on behalf of the EntryPoint v0.6.
+	PredeploySenderCreatorV06 = "sender_creator_v06"
+)
+
+// deterministicDeploymentProxyCode is the runtime code of the deterministic
+// deployment proxy, see https://github.com/Arachnid/deterministic-deployment-proxy
+const deterministicDeploymentProxyCode = "7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3"
+
+// The runtime codes of the predeploys deployed by a constructor are the ones
+// deployed on Ethereum mainnet, as preinstalled by the OP Stack.
+var (
+	//go:embed predeploys/multicall3.hex
+	multicall3Code string
+	//go:embed predeploys/permit2.hex
+	permit2Code string
+	//go:embed predeploys/entrypoint_v06.hex
+	entryPointV06Code string
+	//go:embed predeploys/sender_creator_v06.hex
+	senderCreatorV06Code string
+)
+
+// The Permit2 runtime code holds the chain ID and the EIP-712 domain separator
+// cached by its constructor, at these offsets.
+const (
+	permit2ChainIDOffset         = 6945
+	permit2DomainSeparatorOffset = 6983
+)
+
+// predeploys are the well-known predeploys which can be installed, indexed by
+// name.
+var predeploys = map[string]Predeploy{
+	PredeployCreate2Deployer: {
+		Name:    PredeployCreate2Deployer,
+		Address: "0x4e59b44847b379578588920cA78FbF26c0B4956C",
+		Code:    deterministicDeploymentProxyCode,
+	},
+	PredeploySafeSingletonFactory: {
+		Name:    PredeploySafeSingletonFactory,
+		Address: "0x914d7Fec6aaC8cd542e72Bca78B30650d45643d7",
+		Code:    deterministicDeploymentProxyCode,
+	},
+	PredeployMulticall3: {
+		Name:    PredeployMulticall3,
+		Address: "0xcA11bde05977b3631167028862bE2a173976CA11",
+		Code:    multicall3Code,
+	},
+	PredeployPermit2: {
+		Name:    PredeployPermit2,
+		Address: "0x000000000022D473030F116dDEE9F6B43aC78BA3",
+		Code:    permit2Code,
+	},
+	PredeployEntryPointV06: {
+		Name:    PredeployEntryPointV06,
+		Address: "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789",
+		Code:    entryPointV06Code,
+	},
+	PredeploySenderCreatorV06: {
+		Name:    PredeploySenderCreatorV06,
+		Address: "0x7fc98430eAEdbb6070B35B39D798725049088348",
+		Code:    senderCreatorV06Code,
+	},
+}
+
+// predeployDependencies are the predeploys installed along with a predeploy,
+// since its code calls them at their canonical address.
+var predeployDependencies = map[string][]string{
+	PredeployEntryPointV06: {PredeploySenderCreatorV06},
+}
+
+// GetPredeploy returns the well-known predeploy with the given name.
+func GetPredeploy(name string) (Predeploy, bool) {
+	predeploy, found := predeploys[name]
+	return predeploy, found
+}
+
+// GetPredeployDependencies returns the names of the predeploys that must be
+// installed along with the predeploy with the given name.
+func GetPredeployDependencies(name string) []string {
+	return predeployDependencies[name]
+}
+
+// AvailablePredeploys returns the names of the well-known predeploys, sorted
+// alphabetically.
+func AvailablePredeploys() []string {
+	names := make([]string, 0, len(predeploys))
+	for name := range predeploys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidatePredeployNames checks that the names are the ones of well-known
+// predeploys, without duplicates.
+func ValidatePredeployNames(names []string) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, found := predeploys[name]; !found {
+			return errorsmod.Wrapf(ErrInvalidPredeploy, "unknown predeploy %s, available: %v", name, AvailablePredeploys())
+		}
+		if seen[name] {
+			return errorsmod.Wrapf(ErrInvalidPredeploy, "duplicated predeploy %s", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// RuntimeCode returns the code of the predeploy deployed on the chain with the
+// given ID. The code of Permit2 is the mainnet one with the immutables cached
+// for the chain ID.
+func (p Predeploy) RuntimeCode(chainID *big.Int) []byte {
+	code := common.FromHex(p.Code)
+	if p.Name != PredeployPermit2 || chainID == nil {
+		return code
+	}
+
+	// keccak256(abi.encode(typeHash, nameHash, chainId, address))
+	typeHash := crypto.Keccak256([]byte("EIP712Domain(string name,uint256 chainId,address verifyingContract)"))
+	nameHash := crypto.Keccak256([]byte("Permit2"))
+	domainSeparator := crypto.Keccak256(
+		typeHash,
+		nameHash,
+		math.U256Bytes(new(big.Int).Set(chainID)),
+		common.LeftPadBytes(common.HexToAddress(p.Address).Bytes(), 32),
+	)
+
+	copy(code[permit2ChainIDOffset:], math.U256Bytes(new(big.Int).Set(chainID)))
+	copy(code[permit2DomainSeparatorOffset:], domainSeparator)
+	return code
+}
+
+// Validate performs a basic validation of the predeploy fields.
+func (p Predeploy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("predeploy name cannot be empty")
+	}
+	if err := types.ValidateNonZeroAddress(p.Address); err != nil {
+		return err
+	}
+	if len(common.FromHex(p.Code)) == 0 {
+		return fmt.Errorf("predeploy %s code cannot be empty", p.Name)
+	}
+	return p.Storage.Validate()
+}
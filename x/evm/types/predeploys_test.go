@@ -0,0 +1,75 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredeploys(t *testing.T) {
+	names := AvailablePredeploys()
+	require.Equal(t, []string{
+		PredeployCreate2Deployer,
+		PredeployEntryPointV06,
+		PredeployMulticall3,
+		PredeployPermit2,
+		PredeploySafeSingletonFactory,
+		PredeploySenderCreatorV06,
+	}, names)
+
+	addresses := make(map[string]bool)
+	for _, name := range names {
+		predeploy, found := GetPredeploy(name)
+		require.True(t, found)
+		require.Equal(t, name, predeploy.Name)
+		require.NoError(t, predeploy.Validate(), name)
+		require.False(t, addresses[predeploy.Address], "duplicated address %s", predeploy.Address)
+		addresses[predeploy.Address] = true
+
+		for _, dependency := range GetPredeployDependencies(name) {
+			_, found := GetPredeploy(dependency)
+			require.True(t, found, dependency)
+		}
+	}
+
+	_, found := GetPredeploy("multicall")
+	require.False(t, found)
+}
+
+func TestPredeployRuntimeCode(t *testing.T) {
+	multicall3, _ := GetPredeploy(PredeployMulticall3)
+	require.Equal(t, common.FromHex(multicall3.Code), multicall3.RuntimeCode(big.NewInt(9000)))
+
+	// the mainnet code of Permit2 caches the chain ID 1
+	permit2, _ := GetPredeploy(PredeployPermit2)
+	require.Equal(t, common.FromHex(permit2.Code), permit2.RuntimeCode(big.NewInt(1)))
+
+	code := permit2.RuntimeCode(big.NewInt(9000))
+	require.Len(t, code, len(common.FromHex(permit2.Code)))
+	require.Equal(t, common.LeftPadBytes(big.NewInt(9000).Bytes(), 32), code[permit2ChainIDOffset:permit2ChainIDOffset+32])
+	require.NotEqual(t, common.FromHex(permit2.Code), code)
+}
+
+func TestValidatePredeployNames(t *testing.T) {
+	testCases := []struct {
+		name    string
+		names   []string
+		expPass bool
+	}{
+		{"empty", nil, true},
+		{"valid", []string{PredeploySafeSingletonFactory, PredeployCreate2Deployer}, true},
+		{"unknown", []string{PredeployCreate2Deployer, "multicall"}, false},
+		{"duplicated", []string{PredeployCreate2Deployer, PredeployCreate2Deployer}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidatePredeployNames(tc.names)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/types"
+)
+
+// MaxSponsoredContracts is the maximum number of contracts a single account can
+// sponsor.
+const MaxSponsoredContracts = 100
+
+// TxSponsor is the account paying the gas of a transaction, either through the
+// fee allowance granted to the sender or by sponsoring the called contract.
+type TxSponsor struct {
+	Address      sdk.AccAddress
+	FeeAllowance bool
+}
+
+// NewSponsorship returns a new Sponsorship instance.
+func NewSponsorship(sponsor sdk.AccAddress, contracts []common.Address, limits SponsorshipLimits) Sponsorship {
+	hexContracts := make([]string, len(contracts))
+	for i, contract := range contracts {
+		hexContracts[i] = contract.Hex()
+	}
+
+	return Sponsorship{
+		Sponsor:   sponsor.String(),
+		Contracts: hexContracts,
+		Limits:    limits,
+	}
+}
+
+// Validate performs a basic validation of the sponsorship fields.
+func (s Sponsorship) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	if err := ValidateSponsoredContracts(s.Contracts); err != nil {
+		return err
+	}
+
+	return s.Limits.Validate()
+}
+
+// NewSponsorshipLimits returns a new SponsorshipLimits instance.
+func NewSponsorshipLimits(maxGasPerTx uint64, maxFeePerGas, maxPriorityFeePerGas, spendLimit sdkmath.Int) SponsorshipLimits {
+	return SponsorshipLimits{
+		MaxGasPerTx:          maxGasPerTx,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		SpendLimit:           spendLimit,
+	}
+}
+
+// Validate checks that the limits are set, the priority fee not exceeding the
+// fee. The spend limit can be exhausted.
+func (l SponsorshipLimits) Validate() error {
+	if l.MaxGasPerTx == 0 {
+		return errorsmod.Wrap(ErrInvalidSponsorship, "max gas per tx cannot be zero")
+	}
+	if l.MaxFeePerGas.IsNil() || !l.MaxFeePerGas.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidSponsorship, "max fee per gas must be positive")
+	}
+	if l.MaxPriorityFeePerGas.IsNil() || l.MaxPriorityFeePerGas.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSponsorship, "max priority fee per gas cannot be negative")
+	}
+	if l.MaxPriorityFeePerGas.GT(l.MaxFeePerGas) {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "max priority fee per gas cannot exceed max fee per gas (%s > %s)", l.MaxPriorityFeePerGas, l.MaxFeePerGas)
+	}
+	if l.SpendLimit.IsNil() || l.SpendLimit.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSponsorship, "spend limit cannot be negative")
+	}
+	return nil
+}
+
+// Accept checks that a transaction with the given gas limit, effective gas
+// price and tip, and fee is within the limits, and returns the limits with the
+// fee deducted from the spend limit.
+func (l SponsorshipLimits) Accept(gasLimit uint64, gasPrice, gasTip *big.Int, fee sdkmath.Int) (SponsorshipLimits, error) {
+	if gasLimit > l.MaxGasPerTx {
+		return l, errorsmod.Wrapf(ErrInvalidSponsorship, "gas limit exceeds the sponsored max gas per tx (%d > %d)", gasLimit, l.MaxGasPerTx)
+	}
+	if gasPrice.Cmp(l.MaxFeePerGas.BigInt()) > 0 {
+		return l, errorsmod.Wrapf(ErrInvalidSponsorship, "gas price exceeds the sponsored max fee per gas (%s > %s)", gasPrice, l.MaxFeePerGas)
+	}
+	if gasTip.Cmp(l.MaxPriorityFeePerGas.BigInt()) > 0 {
+		return l, errorsmod.Wrapf(ErrInvalidSponsorship, "gas tip exceeds the sponsored max priority fee per gas (%s > %s)", gasTip, l.MaxPriorityFeePerGas)
+	}
+	if fee.GT(l.SpendLimit) {
+		return l, errorsmod.Wrapf(ErrInvalidSponsorship, "fee exceeds the sponsorship spend limit (%s > %s)", fee, l.SpendLimit)
+	}
+
+	l.SpendLimit = l.SpendLimit.Sub(fee)
+	return l, nil
+}
+
+// ValidateSponsoredContracts checks that the contracts are valid non-zero hex
+// addresses, without duplicates, and that they don't exceed the maximum number
+// of sponsored contracts.
+func ValidateSponsoredContracts(contracts []string) error {
+	if len(contracts) > MaxSponsoredContracts {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "too many sponsored contracts: %d > %d", len(contracts), MaxSponsoredContracts)
+	}
+
+	seen := make(map[common.Address]bool, len(contracts))
+	for _, contract := range contracts {
+		if err := types.ValidateNonZeroAddress(contract); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid sponsored contract %s: %s", contract, err)
+		}
+		addr := common.HexToAddress(contract)
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidSponsorship, "duplicated sponsored contract %s", contract)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
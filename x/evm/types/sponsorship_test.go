@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+)
+
+func TestValidateSponsoredContracts(t *testing.T) {
+	contract := utiltx.GenerateAddress().Hex()
+
+	tooMany := make([]string, types.MaxSponsoredContracts+1)
+	for i := range tooMany {
+		tooMany[i] = utiltx.GenerateAddress().Hex()
+	}
+
+	testCases := []struct {
+		name      string
+		contracts []string
+		expPass   bool
+	}{
+		{"empty", nil, true},
+		{"valid", []string{contract, utiltx.GenerateAddress().Hex()}, true},
+		{"invalid address", []string{"0x123"}, false},
+		{"zero address", []string{common.Address{}.Hex()}, false},
+		{"duplicated", []string{contract, contract}, false},
+		{"too many", tooMany, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateSponsoredContracts(tc.contracts)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSetSponsoredContractsValidateBasic(t *testing.T) {
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress().Hex()
+	limits := types.NewSponsorshipLimits(21000, sdkmath.NewInt(100), sdkmath.NewInt(10), sdkmath.NewInt(2100000))
+
+	testCases := []struct {
+		name    string
+		msg     types.MsgSetSponsoredContracts
+		expPass bool
+	}{
+		{"valid", types.MsgSetSponsoredContracts{Sponsor: sponsor.String(), Contracts: []string{contract}, Limits: limits}, true},
+		{"missing limits", types.MsgSetSponsoredContracts{Sponsor: sponsor.String(), Contracts: []string{contract}}, false},
+		{"remove sponsorship", types.MsgSetSponsoredContracts{Sponsor: sponsor.String()}, true},
+		{"invalid sponsor", types.MsgSetSponsoredContracts{Sponsor: "invalid", Contracts: []string{contract}}, false},
+		{"invalid contract", types.MsgSetSponsoredContracts{Sponsor: sponsor.String(), Contracts: []string{"invalid"}, Limits: limits}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{sponsor}, tc.msg.GetSigners(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSponsorshipLimitsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		limits  types.SponsorshipLimits
+		expPass bool
+	}{
+		{"valid", types.NewSponsorshipLimits(21000, sdkmath.NewInt(100), sdkmath.NewInt(10), sdkmath.NewInt(2100000)), true},
+		{"zero spend limit", types.NewSponsorshipLimits(21000, sdkmath.NewInt(100), sdkmath.ZeroInt(), sdkmath.ZeroInt()), true},
+		{"zero gas", types.NewSponsorshipLimits(0, sdkmath.NewInt(100), sdkmath.NewInt(10), sdkmath.NewInt(2100000)), false},
+		{"zero max fee", types.NewSponsorshipLimits(21000, sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.NewInt(2100000)), false},
+		{"priority fee above max fee", types.NewSponsorshipLimits(21000, sdkmath.NewInt(100), sdkmath.NewInt(101), sdkmath.NewInt(2100000)), false},
+		{"negative spend limit", types.NewSponsorshipLimits(21000, sdkmath.NewInt(100), sdkmath.NewInt(10), sdkmath.NewInt(-1)), false},
+		{"nil values", types.SponsorshipLimits{MaxGasPerTx: 21000}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.limits.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSponsorshipLimitsAccept(t *testing.T) {
+	limits := types.NewSponsorshipLimits(21000, sdkmath.NewInt(100), sdkmath.NewInt(10), sdkmath.NewInt(2100000))
+
+	testCases := []struct {
+		name     string
+		gasLimit uint64
+		gasPrice *big.Int
+		gasTip   *big.Int
+		fee      sdkmath.Int
+		expPass  bool
+	}{
+		{"within the limits", 21000, big.NewInt(100), big.NewInt(10), sdkmath.NewInt(2100000), true},
+		{"gas limit exceeded", 21001, big.NewInt(100), big.NewInt(10), sdkmath.NewInt(2100000), false},
+		{"gas price exceeded", 21000, big.NewInt(101), big.NewInt(10), sdkmath.NewInt(2100000), false},
+		{"gas tip exceeded", 21000, big.NewInt(100), big.NewInt(11), sdkmath.NewInt(2100000), false},
+		{"spend limit exceeded", 21000, big.NewInt(100), big.NewInt(10), sdkmath.NewInt(2100001), false},
+	}
+
+	for _, tc := range testCases {
+		updated, err := limits.Accept(tc.gasLimit, tc.gasPrice, tc.gasTip, tc.fee)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, limits.SpendLimit.Sub(tc.fee), updated.SpendLimit, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
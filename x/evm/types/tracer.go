@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"math/big"
 	"os"
 	"time"
@@ -30,7 +32,7 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 
 	switch tracer {
 	case TracerAccessList:
-		preCompiles := vm.ActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil))
+		preCompiles := vm.DefaultActivePrecompiles(cfg.Rules(big.NewInt(height), IsMergeNetsplit(cfg, big.NewInt(height))))
 		return logger.NewAccessListTracer(msg.AccessList(), msg.From(), *msg.To(), preCompiles)
 	case TracerJSON:
 		return logger.NewJSONLogger(logCfg, os.Stderr)
@@ -108,3 +110,29 @@ func (dt NoOpTracer) CaptureTxStart(gasLimit uint64) {}
 //
 //nolint:revive // allow unused parameters to indicate expected signature
 func (dt NoOpTracer) CaptureTxEnd(restGas uint64) {}
+
+// UnmarshalJSON decodes the trace config, the tracer config can be given
+// either as a JSON object, as go-ethereum clients do, or as a JSON encoded
+// string.
+func (tc *TraceConfig) UnmarshalJSON(input []byte) error {
+	type traceConfig TraceConfig
+	var dec struct {
+		*traceConfig
+		TracerJSONConfig json.RawMessage `json:"tracerConfig"`
+	}
+	dec.traceConfig = (*traceConfig)(tc)
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+
+	cfg := bytes.TrimSpace(dec.TracerJSONConfig)
+	switch {
+	case len(cfg) == 0 || bytes.Equal(cfg, []byte("null")):
+		tc.TracerJsonConfig = ""
+	case cfg[0] == '"':
+		return json.Unmarshal(cfg, &tc.TracerJsonConfig)
+	default:
+		tc.TracerJsonConfig = string(cfg)
+	}
+	return nil
+}
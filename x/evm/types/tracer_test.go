@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,3 +10,32 @@ import (
 func TestNewNoOpTracer(t *testing.T) {
 	require.Equal(t, &NoOpTracer{}, NewNoOpTracer())
 }
+
+func TestTraceConfigUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expConfig string
+		expErr    bool
+	}{
+		{"no tracer config", `{"tracer":"callTracer"}`, "", false},
+		{"null tracer config", `{"tracer":"callTracer","tracerConfig":null}`, "", false},
+		{"object tracer config", `{"tracer":"callTracer","tracerConfig":{"withLog":true}}`, `{"withLog":true}`, false},
+		{"string tracer config", `{"tracer":"callTracer","tracerConfig":"{\"withLog\":true}"}`, `{"withLog":true}`, false},
+		{"invalid config", `{"tracer":1}`, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg TraceConfig
+			err := json.Unmarshal([]byte(tc.input), &cfg)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "callTracer", cfg.Tracer)
+			require.Equal(t, tc.expConfig, cfg.TracerJsonConfig)
+		})
+	}
+}
@@ -440,6 +440,204 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgInstallPredeploys defines a Msg for installing well-known predeploys.
+type MsgInstallPredeploys struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// names defines the names of the predeploys to install.
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *MsgInstallPredeploys) Reset()         { *m = MsgInstallPredeploys{} }
+func (m *MsgInstallPredeploys) String() string { return proto.CompactTextString(m) }
+func (*MsgInstallPredeploys) ProtoMessage()    {}
+func (*MsgInstallPredeploys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgInstallPredeploys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstallPredeploys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstallPredeploys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstallPredeploys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstallPredeploys.Merge(m, src)
+}
+func (m *MsgInstallPredeploys) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstallPredeploys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstallPredeploys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstallPredeploys proto.InternalMessageInfo
+
+func (m *MsgInstallPredeploys) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgInstallPredeploys) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+// MsgInstallPredeploysResponse defines the response structure for executing a
+// MsgInstallPredeploys message.
+type MsgInstallPredeploysResponse struct {
+}
+
+func (m *MsgInstallPredeploysResponse) Reset()         { *m = MsgInstallPredeploysResponse{} }
+func (m *MsgInstallPredeploysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstallPredeploysResponse) ProtoMessage()    {}
+func (*MsgInstallPredeploysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgInstallPredeploysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstallPredeploysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstallPredeploysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstallPredeploysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstallPredeploysResponse.Merge(m, src)
+}
+func (m *MsgInstallPredeploysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstallPredeploysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstallPredeploysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstallPredeploysResponse proto.InternalMessageInfo
+
+// MsgSetSponsoredContracts defines a Msg for a sponsor to set the contracts
+// whose calls it pays the gas for, within the given limits. It replaces the
+// previous sponsorship, an empty list removes it.
+type MsgSetSponsoredContracts struct {
+	// sponsor is the bech32 address of the account paying the gas
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contracts defines the hex formatted addresses of the sponsored contracts.
+	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// limits defines the transactions paid for by the sponsor, required if
+	// contracts are sponsored.
+	Limits SponsorshipLimits `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits"`
+}
+
+func (m *MsgSetSponsoredContracts) Reset()         { *m = MsgSetSponsoredContracts{} }
+func (m *MsgSetSponsoredContracts) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsoredContracts) ProtoMessage()    {}
+func (*MsgSetSponsoredContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgSetSponsoredContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsoredContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsoredContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsoredContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsoredContracts.Merge(m, src)
+}
+func (m *MsgSetSponsoredContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsoredContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsoredContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsoredContracts proto.InternalMessageInfo
+
+func (m *MsgSetSponsoredContracts) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgSetSponsoredContracts) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *MsgSetSponsoredContracts) GetLimits() SponsorshipLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return SponsorshipLimits{}
+}
+
+// MsgSetSponsoredContractsResponse defines the response structure for
+// executing a MsgSetSponsoredContracts message.
+type MsgSetSponsoredContractsResponse struct {
+}
+
+func (m *MsgSetSponsoredContractsResponse) Reset()         { *m = MsgSetSponsoredContractsResponse{} }
+func (m *MsgSetSponsoredContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsoredContractsResponse) ProtoMessage()    {}
+func (*MsgSetSponsoredContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgSetSponsoredContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsoredContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsoredContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsoredContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsoredContractsResponse.Merge(m, src)
+}
+func (m *MsgSetSponsoredContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsoredContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsoredContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsoredContractsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -449,74 +647,88 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgInstallPredeploys)(nil), "ethermint.evm.v1.MsgInstallPredeploys")
+	proto.RegisterType((*MsgInstallPredeploysResponse)(nil), "ethermint.evm.v1.MsgInstallPredeploysResponse")
+	proto.RegisterType((*MsgSetSponsoredContracts)(nil), "ethermint.evm.v1.MsgSetSponsoredContracts")
+	proto.RegisterType((*MsgSetSponsoredContractsResponse)(nil), "ethermint.evm.v1.MsgSetSponsoredContractsResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 983 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x13, 0xe7, 0xdf, 0x24, 0x94, 0x6a, 0xb4, 0x55, 0x9d, 0x00, 0x71, 0x9a, 0x03, 0xa4,
-	0x45, 0xb1, 0xb5, 0x01, 0xf5, 0xb0, 0x27, 0x36, 0xdd, 0xb4, 0x6a, 0xb5, 0x2b, 0x2a, 0x93, 0x5e,
-	0x28, 0x52, 0x34, 0xeb, 0xcc, 0x4e, 0x2c, 0x62, 0x8f, 0xe5, 0x99, 0x58, 0x09, 0xc7, 0x9e, 0xb8,
-	0x01, 0xe2, 0x0b, 0x70, 0xe0, 0xc4, 0x09, 0x89, 0x7e, 0x00, 0x8e, 0x15, 0xa7, 0x0a, 0x2e, 0x88,
-	0x83, 0x41, 0x59, 0x24, 0xa4, 0xbd, 0xc1, 0x27, 0x40, 0x33, 0xe3, 0x6c, 0x36, 0x0d, 0xdb, 0x42,
-	0x29, 0xe2, 0xe4, 0x79, 0x7e, 0x6f, 0xde, 0x7b, 0xf3, 0xfb, 0xfd, 0xe6, 0x0f, 0xa8, 0x61, 0x3e,
-	0xc6, 0x91, 0xef, 0x05, 0xdc, 0xc6, 0xb1, 0x6f, 0xc7, 0xdb, 0x36, 0x9f, 0x59, 0x61, 0x44, 0x39,
-	0x85, 0x17, 0x4f, 0x5d, 0x16, 0x8e, 0x7d, 0x2b, 0xde, 0xae, 0x5f, 0x76, 0x29, 0xf3, 0x29, 0xb3,
-	0x7d, 0x46, 0x44, 0xa4, 0xcf, 0x88, 0x0a, 0xad, 0xd7, 0x94, 0x63, 0x28, 0x2d, 0x5b, 0x19, 0xa9,
-	0xab, 0xbe, 0x51, 0x40, 0x24, 0x53, 0xbe, 0x2d, 0x42, 0x09, 0x55, 0x73, 0xc4, 0x28, 0xfd, 0xfb,
-	0x2a, 0xa1, 0x94, 0x4c, 0xb0, 0x8d, 0x42, 0xcf, 0x46, 0x41, 0x40, 0x39, 0xe2, 0x1e, 0x0d, 0x96,
-	0xf9, 0x6a, 0xa9, 0x57, 0x5a, 0x87, 0xd3, 0x23, 0x1b, 0x05, 0x73, 0xe5, 0x6a, 0x7d, 0xa2, 0x81,
-	0x97, 0x0e, 0x18, 0xe9, 0x8b, 0x82, 0x78, 0xea, 0x0f, 0x66, 0xb0, 0x0d, 0xf4, 0x11, 0xe2, 0xc8,
-	0xd0, 0x9a, 0x5a, 0xbb, 0xd2, 0xdd, 0xb2, 0xd4, 0x5c, 0x6b, 0x39, 0xd7, 0xda, 0x0d, 0xe6, 0x8e,
-	0x8c, 0x80, 0x35, 0xa0, 0x33, 0xef, 0x23, 0x6c, 0x64, 0x9b, 0x5a, 0x5b, 0xeb, 0xe5, 0x4f, 0x12,
-	0x53, 0xeb, 0x38, 0xf2, 0x17, 0x34, 0x81, 0x3e, 0x46, 0x6c, 0x6c, 0xe4, 0x9a, 0x5a, 0xbb, 0xdc,
-	0xab, 0xfc, 0x91, 0x98, 0xc5, 0x68, 0x12, 0xee, 0xb4, 0x3a, 0x2d, 0x47, 0x3a, 0x20, 0x04, 0xfa,
-	0x51, 0x44, 0x7d, 0x43, 0x17, 0x01, 0x8e, 0x1c, 0xef, 0xe8, 0x1f, 0x7f, 0x61, 0x66, 0x5a, 0xdf,
-	0x64, 0x41, 0x69, 0x1f, 0x13, 0xe4, 0xce, 0x07, 0x33, 0xb8, 0x05, 0xf2, 0x01, 0x0d, 0x5c, 0x2c,
-	0xbb, 0xd1, 0x1d, 0x65, 0xc0, 0x5b, 0xa0, 0x4c, 0x90, 0x40, 0xce, 0x73, 0x55, 0xf5, 0x72, 0xef,
-	0xda, 0x4f, 0x89, 0xf9, 0x3a, 0xf1, 0xf8, 0x78, 0x7a, 0x68, 0xb9, 0xd4, 0x4f, 0xf1, 0x4c, 0x3f,
-	0x1d, 0x36, 0xfa, 0xd0, 0xe6, 0xf3, 0x10, 0x33, 0xeb, 0x76, 0xc0, 0x9d, 0x12, 0x41, 0xec, 0xae,
-	0x98, 0x0b, 0x1b, 0x20, 0x47, 0x10, 0x93, 0x5d, 0xea, 0xbd, 0xea, 0x22, 0x31, 0x4b, 0xb7, 0x10,
-	0xdb, 0xf7, 0x7c, 0x8f, 0x3b, 0xc2, 0x01, 0x2f, 0x80, 0x2c, 0xa7, 0x69, 0x8f, 0x59, 0x4e, 0xe1,
-	0x1d, 0x90, 0x8f, 0xd1, 0x64, 0x8a, 0x8d, 0xbc, 0x2c, 0xfa, 0xf6, 0xdf, 0x2f, 0xba, 0x48, 0xcc,
-	0xc2, 0xae, 0x4f, 0xa7, 0x01, 0x77, 0x54, 0x0a, 0x81, 0x80, 0xc4, 0xb9, 0xd0, 0xd4, 0xda, 0xd5,
-	0x14, 0xd1, 0x2a, 0xd0, 0x62, 0xa3, 0x28, 0x7f, 0x68, 0xb1, 0xb0, 0x22, 0xa3, 0xa4, 0xac, 0x48,
-	0x58, 0xcc, 0x28, 0x2b, 0x8b, 0xed, 0x5c, 0x10, 0x58, 0x7d, 0xf7, 0xb0, 0x53, 0x18, 0xcc, 0xf6,
-	0x10, 0x47, 0xad, 0xdf, 0x73, 0xa0, 0xba, 0xeb, 0xba, 0x98, 0xb1, 0x7d, 0x8f, 0xf1, 0xc1, 0x0c,
-	0xde, 0x07, 0x25, 0x77, 0x8c, 0xbc, 0x60, 0xe8, 0x8d, 0x24, 0x78, 0xe5, 0xde, 0x3b, 0xff, 0xa8,
-	0xdb, 0xe2, 0x0d, 0x31, 0xfb, 0xf6, 0xde, 0x49, 0x62, 0x16, 0x5d, 0x35, 0x74, 0xd2, 0xc1, 0x68,
-	0x45, 0x4b, 0xf6, 0x5c, 0x5a, 0x72, 0xff, 0x9e, 0x16, 0xfd, 0xe9, 0xb4, 0xe4, 0x37, 0x69, 0x29,
-	0xbc, 0x38, 0x5a, 0x8a, 0x67, 0x68, 0xb9, 0x0f, 0x4a, 0x48, 0x62, 0x8b, 0x99, 0x51, 0x6a, 0xe6,
-	0xda, 0x95, 0xee, 0x6b, 0xd6, 0x93, 0x1b, 0xdd, 0x52, 0xe8, 0x0f, 0xa6, 0xe1, 0x04, 0xf7, 0x9a,
-	0x8f, 0x12, 0x33, 0x73, 0x92, 0x98, 0x00, 0x9d, 0x52, 0xf2, 0xd5, 0xcf, 0x26, 0x58, 0x11, 0xe4,
-	0x9c, 0x26, 0x54, 0x9c, 0x97, 0xd7, 0x38, 0x07, 0x6b, 0x9c, 0x57, 0xce, 0xe3, 0xfc, 0x5b, 0x1d,
-	0x54, 0xf7, 0xe6, 0x01, 0xf2, 0x3d, 0xf7, 0x26, 0xc6, 0xff, 0x0f, 0xe7, 0x77, 0x40, 0x45, 0x70,
-	0xce, 0xbd, 0x70, 0xe8, 0xa2, 0xf0, 0x39, 0x58, 0x17, 0x92, 0x19, 0x78, 0xe1, 0x0d, 0x14, 0x2e,
-	0x73, 0x1d, 0x61, 0x2c, 0x73, 0xe9, 0xcf, 0x95, 0xeb, 0x26, 0xc6, 0x22, 0x57, 0x2a, 0xa1, 0xfc,
-	0xd3, 0x25, 0x54, 0xd8, 0x94, 0x50, 0xf1, 0xc5, 0x49, 0xa8, 0x74, 0x8e, 0x84, 0xca, 0xff, 0x89,
-	0x84, 0xc0, 0x9a, 0x84, 0x2a, 0x6b, 0x12, 0xaa, 0x9e, 0x27, 0xa1, 0x16, 0xa8, 0xf7, 0x67, 0x1c,
-	0x07, 0xcc, 0xa3, 0xc1, 0xbb, 0xa1, 0xbc, 0x33, 0x56, 0x57, 0x41, 0x7a, 0x20, 0x7f, 0xa9, 0x81,
-	0x4b, 0x6b, 0x57, 0x84, 0x83, 0x59, 0x48, 0x03, 0x26, 0x17, 0x2a, 0x4f, 0x79, 0x4d, 0x1d, 0xe2,
-	0xf2, 0x60, 0xbf, 0x0a, 0xf4, 0x09, 0x25, 0xcc, 0xc8, 0xca, 0x45, 0x5e, 0xda, 0x5c, 0xe4, 0x3e,
-	0x25, 0x8e, 0x0c, 0x81, 0x17, 0x41, 0x2e, 0xc2, 0x5c, 0x6a, 0xa6, 0xea, 0x88, 0x21, 0xac, 0x81,
-	0x52, 0xec, 0x0f, 0x71, 0x14, 0xd1, 0x28, 0x3d, 0x75, 0x8b, 0xb1, 0xdf, 0x17, 0xa6, 0x70, 0x09,
-	0x71, 0x4c, 0x19, 0x1e, 0x29, 0x56, 0x9d, 0x22, 0x41, 0xec, 0x1e, 0xc3, 0xa3, 0xb4, 0xcd, 0xcf,
-	0x34, 0xf0, 0xf2, 0x01, 0x23, 0xf7, 0xc2, 0x11, 0xe2, 0xf8, 0x2e, 0x8a, 0x90, 0xcf, 0xe0, 0x75,
-	0x50, 0x46, 0x53, 0x3e, 0xa6, 0x91, 0xc7, 0xe7, 0xe9, 0x8e, 0x30, 0xbe, 0x7f, 0xd8, 0xd9, 0x4a,
-	0x6f, 0xdb, 0xdd, 0xd1, 0x28, 0xc2, 0x8c, 0xbd, 0xc7, 0x23, 0x2f, 0x20, 0xce, 0x2a, 0x14, 0x5e,
-	0x07, 0x85, 0x50, 0x66, 0x90, 0x62, 0xaf, 0x74, 0x8d, 0xcd, 0x65, 0xa8, 0x0a, 0x3d, 0x5d, 0xd0,
-	0xe4, 0xa4, 0xd1, 0x3b, 0x17, 0x1e, 0xfc, 0xf6, 0xf5, 0xb5, 0x55, 0x9e, 0x56, 0x0d, 0x5c, 0x7e,
-	0xa2, 0xa5, 0x25, 0x76, 0xdd, 0x44, 0x03, 0xb9, 0x03, 0x46, 0xe0, 0x1c, 0x80, 0x33, 0x97, 0xaf,
-	0xb9, 0x59, 0x68, 0x0d, 0xfa, 0xfa, 0x1b, 0xcf, 0x08, 0x58, 0xe6, 0x6f, 0x5d, 0x79, 0xf0, 0xc3,
-	0xaf, 0x9f, 0x67, 0x5f, 0x69, 0xd5, 0xc4, 0xdb, 0x81, 0xb2, 0xd3, 0x87, 0x44, 0x1a, 0x39, 0xe4,
-	0x33, 0xf8, 0x01, 0xa8, 0xae, 0xa1, 0x75, 0xe5, 0x2f, 0x73, 0x9f, 0x0d, 0xa9, 0x5f, 0x7d, 0x66,
-	0xc8, 0xb2, 0x81, 0x5e, 0xff, 0xd1, 0xa2, 0xa1, 0x3d, 0x5e, 0x34, 0xb4, 0x5f, 0x16, 0x0d, 0xed,
-	0xd3, 0xe3, 0x46, 0xe6, 0xf1, 0x71, 0x23, 0xf3, 0xe3, 0x71, 0x23, 0xf3, 0xfe, 0x9b, 0x67, 0x36,
-	0x56, 0x9f, 0xb9, 0x28, 0xe8, 0xf5, 0x6d, 0x1c, 0xa7, 0x0f, 0x9e, 0x78, 0xbb, 0x6b, 0xcf, 0x64,
-	0xb7, 0x72, 0x87, 0x1d, 0x16, 0xe4, 0xc3, 0xe3, 0xad, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x63,
-	0x42, 0x91, 0x73, 0x75, 0x09, 0x00, 0x00,
+	// 1144 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0xeb, 0x5f, 0x63, 0x13, 0xca, 0x28, 0x51, 0xd7, 0x26, 0x78, 0x5d, 0x23, 0x15,
+	0x37, 0x52, 0xbc, 0x8d, 0x8b, 0x7a, 0xc8, 0x89, 0x38, 0x49, 0x4b, 0xaa, 0x58, 0x44, 0x1b, 0xf7,
+	0x42, 0x91, 0xa2, 0xc9, 0xee, 0x64, 0xbd, 0x8a, 0x77, 0x67, 0xb5, 0x33, 0x36, 0x36, 0xc7, 0x9e,
+	0xb8, 0x01, 0xe2, 0x1f, 0xe0, 0xc0, 0x89, 0x13, 0x12, 0xbd, 0x22, 0x71, 0xe0, 0x50, 0x71, 0xaa,
+	0xe0, 0x82, 0x38, 0x18, 0x94, 0x20, 0x21, 0xe5, 0x06, 0x7f, 0x01, 0x9a, 0x99, 0xb5, 0x1d, 0xd7,
+	0x4e, 0x13, 0x42, 0x11, 0xa7, 0x9d, 0x37, 0xef, 0x9b, 0xf7, 0xde, 0xbc, 0xef, 0x9b, 0x99, 0x05,
+	0x79, 0xcc, 0x5a, 0x38, 0xf4, 0x5c, 0x9f, 0x19, 0xb8, 0xeb, 0x19, 0xdd, 0x55, 0x83, 0xf5, 0xaa,
+	0x41, 0x48, 0x18, 0x81, 0xd7, 0x46, 0xae, 0x2a, 0xee, 0x7a, 0xd5, 0xee, 0x6a, 0xe1, 0xba, 0x45,
+	0xa8, 0x47, 0xa8, 0xe1, 0x51, 0x87, 0x23, 0x3d, 0xea, 0x48, 0x68, 0x21, 0x2f, 0x1d, 0xfb, 0xc2,
+	0x32, 0xa4, 0x11, 0xb9, 0x0a, 0x53, 0x09, 0x78, 0x30, 0xe9, 0x5b, 0x70, 0x88, 0x43, 0xe4, 0x1a,
+	0x3e, 0x8a, 0x66, 0x97, 0x1c, 0x42, 0x9c, 0x36, 0x36, 0x50, 0xe0, 0x1a, 0xc8, 0xf7, 0x09, 0x43,
+	0xcc, 0x25, 0xfe, 0x30, 0x5e, 0x3e, 0xf2, 0x0a, 0xeb, 0xa0, 0x73, 0x68, 0x20, 0xbf, 0x2f, 0x5d,
+	0xe5, 0x4f, 0x14, 0xf0, 0x4a, 0x83, 0x3a, 0x5b, 0x3c, 0x21, 0xee, 0x78, 0xcd, 0x1e, 0xac, 0x00,
+	0xd5, 0x46, 0x0c, 0x69, 0x4a, 0x49, 0xa9, 0x64, 0x6b, 0x0b, 0x55, 0xb9, 0xb6, 0x3a, 0x5c, 0x5b,
+	0x5d, 0xf7, 0xfb, 0xa6, 0x40, 0xc0, 0x3c, 0x50, 0xa9, 0xfb, 0x11, 0xd6, 0x62, 0x25, 0xa5, 0xa2,
+	0xd4, 0x13, 0xa7, 0x03, 0x5d, 0x59, 0x31, 0xc5, 0x14, 0xd4, 0x81, 0xda, 0x42, 0xb4, 0xa5, 0xc5,
+	0x4b, 0x4a, 0x25, 0x53, 0xcf, 0xfe, 0x35, 0xd0, 0x53, 0x61, 0x3b, 0x58, 0x2b, 0xaf, 0x94, 0x4d,
+	0xe1, 0x80, 0x10, 0xa8, 0x87, 0x21, 0xf1, 0x34, 0x95, 0x03, 0x4c, 0x31, 0x5e, 0x53, 0x3f, 0xfe,
+	0x42, 0x9f, 0x2b, 0x7f, 0x13, 0x03, 0xe9, 0x1d, 0xec, 0x20, 0xab, 0xdf, 0xec, 0xc1, 0x05, 0x90,
+	0xf0, 0x89, 0x6f, 0x61, 0x51, 0x8d, 0x6a, 0x4a, 0x03, 0xde, 0x07, 0x19, 0x07, 0xf1, 0xce, 0xb9,
+	0x96, 0xcc, 0x9e, 0xa9, 0x2f, 0xff, 0x32, 0xd0, 0x6f, 0x3a, 0x2e, 0x6b, 0x75, 0x0e, 0xaa, 0x16,
+	0xf1, 0xa2, 0x7e, 0x46, 0x9f, 0x15, 0x6a, 0x1f, 0x19, 0xac, 0x1f, 0x60, 0x5a, 0xdd, 0xf6, 0x99,
+	0x99, 0x76, 0x10, 0xdd, 0xe5, 0x6b, 0x61, 0x11, 0xc4, 0x1d, 0x44, 0x45, 0x95, 0x6a, 0x3d, 0x77,
+	0x3c, 0xd0, 0xd3, 0xf7, 0x11, 0xdd, 0x71, 0x3d, 0x97, 0x99, 0xdc, 0x01, 0xe7, 0x41, 0x8c, 0x91,
+	0xa8, 0xc6, 0x18, 0x23, 0xf0, 0x01, 0x48, 0x74, 0x51, 0xbb, 0x83, 0xb5, 0x84, 0x48, 0xfa, 0xf6,
+	0xe5, 0x93, 0x1e, 0x0f, 0xf4, 0xe4, 0xba, 0x47, 0x3a, 0x3e, 0x33, 0x65, 0x08, 0xde, 0x01, 0xd1,
+	0xe7, 0x64, 0x49, 0xa9, 0xe4, 0xa2, 0x8e, 0xe6, 0x80, 0xd2, 0xd5, 0x52, 0x62, 0x42, 0xe9, 0x72,
+	0x2b, 0xd4, 0xd2, 0xd2, 0x0a, 0xb9, 0x45, 0xb5, 0x8c, 0xb4, 0xe8, 0xda, 0x3c, 0xef, 0xd5, 0x0f,
+	0x4f, 0x56, 0x92, 0xcd, 0xde, 0x26, 0x62, 0xa8, 0xfc, 0x67, 0x1c, 0xe4, 0xd6, 0x2d, 0x0b, 0x53,
+	0xba, 0xe3, 0x52, 0xd6, 0xec, 0xc1, 0x47, 0x20, 0x6d, 0xb5, 0x90, 0xeb, 0xef, 0xbb, 0xb6, 0x68,
+	0x5e, 0xa6, 0xfe, 0xce, 0x3f, 0xaa, 0x36, 0xb5, 0xc1, 0x57, 0x6f, 0x6f, 0x9e, 0x0e, 0xf4, 0x94,
+	0x25, 0x87, 0x66, 0x34, 0xb0, 0xc7, 0xb4, 0xc4, 0xce, 0xa5, 0x25, 0xfe, 0xef, 0x69, 0x51, 0x5f,
+	0x4c, 0x4b, 0x62, 0x9a, 0x96, 0xe4, 0xcb, 0xa3, 0x25, 0x75, 0x86, 0x96, 0x47, 0x20, 0x8d, 0x44,
+	0x6f, 0x31, 0xd5, 0xd2, 0xa5, 0x78, 0x25, 0x5b, 0x7b, 0xa3, 0xfa, 0xfc, 0x41, 0xaf, 0xca, 0xee,
+	0x37, 0x3b, 0x41, 0x1b, 0xd7, 0x4b, 0x4f, 0x07, 0xfa, 0xdc, 0xe9, 0x40, 0x07, 0x68, 0x44, 0xc9,
+	0x57, 0xbf, 0xea, 0x60, 0x4c, 0x90, 0x39, 0x0a, 0x28, 0x39, 0xcf, 0x4c, 0x70, 0x0e, 0x26, 0x38,
+	0xcf, 0x9e, 0xc7, 0xf9, 0x77, 0x2a, 0xc8, 0x6d, 0xf6, 0x7d, 0xe4, 0xb9, 0xd6, 0x3d, 0x8c, 0xff,
+	0x1f, 0xce, 0x1f, 0x80, 0x2c, 0xe7, 0x9c, 0xb9, 0xc1, 0xbe, 0x85, 0x82, 0x2b, 0xb0, 0xce, 0x25,
+	0xd3, 0x74, 0x83, 0x0d, 0x14, 0x0c, 0x63, 0x1d, 0x62, 0x2c, 0x62, 0xa9, 0x57, 0x8a, 0x75, 0x0f,
+	0x63, 0x1e, 0x2b, 0x92, 0x50, 0xe2, 0xc5, 0x12, 0x4a, 0x4e, 0x4b, 0x28, 0xf5, 0xf2, 0x24, 0x94,
+	0x3e, 0x47, 0x42, 0x99, 0xff, 0x44, 0x42, 0x60, 0x42, 0x42, 0xd9, 0x09, 0x09, 0xe5, 0xce, 0x93,
+	0x50, 0x19, 0x14, 0xb6, 0x7a, 0x0c, 0xfb, 0xd4, 0x25, 0xfe, 0x7b, 0x81, 0x78, 0x33, 0xc6, 0x4f,
+	0x41, 0x74, 0x21, 0x7f, 0xa9, 0x80, 0xc5, 0x89, 0x27, 0xc2, 0xc4, 0x34, 0x20, 0x3e, 0x15, 0x1b,
+	0x15, 0xb7, 0xbc, 0x22, 0x2f, 0x71, 0x3e, 0x86, 0xb7, 0x80, 0xda, 0x26, 0x0e, 0xd5, 0x62, 0x62,
+	0x93, 0x8b, 0xd3, 0x9b, 0xdc, 0x21, 0x8e, 0x29, 0x20, 0xf0, 0x1a, 0x88, 0x87, 0x98, 0x09, 0xcd,
+	0xe4, 0x4c, 0x3e, 0x84, 0x79, 0x90, 0xee, 0x7a, 0xfb, 0x38, 0x0c, 0x49, 0x18, 0xdd, 0xba, 0xa9,
+	0xae, 0xb7, 0xc5, 0x4d, 0xee, 0xe2, 0xe2, 0xe8, 0x50, 0x6c, 0x4b, 0x56, 0xcd, 0x94, 0x83, 0xe8,
+	0x43, 0x8a, 0xed, 0xa8, 0xcc, 0xcf, 0x14, 0xf0, 0x6a, 0x83, 0x3a, 0x0f, 0x03, 0x1b, 0x31, 0xbc,
+	0x8b, 0x42, 0xe4, 0x51, 0x78, 0x17, 0x64, 0x50, 0x87, 0xb5, 0x48, 0xe8, 0xb2, 0x7e, 0x74, 0x22,
+	0xb4, 0x1f, 0x9f, 0xac, 0x2c, 0x44, 0xaf, 0xed, 0xba, 0x6d, 0x87, 0x98, 0xd2, 0x3d, 0x16, 0xba,
+	0xbe, 0x63, 0x8e, 0xa1, 0xf0, 0x2e, 0x48, 0x06, 0x22, 0x82, 0x10, 0x7b, 0xb6, 0xa6, 0x4d, 0x6f,
+	0x43, 0x66, 0xa8, 0xab, 0x9c, 0x26, 0x33, 0x42, 0xaf, 0xcd, 0x3f, 0xfe, 0xe3, 0xeb, 0xe5, 0x71,
+	0x9c, 0x72, 0x1e, 0x5c, 0x7f, 0xae, 0xa4, 0x61, 0xef, 0xca, 0x0c, 0x2c, 0x34, 0xa8, 0xb3, 0xed,
+	0x53, 0x86, 0xda, 0xed, 0xdd, 0x10, 0xdb, 0x38, 0x68, 0x93, 0xfe, 0xd5, 0x4b, 0xe6, 0xc7, 0x13,
+	0x79, 0x58, 0x36, 0x3e, 0x63, 0x4a, 0x63, 0xaa, 0xa0, 0x22, 0x58, 0x9a, 0x95, 0x75, 0x54, 0xd5,
+	0xb7, 0x0a, 0xd0, 0x1a, 0xd4, 0xd9, 0xc3, 0x6c, 0x8f, 0x4f, 0x90, 0x10, 0xdb, 0x1b, 0xc4, 0x67,
+	0x21, 0xb2, 0x18, 0x85, 0x35, 0x90, 0xa2, 0x72, 0xf6, 0xc2, 0xc2, 0x86, 0x40, 0xb8, 0x04, 0x32,
+	0xd6, 0x30, 0x40, 0x54, 0xda, 0x78, 0x02, 0xae, 0x83, 0x64, 0x9b, 0x9f, 0x49, 0xf9, 0x04, 0x67,
+	0x6b, 0x6f, 0x4e, 0xf7, 0x39, 0xaa, 0x83, 0xb6, 0xdc, 0x40, 0x1c, 0xdf, 0x51, 0xcb, 0xe5, 0xc2,
+	0xb5, 0x1c, 0xdf, 0xe1, 0x30, 0x5d, 0xb9, 0x0c, 0x4a, 0xe7, 0x95, 0x3f, 0xdc, 0x63, 0xed, 0xfb,
+	0x38, 0x88, 0x37, 0xa8, 0x03, 0xfb, 0x00, 0x9c, 0xf9, 0xed, 0xd1, 0xa7, 0x53, 0x4f, 0x88, 0xbe,
+	0xf0, 0xd6, 0x05, 0x80, 0x51, 0x0f, 0x6f, 0x3c, 0xfe, 0xe9, 0xf7, 0xcf, 0x63, 0xaf, 0x97, 0xf3,
+	0xfc, 0xaf, 0x8d, 0xd0, 0xd1, 0x2f, 0x5c, 0x84, 0xdc, 0x67, 0x3d, 0xf8, 0x01, 0xc8, 0x4d, 0xe8,
+	0xf4, 0xc6, 0xcc, 0xd8, 0x67, 0x21, 0x85, 0x5b, 0x17, 0x42, 0x46, 0xc7, 0xf2, 0x08, 0xbc, 0x36,
+	0xad, 0xab, 0x9b, 0x33, 0xd7, 0x4f, 0xe1, 0x0a, 0xd5, 0xcb, 0xe1, 0x46, 0xc9, 0x3e, 0x04, 0x8b,
+	0xb3, 0xd5, 0xb2, 0x3c, 0x33, 0xd0, 0x4c, 0x6c, 0xa1, 0x76, 0x79, 0xec, 0x30, 0x71, 0xbd, 0xf1,
+	0xf4, 0xb8, 0xa8, 0x3c, 0x3b, 0x2e, 0x2a, 0xbf, 0x1d, 0x17, 0x95, 0x4f, 0x4f, 0x8a, 0x73, 0xcf,
+	0x4e, 0x8a, 0x73, 0x3f, 0x9f, 0x14, 0xe7, 0xde, 0xbf, 0x73, 0xe6, 0xe2, 0x7e, 0x17, 0x85, 0x61,
+	0xbf, 0xee, 0xfa, 0xb5, 0xdb, 0xb7, 0x6b, 0xc6, 0x11, 0x72, 0x43, 0x42, 0xc5, 0x83, 0x66, 0x74,
+	0x57, 0x6b, 0x46, 0x4f, 0x70, 0x23, 0x6e, 0xf2, 0x83, 0xa4, 0xf8, 0xc1, 0xbd, 0xf3, 0xf7, 0x00,
+	0xc1, 0x54, 0x59, 0x71, 0xdd, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -536,6 +748,12 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// InstallPredeploys defines a governance operation for installing well-known
+	// predeploys at their canonical addresses.
+	InstallPredeploys(ctx context.Context, in *MsgInstallPredeploys, opts ...grpc.CallOption) (*MsgInstallPredeploysResponse, error)
+	// SetSponsoredContracts defines a method for a sponsor account to set the
+	// contracts whose calls it pays the gas for.
+	SetSponsoredContracts(ctx context.Context, in *MsgSetSponsoredContracts, opts ...grpc.CallOption) (*MsgSetSponsoredContractsResponse, error)
 }
 
 type msgClient struct {
@@ -564,6 +782,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) InstallPredeploys(ctx context.Context, in *MsgInstallPredeploys, opts ...grpc.CallOption) (*MsgInstallPredeploysResponse, error) {
+	out := new(MsgInstallPredeploysResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/InstallPredeploys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSponsoredContracts(ctx context.Context, in *MsgSetSponsoredContracts, opts ...grpc.CallOption) (*MsgSetSponsoredContractsResponse, error) {
+	out := new(MsgSetSponsoredContractsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/SetSponsoredContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -571,6 +807,12 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// InstallPredeploys defines a governance operation for installing well-known
+	// predeploys at their canonical addresses.
+	InstallPredeploys(context.Context, *MsgInstallPredeploys) (*MsgInstallPredeploysResponse, error)
+	// SetSponsoredContracts defines a method for a sponsor account to set the
+	// contracts whose calls it pays the gas for.
+	SetSponsoredContracts(context.Context, *MsgSetSponsoredContracts) (*MsgSetSponsoredContractsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +825,12 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) InstallPredeploys(ctx context.Context, req *MsgInstallPredeploys) (*MsgInstallPredeploysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallPredeploys not implemented")
+}
+func (*UnimplementedMsgServer) SetSponsoredContracts(ctx context.Context, req *MsgSetSponsoredContracts) (*MsgSetSponsoredContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsoredContracts not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -624,6 +872,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InstallPredeploys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstallPredeploys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstallPredeploys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/InstallPredeploys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstallPredeploys(ctx, req.(*MsgInstallPredeploys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSponsoredContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsoredContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsoredContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/SetSponsoredContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsoredContracts(ctx, req.(*MsgSetSponsoredContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -636,6 +920,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "InstallPredeploys",
+			Handler:    _Msg_InstallPredeploys_Handler,
+		},
+		{
+			MethodName: "SetSponsoredContracts",
+			Handler:    _Msg_SetSponsoredContracts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1185,55 +1477,189 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgInstallPredeploys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgInstallPredeploys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallPredeploys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 9
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallPredeploysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LegacyTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgInstallPredeploysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallPredeploysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsoredContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsoredContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsoredContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.GasLimit != 0 {
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsoredContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsoredContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsoredContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 9
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LegacyTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
 	}
 	l = len(m.To)
@@ -1436,6 +1862,64 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgInstallPredeploys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInstallPredeploysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSponsoredContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Limits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSponsoredContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3179,6 +3663,367 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgInstallPredeploys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstallPredeploys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstallPredeploys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstallPredeploysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstallPredeploysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstallPredeploysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSponsoredContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsoredContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsoredContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSponsoredContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsoredContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsoredContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
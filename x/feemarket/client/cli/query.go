@@ -23,6 +23,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetBurnedSupplyCmd(),
 	)
 	return cmd
 }
@@ -114,3 +115,30 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBurnedSupplyCmd queries the cumulative amount of fees burned
+func GetBurnedSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-supply",
+		Short: "Get the cumulative amount of fees burned by the fee market",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedSupply(cmd.Context(), &types.QueryBurnedSupplyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
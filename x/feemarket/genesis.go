@@ -21,6 +21,7 @@ func InitGenesis(
 	}
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
+	k.SetBurnedSupply(ctx, data.BurnedSupply)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -28,7 +29,8 @@ func InitGenesis(
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:       k.GetParams(ctx),
+		BlockGas:     k.GetBlockGasWanted(ctx),
+		BurnedSupply: k.GetBurnedSupply(ctx),
 	}
 }
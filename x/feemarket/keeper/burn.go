@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Base Fee Burn
+// The configured share of the base fee paid by a transaction is burned from the
+// fee collector when the transaction fee is settled.
+// ----------------------------------------------------------------------------
+
+// GetBurnedSupply returns the cumulative amount of fees burned by the module.
+func (k Keeper) GetBurnedSupply(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurnedSupply)
+	return getCoins(store)
+}
+
+// SetBurnedSupply sets the cumulative amount of fees burned by the module.
+// CONTRACT: this should be only called during InitGenesis.
+func (k Keeper) SetBurnedSupply(ctx sdk.Context, burned sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurnedSupply)
+	for _, coin := range burned {
+		setAmount(store, coin.Denom, coin.Amount)
+	}
+}
+
+// BurnBaseFee burns the configured share of the base fee paid by a transaction
+// from the fee collector. The burn is capped by the fees of the transaction
+// left once the payouts taken from them are deducted, both are computed from
+// the fee of the transaction, so that the fees of the other transactions,
+// including their priority tips, are left to be distributed to the validators.
+func (k Keeper) BurnBaseFee(ctx sdk.Context, baseFeePaid, feesLeft sdk.Coins) (sdk.Coins, error) {
+	ratio := k.GetParams(ctx).BaseFeeBurnRatio
+	if !ratio.IsPositive() {
+		return nil, nil
+	}
+
+	burn := sdk.Coins{}
+	for _, paid := range baseFeePaid {
+		amount := ratio.MulInt(paid.Amount).TruncateInt()
+		if left := feesLeft.AmountOf(paid.Denom); amount.GT(left) {
+			k.Logger(ctx).Info(
+				"base fee burn capped by the fees left",
+				"denom", paid.Denom,
+				"amount", amount.String(),
+				"fees-left", left.String(),
+			)
+			amount = left
+		}
+		if amount.IsPositive() {
+			burn = burn.Add(sdk.NewCoin(paid.Denom, amount))
+		}
+	}
+
+	if burn.IsZero() {
+		return nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, burn); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurnedSupply)
+	addCoins(store, burn)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurnBaseFee,
+		sdk.NewAttribute(types.AttributeKeyAmount, burn.String()),
+	))
+
+	return burn, nil
+}
+
+// getCoins returns the coins stored by denomination in the given store.
+func getCoins(store prefix.Store) sdk.Coins {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	coins := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		coins = coins.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return coins
+}
+
+// addCoins adds the given coins to the amounts stored by denomination.
+func addCoins(store prefix.Store, coins sdk.Coins) {
+	for _, coin := range coins {
+		amount := coin.Amount
+		if bz := store.Get([]byte(coin.Denom)); len(bz) > 0 {
+			var current sdkmath.Int
+			if err := current.Unmarshal(bz); err != nil {
+				panic(err)
+			}
+			amount = amount.Add(current)
+		}
+		setAmount(store, coin.Denom, amount)
+	}
+}
+
+// setAmount stores the amount of a single denomination.
+func setAmount(store prefix.Store, denom string, amount sdkmath.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
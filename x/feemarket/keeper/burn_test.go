@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/HarryBin2002/kairoschain/v12/testutil"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+	"github.com/HarryBin2002/kairoschain/v12/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestBurnBaseFee() {
+	denom := evmtypes.DefaultEVMDenom
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name      string
+		ratio     sdk.Dec
+		paid      int64
+		feesLeft  int64
+		expBurned int64
+	}{
+		{
+			"burn disabled",
+			sdk.ZeroDec(),
+			600,
+			1000,
+			0,
+		},
+		{
+			"no base fee paid",
+			sdk.OneDec(),
+			0,
+			1000,
+			0,
+		},
+		{
+			"burn the whole base fee",
+			sdk.OneDec(),
+			600,
+			1000,
+			600,
+		},
+		{
+			"burn half of the base fee",
+			sdk.NewDecWithPrec(5, 1),
+			601,
+			1000,
+			300,
+		},
+		{
+			"burn capped by the fees left by the tx",
+			sdk.OneDec(),
+			600,
+			400,
+			400,
+		},
+		{
+			"no fees left by the tx",
+			sdk.OneDec(),
+			600,
+			0,
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.BaseFeeBurnRatio = tc.ratio
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			// the fee collector holds the fees of other txs too
+			collected := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000+tc.feesLeft))
+			err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, collected)
+			suite.Require().NoError(err)
+
+			collectorBefore := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom)
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+
+			burned, err := suite.app.FeeMarketKeeper.BurnBaseFee(
+				suite.ctx,
+				sdk.NewCoins(sdk.NewInt64Coin(denom, tc.paid)),
+				sdk.NewCoins(sdk.NewInt64Coin(denom, tc.feesLeft)),
+			)
+			suite.Require().NoError(err)
+
+			expBurned := sdkmath.NewInt(tc.expBurned)
+			suite.Require().True(expBurned.Equal(burned.AmountOf(denom)))
+			collectorAfter := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom)
+			suite.Require().True(collectorBefore.Amount.Sub(expBurned).Equal(collectorAfter.Amount))
+			supplyAfter := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+			suite.Require().True(supplyBefore.Amount.Sub(expBurned).Equal(supplyAfter.Amount))
+			suite.Require().True(expBurned.Equal(suite.app.FeeMarketKeeper.GetBurnedSupply(suite.ctx).AmountOf(denom)))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBurnedSupplyAccumulates() {
+	suite.SetupTest()
+	denom := evmtypes.DefaultEVMDenom
+
+	for i := 0; i < 2; i++ {
+		fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+		err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, fees)
+		suite.Require().NoError(err)
+		_, err = suite.app.FeeMarketKeeper.BurnBaseFee(suite.ctx, fees, fees)
+		suite.Require().NoError(err)
+		suite.Commit()
+	}
+
+	res, err := suite.queryClient.BurnedSupply(suite.ctx.Context(), &types.QueryBurnedSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 200)), res.BurnedSupply)
+}
@@ -50,3 +50,12 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// BurnedSupply implements the Query/BurnedSupply gRPC method
+func (k Keeper) BurnedSupply(c context.Context, _ *types.QueryBurnedSupplyRequest) (*types.QueryBurnedSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedSupplyResponse{
+		BurnedSupply: k.GetBurnedSupply(ctx),
+	}, nil
+}
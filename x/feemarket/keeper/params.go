@@ -27,6 +27,10 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		params.MinGasMultiplier = sdk.ZeroDec()
 	}
 
+	if params.BaseFeeBurnRatio.IsNil() {
+		params.BaseFeeBurnRatio = sdk.ZeroDec()
+	}
+
 	return
 }
 
@@ -43,6 +43,14 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// base_fee_burn_ratio defines the share of the base fee paid by transactions
+	// that is burned when the transaction fee is settled. The remainder, together
+	// with the priority tip, stays in the fee collector and is distributed as
+	// rewards.
+	BaseFeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_burn_ratio"`
+	// burn_cosmos_tx_fees toggles whether the base fee part of Cosmos transaction
+	// fees is burned as well. Only EVM transaction fees are burned otherwise.
+	BurnCosmosTxFees bool `protobuf:"varint,10,opt,name=burn_cosmos_tx_fees,json=burnCosmosTxFees,proto3" json:"burn_cosmos_tx_fees,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,6 +114,13 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBurnCosmosTxFees() bool {
+	if m != nil {
+		return m.BurnCosmosTxFees
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
 }
@@ -115,32 +130,36 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xad, 0xa5, 0x4d, 0x5d, 0x75, 0x81, 0x20, 0xba, 0x61, 0x36, 0x70, 0xcd, 0x06, 0xc5,
-	0x97, 0xd9, 0x78, 0x3d, 0xef, 0xe2, 0xb5, 0xdb, 0xba, 0x31, 0x28, 0x3e, 0x8e, 0x81, 0x90, 0xdd,
-	0x57, 0x5b, 0xd4, 0x92, 0x8c, 0xa5, 0x9a, 0xe5, 0x5b, 0xec, 0x63, 0xe5, 0x98, 0xd3, 0x18, 0x3b,
-	0x84, 0x91, 0x7c, 0x91, 0x11, 0x3b, 0xb1, 0x73, 0x5d, 0x4f, 0xb6, 0xde, 0xff, 0xaf, 0xdf, 0x7b,
-	0x92, 0xfe, 0xf8, 0x1c, 0x4c, 0x01, 0xb5, 0xe0, 0xd2, 0x84, 0x77, 0x00, 0x82, 0xd5, 0xf7, 0x60,
-	0xc2, 0x26, 0x1a, 0x16, 0x41, 0x55, 0x2b, 0xa3, 0xc8, 0xf3, 0xde, 0x17, 0x0c, 0x52, 0x13, 0xbd,
-	0x38, 0xcd, 0x55, 0xae, 0x5a, 0x4b, 0xb8, 0xf9, 0xeb, 0xdc, 0xaf, 0x7e, 0x8d, 0xf0, 0xf8, 0x86,
-	0xd5, 0x4c, 0x68, 0xe2, 0xe2, 0x13, 0xa9, 0x68, 0xca, 0x34, 0xd0, 0x3b, 0x00, 0x07, 0x79, 0xc8,
-	0xb7, 0x93, 0x63, 0xa9, 0x62, 0xa6, 0xe1, 0x03, 0x00, 0x79, 0x87, 0x5f, 0xee, 0x44, 0x9a, 0x15,
-	0x4c, 0xe6, 0x40, 0x6f, 0x41, 0x2a, 0xc1, 0x25, 0x33, 0xaa, 0x76, 0x9e, 0x78, 0xc8, 0x9f, 0x24,
-	0x4e, 0xda, 0xb9, 0xdf, 0xb7, 0x86, 0xcb, 0x41, 0x27, 0x17, 0xf8, 0x19, 0x94, 0x4c, 0x1b, 0x9e,
-	0x71, 0x33, 0xa3, 0xe2, 0xa1, 0x34, 0xbc, 0x2a, 0x39, 0xd4, 0xce, 0xa8, 0xdd, 0x78, 0x3a, 0x88,
-	0x5f, 0x7b, 0x8d, 0xbc, 0xc6, 0x13, 0x90, 0x2c, 0x2d, 0x81, 0x16, 0xc0, 0xf3, 0xc2, 0x38, 0x87,
-	0x1e, 0xf2, 0x47, 0xc9, 0xd3, 0xae, 0xf8, 0xa9, 0xad, 0x91, 0x6b, 0x6c, 0xf7, 0x53, 0x8f, 0x3d,
-	0xe4, 0x1f, 0xc7, 0xc1, 0x7c, 0x79, 0x66, 0xfd, 0x59, 0x9e, 0x9d, 0xe7, 0xdc, 0x14, 0x0f, 0x69,
-	0x90, 0x29, 0x11, 0x66, 0x4a, 0x0b, 0xa5, 0xb7, 0x9f, 0x37, 0xfa, 0xf6, 0x3e, 0x34, 0xb3, 0x0a,
-	0x74, 0x70, 0x2d, 0x4d, 0x72, 0xb4, 0x9d, 0x9a, 0x24, 0x78, 0x22, 0xb8, 0xa4, 0x39, 0xd3, 0xb4,
-	0xaa, 0x79, 0x06, 0xce, 0xd1, 0x7f, 0xf3, 0x2e, 0x21, 0x4b, 0x4e, 0x04, 0x97, 0x1f, 0x99, 0xbe,
-	0xd9, 0x20, 0xc8, 0x77, 0x4c, 0x76, 0xcc, 0xbd, 0x53, 0xdb, 0x8f, 0x02, 0x4f, 0x3b, 0xf0, 0x70,
-	0x43, 0x9f, 0x0f, 0xec, 0x83, 0xe9, 0x61, 0x32, 0xe5, 0x92, 0x1b, 0xce, 0xca, 0xfe, 0xf9, 0xe2,
-	0x2f, 0xf3, 0x95, 0x8b, 0x16, 0x2b, 0x17, 0xfd, 0x5d, 0xb9, 0xe8, 0xe7, 0xda, 0xb5, 0x16, 0x6b,
-	0xd7, 0xfa, 0xbd, 0x76, 0xad, 0x6f, 0xd1, 0x5e, 0xaf, 0x2b, 0x9d, 0x31, 0x19, 0x5f, 0x85, 0xd0,
-	0x6c, 0xa3, 0xd5, 0x44, 0x6f, 0xc3, 0x1f, 0x7b, 0x01, 0x6b, 0x5b, 0xa7, 0xe3, 0x36, 0x2c, 0x17,
-	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x90, 0x5c, 0x00, 0x6e, 0x84, 0x02, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6a, 0xdb, 0x4c,
+	0x14, 0x85, 0xad, 0x3f, 0x8e, 0x63, 0x4f, 0x7e, 0x83, 0x99, 0xa4, 0x45, 0xb4, 0xa0, 0x98, 0x16,
+	0x82, 0x37, 0x91, 0x62, 0x67, 0xd5, 0x45, 0x37, 0x4a, 0x48, 0x93, 0x42, 0x21, 0xa8, 0x5d, 0x95,
+	0x96, 0x61, 0xa4, 0xdc, 0x48, 0x17, 0x4b, 0x33, 0x66, 0x66, 0x6c, 0xec, 0xb7, 0xe8, 0x63, 0x65,
+	0x99, 0x65, 0xe9, 0x22, 0x14, 0xbb, 0x0f, 0x52, 0x34, 0x72, 0x64, 0x6f, 0x9b, 0x95, 0xa4, 0x7b,
+	0x8e, 0x3e, 0x1d, 0xdd, 0x39, 0xe4, 0x18, 0x4c, 0x06, 0xaa, 0x40, 0x61, 0x82, 0x3b, 0x80, 0x82,
+	0xab, 0x31, 0x98, 0x60, 0x36, 0xdc, 0x3c, 0xf8, 0x13, 0x25, 0x8d, 0xa4, 0x2f, 0x6b, 0x9f, 0xbf,
+	0x91, 0x66, 0xc3, 0x57, 0x87, 0xa9, 0x4c, 0xa5, 0xb5, 0x04, 0xe5, 0x5d, 0xe5, 0x7e, 0xf3, 0xa7,
+	0x49, 0x5a, 0x37, 0x5c, 0xf1, 0x42, 0x53, 0x8f, 0xec, 0x0b, 0xc9, 0x62, 0xae, 0x81, 0xdd, 0x01,
+	0xb8, 0x4e, 0xdf, 0x19, 0xb4, 0xa3, 0x8e, 0x90, 0x21, 0xd7, 0x70, 0x09, 0x40, 0xdf, 0x93, 0xd7,
+	0x4f, 0x22, 0x4b, 0x32, 0x2e, 0x52, 0x60, 0xb7, 0x20, 0x64, 0x81, 0x82, 0x1b, 0xa9, 0xdc, 0xff,
+	0xfa, 0xce, 0xa0, 0x1b, 0xb9, 0x71, 0xe5, 0x3e, 0xb7, 0x86, 0x8b, 0x8d, 0x4e, 0xcf, 0xc8, 0x0b,
+	0xc8, 0xb9, 0x36, 0x98, 0xa0, 0x59, 0xb0, 0x62, 0x9a, 0x1b, 0x9c, 0xe4, 0x08, 0xca, 0xdd, 0xb1,
+	0x2f, 0x1e, 0x6e, 0xc4, 0x4f, 0xb5, 0x46, 0xdf, 0x92, 0x2e, 0x08, 0x1e, 0xe7, 0xc0, 0x32, 0xc0,
+	0x34, 0x33, 0xee, 0x6e, 0xdf, 0x19, 0xec, 0x44, 0xff, 0x57, 0xc3, 0x2b, 0x3b, 0xa3, 0xd7, 0xa4,
+	0x5d, 0xa7, 0x6e, 0xf5, 0x9d, 0x41, 0x27, 0xf4, 0xef, 0x1f, 0x8f, 0x1a, 0xbf, 0x1e, 0x8f, 0x8e,
+	0x53, 0x34, 0xd9, 0x34, 0xf6, 0x13, 0x59, 0x04, 0x89, 0xd4, 0x85, 0xd4, 0xeb, 0xcb, 0x89, 0xbe,
+	0x1d, 0x07, 0x66, 0x31, 0x01, 0xed, 0x5f, 0x0b, 0x13, 0xed, 0xad, 0x53, 0xd3, 0x88, 0x74, 0x0b,
+	0x14, 0x2c, 0xe5, 0x9a, 0x4d, 0x14, 0x26, 0xe0, 0xee, 0xfd, 0x33, 0xef, 0x02, 0x92, 0x68, 0xbf,
+	0x40, 0xf1, 0x81, 0xeb, 0x9b, 0x12, 0x41, 0xbf, 0x11, 0xfa, 0xc4, 0xdc, 0xfa, 0xeb, 0xf6, 0xb3,
+	0xc0, 0xbd, 0x0a, 0xbc, 0xb5, 0xa1, 0xef, 0xe4, 0xa0, 0x3e, 0x95, 0x78, 0xaa, 0x04, 0x53, 0xdc,
+	0xa0, 0x74, 0x3b, 0xcf, 0xc3, 0xaf, 0xf7, 0x10, 0x4e, 0x95, 0x88, 0x4a, 0x0e, 0x3d, 0x21, 0x07,
+	0x96, 0x5a, 0xd9, 0x99, 0x99, 0x97, 0x1f, 0xd2, 0x2e, 0xb1, 0xe5, 0xe8, 0x95, 0xd2, 0xb9, 0x55,
+	0xbe, 0xcc, 0x2f, 0x01, 0xf4, 0xc7, 0x66, 0xbb, 0xd9, 0xdb, 0x8d, 0x7a, 0x28, 0xd0, 0x20, 0xcf,
+	0xeb, 0x32, 0x85, 0x9f, 0xef, 0x97, 0x9e, 0xf3, 0xb0, 0xf4, 0x9c, 0xdf, 0x4b, 0xcf, 0xf9, 0xb1,
+	0xf2, 0x1a, 0x0f, 0x2b, 0xaf, 0xf1, 0x73, 0xe5, 0x35, 0xbe, 0xbe, 0xdb, 0x8a, 0x76, 0xc5, 0x95,
+	0x5a, 0x84, 0x28, 0x46, 0xa7, 0xa7, 0xa3, 0x60, 0xcc, 0x51, 0x49, 0x9d, 0x64, 0x1c, 0x45, 0x30,
+	0x1b, 0x8e, 0x82, 0xf9, 0x56, 0xed, 0x6d, 0xe2, 0xb8, 0x65, 0x2b, 0x7c, 0xf6, 0x77, 0x00, 0x07,
+	0xdb, 0x3f, 0xd4, 0x1a, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +182,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnCosmosTxFees {
+		i--
+		if m.BurnCosmosTxFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -256,6 +295,11 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeBurnRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BurnCosmosTxFees {
+		n += 2
+	}
 	return n
 }
 
@@ -473,6 +517,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnCosmosTxFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnCosmosTxFees = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
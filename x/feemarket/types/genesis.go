@@ -1,10 +1,17 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		BlockGas: 0,
+		Params:       DefaultParams(),
+		BlockGas:     0,
+		BurnedSupply: sdk.Coins{},
 	}
 }
 
@@ -19,5 +26,9 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.BurnedSupply.Validate(); err != nil {
+		return fmt.Errorf("invalid burned supply: %w", err)
+	}
+
 	return gs.Params.Validate()
 }
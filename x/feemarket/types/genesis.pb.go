@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -30,6 +32,8 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// burned_supply is the cumulative amount of fees burned by the module.
+	BurnedSupply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=burned_supply,json=burnedSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_supply"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,13 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBurnedSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedSupply
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
 }
@@ -88,23 +99,29 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc3, 0xa1, 0x1b, 0xa1,
-	0x08, 0xac, 0x5f, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a,
-	0x95, 0x5c, 0x3c, 0xee, 0x10, 0x6b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c, 0xb8, 0xd8, 0x0a,
-	0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0xb0, 0x5b,
-	0xab, 0x17, 0x00, 0x56, 0xe5, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x34,
-	0x17, 0x67, 0x52, 0x4e, 0x7e, 0x72, 0x76, 0x7c, 0x7a, 0x62, 0xb1, 0x04, 0xb3, 0x02, 0xa3, 0x06,
-	0x4b, 0x10, 0x07, 0x58, 0xc0, 0x3d, 0xb1, 0xd8, 0x8b, 0x85, 0x83, 0x49, 0x80, 0x39, 0x88, 0x23,
-	0x29, 0xb1, 0x38, 0x35, 0x3e, 0x2d, 0x35, 0xd5, 0xc9, 0xfb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x5d, 0x8b, 0x93, 0x13, 0xf3, 0x9c, 0x5c, 0xf5, 0x53, 0xcb, 0xa0, 0x9e, 0x2c, 0x33, 0x34, 0xd2,
-	0xaf, 0x40, 0xf2, 0x6a, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x3b, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xa2, 0xd2, 0xef, 0x70, 0x4c, 0x01, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0x93, 0xdb, 0xa8, 0xea, 0x4d, 0x7b, 0xa5, 0xab, 0x08, 0xa1, 0x52, 0x24, 0xb7, 0x42,
+	0x08, 0x65, 0xc1, 0x6e, 0xc2, 0x84, 0xc4, 0x14, 0x86, 0x22, 0x26, 0x94, 0x6e, 0x2c, 0x95, 0x93,
+	0xba, 0xa9, 0x95, 0xc6, 0x8e, 0x6c, 0x37, 0xa2, 0x6f, 0xc1, 0x73, 0xf0, 0x24, 0x1d, 0x3b, 0x32,
+	0x01, 0x6a, 0x47, 0x5e, 0x02, 0xc5, 0x89, 0x4a, 0x07, 0x98, 0x7c, 0x74, 0xfc, 0xff, 0xe7, 0xff,
+	0xec, 0x63, 0x9f, 0x13, 0x35, 0x27, 0x22, 0xa3, 0x4c, 0xa1, 0x19, 0x21, 0x19, 0x16, 0x29, 0x51,
+	0xa8, 0xf0, 0x50, 0x42, 0x18, 0x91, 0x54, 0xc2, 0x5c, 0x70, 0xc5, 0x9d, 0xe3, 0xbd, 0x0a, 0xee,
+	0x55, 0xb0, 0xf0, 0x7a, 0x17, 0xbf, 0xb8, 0xbf, 0x45, 0xda, 0xdf, 0x03, 0x31, 0x97, 0x19, 0x97,
+	0x28, 0xc2, 0x92, 0xa0, 0xc2, 0x8b, 0x88, 0xc2, 0x1e, 0x8a, 0x39, 0x65, 0xf5, 0xfd, 0x51, 0xc2,
+	0x13, 0xae, 0x4b, 0x54, 0x56, 0x55, 0xf7, 0xec, 0xd3, 0xb4, 0x3b, 0xa3, 0x8a, 0x63, 0xac, 0xb0,
+	0x22, 0xce, 0x8d, 0xdd, 0xcc, 0xb1, 0xc0, 0x99, 0xec, 0x9a, 0x03, 0xd3, 0x6d, 0xfb, 0x00, 0xfe,
+	0xcc, 0x05, 0x1f, 0xb4, 0x2a, 0xb0, 0xd6, 0x6f, 0x7d, 0x23, 0xac, 0x3d, 0xce, 0xa9, 0xfd, 0x37,
+	0x5a, 0xf0, 0x38, 0x9d, 0x24, 0x58, 0x76, 0x1b, 0x03, 0xd3, 0xb5, 0xc2, 0x96, 0x6e, 0x8c, 0xb0,
+	0x74, 0x72, 0xfb, 0x5f, 0xb4, 0x14, 0x8c, 0x4c, 0x27, 0x72, 0x99, 0xe7, 0x8b, 0x55, 0xd7, 0x1a,
+	0x34, 0xdc, 0xb6, 0x7f, 0x02, 0x2b, 0x72, 0x58, 0x92, 0xc3, 0x9a, 0x1c, 0xde, 0x72, 0xca, 0x82,
+	0x61, 0x39, 0xfc, 0xe5, 0xbd, 0xef, 0x26, 0x54, 0xcd, 0x97, 0x11, 0x8c, 0x79, 0x86, 0xea, 0x67,
+	0x56, 0xc7, 0xa5, 0x9c, 0xa6, 0x48, 0xad, 0x72, 0x22, 0xb5, 0x41, 0x86, 0x9d, 0x2a, 0x61, 0xac,
+	0x03, 0xee, 0xad, 0xd6, 0x9f, 0xff, 0x8d, 0xb0, 0x55, 0x0e, 0x9e, 0xcc, 0x08, 0x09, 0xc6, 0xeb,
+	0x2d, 0x30, 0x37, 0x5b, 0x60, 0x7e, 0x6c, 0x81, 0xf9, 0xbc, 0x03, 0xc6, 0x66, 0x07, 0x8c, 0xd7,
+	0x1d, 0x30, 0x1e, 0xaf, 0x0f, 0x12, 0xee, 0xb0, 0x10, 0xab, 0x80, 0x32, 0x7f, 0x38, 0xf4, 0x51,
+	0x8a, 0xa9, 0xe0, 0x32, 0x9e, 0x63, 0xca, 0x50, 0xe1, 0xf9, 0xe8, 0xe9, 0x60, 0x0b, 0x3a, 0x38,
+	0x6a, 0xea, 0x9f, 0xbc, 0xfa, 0x1a, 0x00, 0x42, 0xbe, 0x13, 0xfa, 0xe7, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +144,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnedSupply) > 0 {
+		for iNdEx := len(m.BurnedSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -167,6 +198,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.BurnedSupply) > 0 {
+		for _, e := range m.BurnedSupply {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -257,6 +294,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedSupply = append(m.BurnedSupply, types.Coin{})
+			if err := m.BurnedSupply[len(m.BurnedSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,9 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/suite"
 )
 
@@ -30,6 +33,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
 			},
 			true,
 		},
@@ -41,6 +45,14 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			),
 			true,
 		},
+		{
+			"invalid burned supply",
+			&GenesisState{
+				Params:       DefaultParams(),
+				BurnedSupply: sdk.Coins{{Denom: "aevmos", Amount: sdkmath.NewInt(-1)}},
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
@@ -20,6 +20,7 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBurnedSupply
 )
 
 const (
@@ -29,6 +30,7 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBurnedSupply   = []byte{prefixBurnedSupply}
 )
 
 // Transient Store key prefixes
@@ -18,6 +18,10 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeBurnRatio is 1 (i.e the whole base fee is burned)
+	DefaultBaseFeeBurnRatio = sdk.OneDec()
+	// DefaultBurnCosmosTxFees is false
+	DefaultBurnCosmosTxFees = false
 )
 
 // Parameter keys
@@ -30,6 +34,8 @@ var (
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
 	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
 	ParamStoreKeyMinGasMultiplier         = []byte("MinGasMultiplier")
+	ParamStoreKeyBaseFeeBurnRatio         = []byte("BaseFeeBurnRatio")
+	ParamStoreKeyBurnCosmosTxFees         = []byte("BurnCosmosTxFees")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -47,6 +53,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyBaseFeeBurnRatio, &p.BaseFeeBurnRatio, validateBaseFeeBurnRatio),
+		paramtypes.NewParamSetPair(ParamStoreKeyBurnCosmosTxFees, &p.BurnCosmosTxFees, validateBool),
 	}
 }
 
@@ -59,6 +67,8 @@ func NewParams(
 	enableHeight int64,
 	minGasPrice sdk.Dec,
 	minGasPriceMultiplier sdk.Dec,
+	baseFeeBurnRatio sdk.Dec,
+	burnCosmosTxFees bool,
 ) Params {
 	return Params{
 		NoBaseFee:                noBaseFee,
@@ -68,6 +78,8 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		BaseFeeBurnRatio:         baseFeeBurnRatio,
+		BurnCosmosTxFees:         burnCosmosTxFees,
 	}
 }
 
@@ -81,6 +93,8 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
+		BurnCosmosTxFees:         DefaultBurnCosmosTxFees,
 	}
 }
 
@@ -102,6 +116,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBaseFeeBurnRatio(p.BaseFeeBurnRatio); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -202,3 +220,24 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateBaseFeeBurnRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("base fee burn ratio cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("base fee burn ratio cannot be greater than 1: %s", v)
+	}
+	return nil
+}
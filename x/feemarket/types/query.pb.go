@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -274,6 +275,90 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBurnedSupplyRequest defines the request type for querying the
+// cumulative burned fees.
+type QueryBurnedSupplyRequest struct {
+}
+
+func (m *QueryBurnedSupplyRequest) Reset()         { *m = QueryBurnedSupplyRequest{} }
+func (m *QueryBurnedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyRequest) ProtoMessage()    {}
+func (*QueryBurnedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyRequest.Merge(m, src)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyRequest proto.InternalMessageInfo
+
+// QueryBurnedSupplyResponse returns the cumulative amount of fees burned.
+type QueryBurnedSupplyResponse struct {
+	// burned_supply is the total amount of fees burned since genesis
+	BurnedSupply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned_supply,json=burnedSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_supply"`
+}
+
+func (m *QueryBurnedSupplyResponse) Reset()         { *m = QueryBurnedSupplyResponse{} }
+func (m *QueryBurnedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyResponse) ProtoMessage()    {}
+func (*QueryBurnedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyResponse.Merge(m, src)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedSupplyResponse) GetBurnedSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedSupply
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +366,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBurnedSupplyRequest)(nil), "ethermint.feemarket.v1.QueryBurnedSupplyRequest")
+	proto.RegisterType((*QueryBurnedSupplyResponse)(nil), "ethermint.feemarket.v1.QueryBurnedSupplyResponse")
 }
 
 func init() {
@@ -288,36 +375,44 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6b, 0xd4, 0x40,
-	0x14, 0xcf, 0xb8, 0xba, 0xad, 0xe3, 0x45, 0xc6, 0x6d, 0x91, 0xb0, 0xce, 0x4a, 0xd0, 0xa2, 0xd5,
-	0x66, 0xc8, 0x7a, 0xf5, 0x14, 0x58, 0x45, 0xbc, 0x68, 0xbc, 0x09, 0x52, 0x26, 0xf1, 0x35, 0x0d,
-	0xdb, 0x64, 0xd2, 0xcc, 0x6c, 0xb0, 0x57, 0xc1, 0x8b, 0x07, 0x11, 0xfc, 0x1c, 0x7e, 0x8f, 0x1e,
-	0x0b, 0x5e, 0xc4, 0x43, 0x91, 0x5d, 0x3f, 0x88, 0x64, 0x32, 0x59, 0x37, 0xba, 0x6b, 0xf7, 0x94,
-	0xe1, 0xe5, 0xf7, 0xde, 0xef, 0xcf, 0x9b, 0xc1, 0x0e, 0xa8, 0x43, 0x28, 0xd2, 0x24, 0x53, 0xec,
-	0x00, 0x20, 0xe5, 0xc5, 0x18, 0x14, 0x2b, 0x3d, 0x76, 0x3c, 0x81, 0xe2, 0xc4, 0xcd, 0x0b, 0xa1,
-	0x04, 0xd9, 0x9e, 0x63, 0xdc, 0x39, 0xc6, 0x2d, 0x3d, 0x7b, 0x67, 0x45, 0xef, 0x1f, 0x90, 0xee,
-	0xb7, 0x7b, 0xb1, 0x88, 0x85, 0x3e, 0xb2, 0xea, 0x64, 0xaa, 0xfd, 0x58, 0x88, 0xf8, 0x08, 0x18,
-	0xcf, 0x13, 0xc6, 0xb3, 0x4c, 0x28, 0xae, 0x12, 0x91, 0xc9, 0xfa, 0xaf, 0xd3, 0xc3, 0xe4, 0x65,
-	0x25, 0xe1, 0x05, 0x2f, 0x78, 0x2a, 0x03, 0x38, 0x9e, 0x80, 0x54, 0xce, 0x2b, 0x7c, 0xa3, 0x55,
-	0x95, 0xb9, 0xc8, 0x24, 0x90, 0xc7, 0xb8, 0x9b, 0xeb, 0xca, 0x4d, 0x74, 0x1b, 0xdd, 0xbb, 0x36,
-	0xa4, 0xee, 0x72, 0xc5, 0x6e, 0xdd, 0xe7, 0x5f, 0x3e, 0x3d, 0x1f, 0x58, 0x81, 0xe9, 0x71, 0xb6,
-	0xcc, 0x50, 0x9f, 0x4b, 0x78, 0x02, 0xd0, 0x70, 0xbd, 0xc1, 0xbd, 0x76, 0xd9, 0x90, 0x8d, 0xf0,
-	0x66, 0xc8, 0x25, 0xec, 0x1f, 0x00, 0x68, 0xba, 0xab, 0xfe, 0xee, 0x8f, 0xf3, 0xc1, 0x4e, 0x9c,
-	0xa8, 0xc3, 0x49, 0xe8, 0x46, 0x22, 0x65, 0x91, 0x90, 0xa9, 0x90, 0xe6, 0xb3, 0x27, 0xdf, 0x8e,
-	0x99, 0x3a, 0xc9, 0x41, 0xba, 0xcf, 0x32, 0x15, 0x6c, 0x84, 0xf5, 0x38, 0x67, 0xbb, 0x19, 0x7f,
-	0x24, 0xa2, 0xf1, 0x53, 0x3e, 0xb7, 0x78, 0x1f, 0x6f, 0xfd, 0x55, 0x37, 0xbc, 0xd7, 0x71, 0x27,
-	0xe6, 0xb5, 0xc3, 0x4e, 0x50, 0x1d, 0x87, 0x5f, 0x3b, 0xf8, 0x8a, 0xc6, 0x92, 0x0f, 0x08, 0x77,
-	0x6b, 0x6f, 0x64, 0x77, 0x95, 0xf7, 0x7f, 0xe3, 0xb4, 0x1f, 0xac, 0x85, 0xad, 0xf9, 0x1d, 0xe7,
-	0xfd, 0xb7, 0x5f, 0x5f, 0x2e, 0xf5, 0x89, 0xcd, 0xa0, 0xac, 0xec, 0xb5, 0x56, 0x5e, 0x47, 0x49,
-	0x3e, 0x22, 0xbc, 0x61, 0xf2, 0x22, 0xff, 0x1f, 0xde, 0x0e, 0xdb, 0x7e, 0xb8, 0x1e, 0xd8, 0x48,
-	0xb9, 0xa3, 0xa5, 0x50, 0xd2, 0x5f, 0x26, 0xa5, 0x59, 0x0e, 0xf9, 0x84, 0xf0, 0x66, 0x93, 0x22,
-	0xb9, 0x80, 0xa0, 0xbd, 0x04, 0x7b, 0x6f, 0x4d, 0xb4, 0xd1, 0x73, 0x57, 0xeb, 0x19, 0x90, 0x5b,
-	0x4b, 0xf5, 0x54, 0xe8, 0xfd, 0x98, 0x4b, 0xff, 0xf9, 0xe9, 0x94, 0xa2, 0xb3, 0x29, 0x45, 0x3f,
-	0xa7, 0x14, 0x7d, 0x9e, 0x51, 0xeb, 0x6c, 0x46, 0xad, 0xef, 0x33, 0x6a, 0xbd, 0xf6, 0x16, 0x6e,
-	0xcf, 0x48, 0x46, 0x3c, 0xf3, 0x47, 0x0c, 0x4a, 0xf3, 0xb6, 0x4a, 0x6f, 0xc8, 0xde, 0x2d, 0xcc,
-	0xd4, 0x97, 0x29, 0xec, 0xea, 0x77, 0xf2, 0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x88,
-	0x4d, 0x66, 0xc1, 0x03, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0xd8, 0xd8, 0x86, 0x37, 0x24, 0x64, 0xba, 0xa9, 0x8b, 0x4a, 0x3a, 0x02, 0x4c, 0xdd,
+	0x60, 0x76, 0x13, 0x4e, 0x48, 0x9c, 0x82, 0xf8, 0x75, 0x83, 0xf6, 0x86, 0x84, 0x2a, 0xa7, 0xf3,
+	0xd2, 0xa8, 0xad, 0x9d, 0xc5, 0x6e, 0x45, 0xaf, 0x48, 0x5c, 0x90, 0x40, 0x48, 0xdc, 0xf9, 0x03,
+	0xf8, 0x4b, 0x76, 0x42, 0x93, 0xb8, 0x20, 0x0e, 0x03, 0xb5, 0xfc, 0x21, 0x28, 0xb6, 0x5b, 0x5a,
+	0xd6, 0x8d, 0x72, 0x8a, 0xf5, 0xf9, 0x7d, 0xef, 0x7b, 0x9f, 0xdf, 0x53, 0x80, 0x4b, 0x65, 0x93,
+	0xa6, 0x9d, 0x98, 0x49, 0x7c, 0x40, 0x69, 0x87, 0xa4, 0x2d, 0x2a, 0x71, 0xcf, 0xc3, 0x87, 0x5d,
+	0x9a, 0xf6, 0x51, 0x92, 0x72, 0xc9, 0xe1, 0xc6, 0x18, 0x83, 0xc6, 0x18, 0xd4, 0xf3, 0x6c, 0xa7,
+	0xc1, 0x45, 0x87, 0x0b, 0x1c, 0x12, 0x41, 0x71, 0xcf, 0x0b, 0xa9, 0x24, 0x1e, 0x6e, 0xf0, 0x98,
+	0xe9, 0x3e, 0x7b, 0xfb, 0x0c, 0xee, 0x3f, 0x24, 0x1a, 0x97, 0x8f, 0x78, 0xc4, 0xd5, 0x11, 0x67,
+	0x27, 0x53, 0x2d, 0x46, 0x9c, 0x47, 0x6d, 0x8a, 0x49, 0x12, 0x63, 0xc2, 0x18, 0x97, 0x44, 0xc6,
+	0x9c, 0x09, 0x7d, 0xeb, 0xe6, 0x01, 0x7c, 0x9e, 0x49, 0x7c, 0x46, 0x52, 0xd2, 0x11, 0x55, 0x7a,
+	0xd8, 0xa5, 0x42, 0xba, 0x35, 0x70, 0x75, 0xaa, 0x2a, 0x12, 0xce, 0x04, 0x85, 0xf7, 0xc1, 0x52,
+	0xa2, 0x2a, 0x05, 0x6b, 0xcb, 0x2a, 0xaf, 0xfa, 0x0e, 0x9a, 0xbd, 0x11, 0xd2, 0x7d, 0xc1, 0xe2,
+	0xd1, 0x49, 0x29, 0x57, 0x35, 0x3d, 0xee, 0xba, 0x21, 0x0d, 0x88, 0xa0, 0x8f, 0x28, 0x1d, 0xcd,
+	0x7a, 0x09, 0xf2, 0xd3, 0x65, 0x33, 0xec, 0x21, 0x58, 0xc9, 0x1e, 0xa4, 0x7e, 0x40, 0xa9, 0x1a,
+	0x77, 0x29, 0xd8, 0xfd, 0x7e, 0x52, 0xda, 0x8e, 0x62, 0xd9, 0xec, 0x86, 0xa8, 0xc1, 0x3b, 0xd8,
+	0x3c, 0x9b, 0xfe, 0xec, 0x89, 0xfd, 0x16, 0x96, 0xfd, 0x84, 0x0a, 0xf4, 0x94, 0xc9, 0xea, 0x72,
+	0xa8, 0xe9, 0xdc, 0x8d, 0x11, 0x7d, 0x9b, 0x37, 0x5a, 0x8f, 0xc9, 0x78, 0xc5, 0x1d, 0xb0, 0xfe,
+	0x57, 0xdd, 0xcc, 0xbd, 0x02, 0x16, 0x22, 0xa2, 0x37, 0x5c, 0xa8, 0x66, 0x47, 0xd7, 0x06, 0x05,
+	0x0d, 0xed, 0xa6, 0x8c, 0xee, 0xd7, 0xba, 0x49, 0xd2, 0xee, 0x8f, 0x68, 0xde, 0x59, 0x60, 0x73,
+	0xc6, 0xa5, 0xe1, 0x4a, 0xc0, 0xe5, 0x50, 0xd5, 0xeb, 0x42, 0x5d, 0x14, 0xac, 0xad, 0x85, 0xf2,
+	0xaa, 0xbf, 0x89, 0xb4, 0x66, 0x94, 0x89, 0x44, 0xc6, 0x71, 0xf4, 0x80, 0xc7, 0x2c, 0xa8, 0x64,
+	0x4f, 0xf6, 0xf9, 0x47, 0xa9, 0x3c, 0xc7, 0x9e, 0x59, 0x83, 0xa8, 0xae, 0x85, 0x13, 0x93, 0xfd,
+	0x2f, 0x8b, 0xe0, 0xa2, 0xd2, 0x03, 0xdf, 0x58, 0x60, 0x49, 0xfb, 0x00, 0x77, 0xcf, 0xf2, 0xe9,
+	0xb4, 0xf5, 0xf6, 0xed, 0xb9, 0xb0, 0x7a, 0x3f, 0xd7, 0x7d, 0xfd, 0xf5, 0xd7, 0xc7, 0x0b, 0x45,
+	0x68, 0x63, 0xda, 0xcb, 0x24, 0x4e, 0xc5, 0x53, 0xdb, 0x0e, 0xdf, 0x5a, 0x60, 0xd9, 0x78, 0x0b,
+	0xcf, 0x27, 0x9f, 0x0e, 0x86, 0x7d, 0x67, 0x3e, 0xb0, 0x91, 0x72, 0x53, 0x49, 0x71, 0x60, 0x71,
+	0x96, 0x94, 0x51, 0x90, 0xe0, 0x7b, 0x0b, 0xac, 0x8c, 0x1c, 0x87, 0xff, 0x18, 0x30, 0x1d, 0x18,
+	0x7b, 0x6f, 0x4e, 0xb4, 0xd1, 0x73, 0x4b, 0xe9, 0x29, 0xc1, 0x6b, 0x33, 0xf5, 0x64, 0xe8, 0x7a,
+	0x44, 0x04, 0xfc, 0x64, 0x81, 0xb5, 0xc9, 0xe8, 0xc0, 0xca, 0xf9, 0x63, 0x4e, 0x47, 0xd0, 0xf6,
+	0xfe, 0xa3, 0xc3, 0x88, 0xdb, 0x51, 0xe2, 0x6e, 0xc0, 0xeb, 0x33, 0xc5, 0x4d, 0x26, 0x36, 0xa8,
+	0x1d, 0x0d, 0x1c, 0xeb, 0x78, 0xe0, 0x58, 0x3f, 0x07, 0x8e, 0xf5, 0x61, 0xe8, 0xe4, 0x8e, 0x87,
+	0x4e, 0xee, 0xdb, 0xd0, 0xc9, 0xbd, 0xb8, 0x37, 0x11, 0xd1, 0x27, 0x24, 0x4d, 0xfb, 0x41, 0xcc,
+	0xfc, 0x4a, 0xc5, 0xc7, 0x2d, 0x12, 0xa7, 0x5c, 0x34, 0x9a, 0x24, 0x66, 0xb8, 0xe7, 0xf9, 0xf8,
+	0xd5, 0x04, 0xbf, 0x4a, 0x6e, 0xb8, 0xa4, 0x7e, 0x3e, 0x77, 0x7f, 0x0f, 0x00, 0x4c, 0x1c, 0xdd,
+	0x47, 0x36, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -338,6 +433,8 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedSupply queries the cumulative amount of fees burned by the module
+	BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -375,6 +472,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error) {
+	out := new(QueryBurnedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BurnedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -383,6 +489,8 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedSupply queries the cumulative amount of fees burned by the module
+	BurnedSupply(context.Context, *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -398,6 +506,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BurnedSupply(ctx context.Context, req *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -457,6 +568,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BurnedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedSupply(ctx, req.(*QueryBurnedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -473,6 +602,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedSupply",
+			Handler:    _Query_BurnedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -643,6 +776,66 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BurnedSupply) > 0 {
+		for iNdEx := len(m.BurnedSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -717,6 +910,30 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurnedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BurnedSupply) > 0 {
+		for _, e := range m.BurnedSupply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1111,6 +1328,140 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedSupply = append(m.BurnedSupply, types.Coin{})
+			if err := m.BurnedSupply[len(m.BurnedSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
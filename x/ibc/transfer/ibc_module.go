@@ -1,9 +1,14 @@
 package transfer
 
 import (
-	"github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	ibctransfer "github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/keeper"
+	"github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/types"
 )
 
 var _ porttypes.IBCModule = IBCModule{}
@@ -11,6 +16,7 @@ var _ porttypes.IBCModule = IBCModule{}
 // IBCModule implements the ICS26 interface for transfer given the transfer keeper.
 type IBCModule struct {
 	*ibctransfer.IBCModule
+	keeper keeper.Keeper
 }
 
 // NewIBCModule creates a new IBCModule given the keeper
@@ -18,5 +24,49 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 	transferModule := ibctransfer.NewIBCModule(*k.Keeper)
 	return IBCModule{
 		IBCModule: &transferModule,
+		keeper:    k,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It refunds the
+// sender on error acknowledgements and records the outcome of the tracked
+// packets.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	// the acknowledgement has been validated by the transfer module
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return err
 	}
+
+	status := types.PacketStatusAcknowledged
+	if !ack.Success() {
+		status = types.PacketStatusFailed
+	}
+
+	im.keeper.UpdatePacketStatus(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, status)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It refunds the sender
+// and records the outcome of the tracked packets.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.UpdatePacketStatus(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, types.PacketStatusTimedOut)
+	return nil
 }
@@ -18,6 +18,7 @@ import (
 // to be sent via IBC.
 type Keeper struct {
 	*keeper.Keeper
+	storeKey      storetypes.StoreKey
 	bankKeeper    types.BankKeeper
 	erc20Keeper   types.ERC20Keeper
 	accountKeeper types.AccountKeeper
@@ -46,6 +47,7 @@ func NewKeeper(
 
 	return Keeper{
 		Keeper:        &transferKeeper,
+		storeKey:      storeKey,
 		bankKeeper:    bankKeeper,
 		erc20Keeper:   erc20Keeper,
 		accountKeeper: accountKeeper,
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/types"
+)
+
+// GetPacketStatus returns the status of the packet sent on the given port and
+// channel. Packets that are not tracked have the unknown status.
+func (k Keeper) GetPacketStatus(ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64) types.PacketStatus {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketStatus)
+	bz := store.Get(types.PacketStatusKey(sourcePort, sourceChannel, sequence))
+	if len(bz) == 0 {
+		return types.PacketStatusUnknown
+	}
+
+	return types.PacketStatus(bz[0])
+}
+
+// SetPacketStatus sets the status of the packet sent on the given port and
+// channel.
+func (k Keeper) SetPacketStatus(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	sequence uint64,
+	status types.PacketStatus,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketStatus)
+	store.Set(types.PacketStatusKey(sourcePort, sourceChannel, sequence), []byte{byte(status)})
+}
+
+// UpdatePacketStatus sets the status of the packet sent on the given port and
+// channel if the packet is tracked. It is a no-op otherwise.
+func (k Keeper) UpdatePacketStatus(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	sequence uint64,
+	status types.PacketStatus,
+) {
+	if k.GetPacketStatus(ctx, sourcePort, sourceChannel, sequence) == types.PacketStatusUnknown {
+		return
+	}
+
+	k.SetPacketStatus(ctx, sourcePort, sourceChannel, sequence, status)
+}
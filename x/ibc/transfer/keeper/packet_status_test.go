@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"github.com/HarryBin2002/kairoschain/v12/x/ibc/transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestPacketStatus() {
+	suite.SetupTest()
+	keeper := suite.app.TransferKeeper
+
+	suite.Require().Equal(types.PacketStatusUnknown, keeper.GetPacketStatus(suite.ctx, "transfer", "channel-0", 1))
+
+	// untracked packets are not updated
+	keeper.UpdatePacketStatus(suite.ctx, "transfer", "channel-0", 1, types.PacketStatusAcknowledged)
+	suite.Require().Equal(types.PacketStatusUnknown, keeper.GetPacketStatus(suite.ctx, "transfer", "channel-0", 1))
+
+	keeper.SetPacketStatus(suite.ctx, "transfer", "channel-0", 1, types.PacketStatusPending)
+	keeper.UpdatePacketStatus(suite.ctx, "transfer", "channel-0", 1, types.PacketStatusTimedOut)
+	suite.Require().Equal(types.PacketStatusTimedOut, keeper.GetPacketStatus(suite.ctx, "transfer", "channel-0", 1))
+	suite.Require().Equal(types.PacketStatusUnknown, keeper.GetPacketStatus(suite.ctx, "transfer", "channel-1", 1))
+	suite.Require().Equal(types.PacketStatusUnknown, keeper.GetPacketStatus(suite.ctx, "transfer", "channel-0", 2))
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// prefix bytes for the IBC transfer store. They start after the prefixes of
+// the ICS20 transfer module, which shares the same store.
+const (
+	prefixPacketStatus = iota + 0x10
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixPacketStatus = []byte{prefixPacketStatus}
+)
+
+// PacketStatusKey returns the store key of the status of the packet sent on the
+// given port and channel: port | "/" | channel | "/" | sequence.
+func PacketStatusKey(sourcePort, sourceChannel string, sequence uint64) []byte {
+	key := make([]byte, 0, len(sourcePort)+len(sourceChannel)+10)
+	key = append(key, sourcePort...)
+	key = append(key, '/')
+	key = append(key, sourceChannel...)
+	key = append(key, '/')
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,37 @@
+package types
+
+// PacketStatus is the outcome of a fungible token packet sent by the chain.
+type PacketStatus uint8
+
+const (
+	// PacketStatusUnknown is the status of the packets that are not tracked.
+	PacketStatusUnknown PacketStatus = iota
+	// PacketStatusPending is the status of the packets waiting for an
+	// acknowledgement or a timeout.
+	PacketStatusPending
+	// PacketStatusAcknowledged is the status of the packets acknowledged with a
+	// successful result.
+	PacketStatusAcknowledged
+	// PacketStatusFailed is the status of the packets acknowledged with an
+	// error. The tokens are refunded to the sender.
+	PacketStatusFailed
+	// PacketStatusTimedOut is the status of the packets that timed out. The
+	// tokens are refunded to the sender.
+	PacketStatusTimedOut
+)
+
+// String implements the fmt.Stringer interface.
+func (s PacketStatus) String() string {
+	switch s {
+	case PacketStatusPending:
+		return "pending"
+	case PacketStatusAcknowledged:
+		return "acknowledged"
+	case PacketStatusFailed:
+		return "failed"
+	case PacketStatusTimedOut:
+		return "timed out"
+	default:
+		return "unknown"
+	}
+}
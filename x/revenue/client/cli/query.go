@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+// GetQueryCmd returns the parent command for all revenue CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the revenue module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetRevenuesCmd(),
+		GetRevenueCmd(),
+		GetParamsCmd(),
+		GetDeployerRevenuesCmd(),
+		GetWithdrawerRevenuesCmd(),
+	)
+	return cmd
+}
+
+// GetRevenuesCmd queries all registered revenues
+func GetRevenuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts",
+		Short: "Query all revenues",
+		Long:  "Query all revenues",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRevenuesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Revenues(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all revenues")
+	return cmd
+}
+
+// GetRevenueCmd queries the revenue of a given contract
+func GetRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract CONTRACT_ADDRESS",
+		Short: "Query the revenue of a given contract",
+		Long:  "Query the revenue of a given contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRevenueRequest{
+				ContractAddress: args[0],
+			}
+
+			res, err := queryClient.Revenue(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries revenue module params
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets revenue params",
+		Long:  "Gets revenue params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDeployerRevenuesCmd queries the contracts registered by a deployer
+func GetDeployerRevenuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployer-contracts DEPLOYER_ADDRESS",
+		Short: "Query all contracts that a given deployer has registered for revenue distribution",
+		Long:  "Query all contracts that a given deployer has registered for revenue distribution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDeployerRevenuesRequest{
+				DeployerAddress: args[0],
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.DeployerRevenues(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deployer contracts")
+	return cmd
+}
+
+// GetWithdrawerRevenuesCmd queries the contracts withdrawn by a withdrawer
+func GetWithdrawerRevenuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawer-contracts WITHDRAWER_ADDRESS",
+		Short: "Query all contracts that have been registered for revenue distribution with a given withdrawer address",
+		Long:  "Query all contracts that have been registered for revenue distribution with a given withdrawer address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryWithdrawerRevenuesRequest{
+				WithdrawerAddress: args[0],
+				Pagination:        pageReq,
+			}
+
+			res, err := queryClient.WithdrawerRevenues(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawer contracts")
+	return cmd
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+// NewTxCmd returns a root CLI command handler for revenue transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "revenue subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterRevenue(),
+		NewCancelRevenue(),
+		NewUpdateRevenue(),
+	)
+	return txCmd
+}
+
+// NewRegisterRevenue returns a CLI command handler for registering a
+// contract for fee distribution
+func NewRegisterRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_HEX NONCES [WITHDRAWER_BECH32]",
+		Short: "Register a contract for fee distribution.",
+		Long:  "Register a contract for receiving transaction fees, the NONCES are the comma separated nonces of the path from the deployer to the contract, starting with the nonce of the deployment transaction. If the contract was deployed by a factory, the nonces of the factories follow.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deployer := cliCtx.GetFromAddress()
+
+			contract := args[0]
+			var nonces []uint64
+			for _, nonce := range strings.Split(args[1], ",") {
+				n, err := strconv.ParseUint(strings.TrimSpace(nonce), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", nonce, err)
+				}
+				nonces = append(nonces, n)
+			}
+
+			var withdrawer sdk.AccAddress
+			if len(args) == 3 {
+				withdrawer, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterRevenue(contract, deployer, withdrawer, nonces)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelRevenue returns a CLI command handler for canceling a contract
+// for fee distribution
+func NewCancelRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel CONTRACT_HEX",
+		Short: "Cancel a contract from fee distribution",
+		Long:  "Cancel a contract from fee distribution. The deployer will no longer receive transaction fees from the contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRevenue(args[0], cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateRevenue returns a CLI command handler for updating the withdraw
+// address of a contract for fee distribution
+func NewUpdateRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update CONTRACT_HEX WITHDRAWER_BECH32",
+		Short: "Update withdrawer address for a contract registered for fee distribution.",
+		Long:  "Update withdrawer address for a contract registered for fee distribution. Only the contract deployer can update the withdrawer address.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRevenue(args[0], cliCtx.GetFromAddress(), withdrawer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
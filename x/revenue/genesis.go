@@ -0,0 +1,44 @@
+package revenue
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/keeper"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	err := k.SetParams(ctx, data.Params)
+	if err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	for _, revenue := range data.Revenues {
+		contract := revenue.GetContractAddr()
+		deployer := revenue.GetDeployerAddr()
+		withdrawer := revenue.GetWithdrawerAddr()
+
+		// set initial contracts receiving transaction fees
+		k.SetRevenue(ctx, revenue)
+		k.SetDeployerMap(ctx, deployer, contract)
+
+		if len(withdrawer) != 0 {
+			k.SetWithdrawerMap(ctx, withdrawer, contract)
+		}
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:   k.GetParams(ctx),
+		Revenues: k.GetRevenues(ctx),
+	}
+}
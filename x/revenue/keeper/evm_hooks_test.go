@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		register   bool
+		withdrawer sdk.AccAddress
+		enabled    bool
+		expRevenue bool
+	}{
+		{"registered contract, withdrawer receives the revenue", true, withdrawer, true, true},
+		{"registered contract, deployer receives the revenue", true, nil, true, true},
+		{"unregistered contract", false, withdrawer, true, false},
+		{"revenue disabled", true, withdrawer, false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			deployer := sdk.AccAddress(suite.address.Bytes())
+			contract := suite.DeployContract(suite.address, 0)
+			if tc.register {
+				_, err := suite.app.RevenueKeeper.RegisterRevenue(
+					sdk.WrapSDKContext(suite.ctx),
+					types.NewMsgRegisterRevenue(contract.Hex(), deployer, tc.withdrawer, []uint64{0}),
+				)
+				suite.Require().NoError(err)
+			}
+
+			params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+			params.EnableRevenue = tc.enabled
+			suite.Require().NoError(suite.app.RevenueKeeper.SetParams(suite.ctx, params))
+
+			receiver := withdrawer
+			if tc.withdrawer == nil {
+				receiver = deployer
+			}
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, receiver, suite.denom)
+
+			burnedBefore := suite.app.FeeMarketKeeper.GetBurnedSupply(suite.ctx).AmountOf(suite.denom)
+			baseFee := suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx)
+			res := suite.CallContract(contract)
+
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, receiver, suite.denom)
+			revenue := balanceAfter.Amount.Sub(balanceBefore.Amount)
+			if receiver.Equals(deployer) {
+				// the deployer also sent the transaction and got refunded the
+				// unused gas
+				refund := sdk.NewIntFromUint64(100_000 - res.GasUsed).Mul(sdk.NewIntFromBigInt(baseFee))
+				revenue = revenue.Sub(refund)
+			}
+
+			txFee := sdk.NewIntFromUint64(res.GasUsed).Mul(sdk.NewIntFromBigInt(baseFee))
+			expRevenue := sdk.ZeroInt()
+			if tc.expRevenue {
+				expRevenue = params.DeveloperShares.MulInt(txFee).TruncateInt()
+				suite.Require().True(expRevenue.IsPositive())
+			}
+			suite.Require().Equal(expRevenue.String(), revenue.String())
+
+			// the whole base fee is burned, but the burn is capped by the fee
+			// left once the revenue is paid
+			burned := suite.app.FeeMarketKeeper.GetBurnedSupply(suite.ctx).AmountOf(suite.denom).Sub(burnedBefore)
+			suite.Require().Equal(txFee.Sub(expRevenue).String(), burned.String())
+		})
+	}
+}
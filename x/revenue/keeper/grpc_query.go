@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evertypes "github.com/HarryBin2002/kairoschain/v12/types"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Revenues returns all revenues that have been registered for fee distribution
+func (k Keeper) Revenues(
+	c context.Context,
+	req *types.QueryRevenuesRequest,
+) (*types.QueryRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var revenues []types.Revenue
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var revenue types.Revenue
+		if err := k.cdc.Unmarshal(value, &revenue); err != nil {
+			return err
+		}
+		revenues = append(revenues, revenue)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRevenuesResponse{
+		Revenues:   revenues,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Revenue returns the Revenue that has been registered for fee distribution for
+// a given contract
+func (k Keeper) Revenue(
+	c context.Context,
+	req *types.QueryRevenueRequest,
+) (*types.QueryRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the contract is a non-zero hex address
+	if err := evertypes.ValidateNonZeroAddress(req.ContractAddress); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			err.Error(),
+		)
+	}
+
+	revenue, found := k.GetRevenue(ctx, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"revenue registered contract '%s'",
+			req.ContractAddress,
+		)
+	}
+
+	return &types.QueryRevenueResponse{Revenue: revenue}, nil
+}
+
+// Params returns the revenue module params
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DeployerRevenues returns all contracts that have been registered for fee
+// distribution by a given deployer
+func (k Keeper) DeployerRevenues(
+	c context.Context,
+	req *types.QueryDeployerRevenuesRequest,
+) (*types.QueryDeployerRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(req.DeployerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for deployer %s, should be bech32", req.DeployerAddress,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployer(deployer))
+	contracts, pageRes, err := paginateContracts(store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeployerRevenuesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// WithdrawerRevenues returns all fees for a given withdraw address
+func (k Keeper) WithdrawerRevenues(
+	c context.Context,
+	req *types.QueryWithdrawerRevenuesRequest,
+) (*types.QueryWithdrawerRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32", req.WithdrawerAddress,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixWithdrawer(withdrawer))
+	contracts, pageRes, err := paginateContracts(store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawerRevenuesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// paginateContracts returns the contract addresses keyed in the reverse
+// mapping store.
+func paginateContracts(store prefix.Store, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	var contracts []string
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		contracts = append(contracts, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return contracts, pageRes, nil
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryRevenues() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	deployer := sdk.AccAddress(suite.address.Bytes())
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	var contracts []common.Address
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		contract := suite.DeployContract(suite.address, nonce)
+		contracts = append(contracts, contract)
+
+		// only the first contract has a withdrawer
+		var w sdk.AccAddress
+		if nonce == 0 {
+			w = withdrawer
+		}
+		_, err := suite.app.RevenueKeeper.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(contract.Hex(), deployer, w, []uint64{nonce}))
+		suite.Require().NoError(err)
+	}
+
+	revenues, err := suite.queryClient.Revenues(ctx, &types.QueryRevenuesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(revenues.Revenues, 3)
+	suite.Require().Equal(suite.app.RevenueKeeper.GetRevenues(suite.ctx), revenues.Revenues)
+
+	revenue, err := suite.queryClient.Revenue(ctx, &types.QueryRevenueRequest{ContractAddress: contracts[0].Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(withdrawer.String(), revenue.Revenue.WithdrawerAddress)
+
+	_, err = suite.queryClient.Revenue(ctx, &types.QueryRevenueRequest{ContractAddress: utiltx.GenerateAddress().Hex()})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.Revenue(ctx, &types.QueryRevenueRequest{ContractAddress: "0x"})
+	suite.Require().Error(err)
+
+	deployerRevenues, err := suite.queryClient.DeployerRevenues(ctx, &types.QueryDeployerRevenuesRequest{DeployerAddress: deployer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(deployerRevenues.ContractAddresses, 3)
+	for _, contract := range contracts {
+		suite.Require().Contains(deployerRevenues.ContractAddresses, contract.Hex())
+	}
+
+	withdrawerRevenues, err := suite.queryClient.WithdrawerRevenues(ctx, &types.QueryWithdrawerRevenuesRequest{WithdrawerAddress: withdrawer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contracts[0].Hex()}, withdrawerRevenues.ContractAddresses)
+
+	_, err = suite.queryClient.DeployerRevenues(ctx, &types.QueryDeployerRevenuesRequest{DeployerAddress: "invalid"})
+	suite.Require().Error(err)
+
+	params, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), params.Params)
+}
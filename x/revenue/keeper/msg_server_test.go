@@ -0,0 +1,298 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterRevenue() {
+	var (
+		contract common.Address
+		msg      *types.MsgRegisterRevenue
+	)
+	var deployer sdk.AccAddress
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok - contract deployed by the deployer",
+			func() {
+				contract = suite.DeployContract(suite.address, 0)
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{0})
+			},
+			true,
+		},
+		{
+			"ok - contract deployed through factories, without withdrawer",
+			func() {
+				contract = suite.DeployContract(suite.address, 1, 5, 2)
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1, 5, 2})
+			},
+			true,
+		},
+		{
+			"fail - revenue disabled",
+			func() {
+				params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+				params.EnableRevenue = false
+				suite.Require().NoError(suite.app.RevenueKeeper.SetParams(suite.ctx, params))
+
+				contract = suite.DeployContract(suite.address, 0)
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{0})
+			},
+			false,
+		},
+		{
+			"fail - contract already registered",
+			func() {
+				contract = suite.DeployContract(suite.address, 0)
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{0})
+				_, err := suite.app.RevenueKeeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - deployer account not found",
+			func() {
+				other := utiltx.GenerateAddress()
+				contract = suite.DeployContract(other, 0)
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), other.Bytes(), withdrawer, []uint64{0})
+			},
+			false,
+		},
+		{
+			"fail - deployer is a contract",
+			func() {
+				factory := suite.DeployContract(suite.address, 0)
+				contract = suite.DeployContract(factory, 1)
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), factory.Bytes(), withdrawer, []uint64{1})
+			},
+			false,
+		},
+		{
+			"fail - no contract deployed",
+			func() {
+				contract = utiltx.GenerateAddress()
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{0})
+			},
+			false,
+		},
+		{
+			"fail - wrong nonce",
+			func() {
+				contract = suite.DeployContract(suite.address, 0)
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{1})
+			},
+			false,
+		},
+		{
+			"fail - withdrawer is a blocked address",
+			func() {
+				contract = suite.DeployContract(suite.address, 0)
+				blocked := authtypes.NewModuleAddress(govtypes.ModuleName)
+				msg = types.NewMsgRegisterRevenue(contract.Hex(), deployer, blocked, []uint64{0})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			deployer = suite.address.Bytes()
+			tc.malleate()
+
+			_, err := suite.app.RevenueKeeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			revenue, found := suite.app.RevenueKeeper.GetRevenue(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(contract.Hex(), revenue.ContractAddress)
+			suite.Require().Equal(msg.DeployerAddress, revenue.DeployerAddress)
+			suite.Require().Equal(msg.WithdrawerAddress, revenue.WithdrawerAddress)
+			suite.Require().True(suite.app.RevenueKeeper.IsDeployerMapSet(suite.ctx, deployer, contract))
+			if msg.WithdrawerAddress != "" {
+				suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer, contract))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateRevenue() {
+	var (
+		deployer sdk.AccAddress
+		msg      *types.MsgUpdateRevenue
+	)
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	newWithdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name          string
+		malleate      func(contract common.Address)
+		expWithdrawer sdk.AccAddress
+		expPass       bool
+	}{
+		{
+			"ok - change the withdrawer",
+			func(contract common.Address) {
+				msg = types.NewMsgUpdateRevenue(contract.Hex(), deployer, newWithdrawer)
+			},
+			newWithdrawer,
+			true,
+		},
+		{
+			"ok - reset the withdrawer to the deployer",
+			func(contract common.Address) {
+				msg = types.NewMsgUpdateRevenue(contract.Hex(), deployer, deployer)
+			},
+			nil,
+			true,
+		},
+		{
+			"fail - contract not registered",
+			func(_ common.Address) {
+				msg = types.NewMsgUpdateRevenue(utiltx.GenerateAddress().Hex(), deployer, newWithdrawer)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - not the deployer",
+			func(contract common.Address) {
+				msg = types.NewMsgUpdateRevenue(contract.Hex(), newWithdrawer, newWithdrawer)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - same withdrawer",
+			func(contract common.Address) {
+				msg = types.NewMsgUpdateRevenue(contract.Hex(), deployer, withdrawer)
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			deployer = suite.address.Bytes()
+			contract := suite.DeployContract(suite.address, 0)
+			_, err := suite.app.RevenueKeeper.RegisterRevenue(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{0}),
+			)
+			suite.Require().NoError(err)
+
+			tc.malleate(contract)
+
+			_, err = suite.app.RevenueKeeper.UpdateRevenue(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			revenue, found := suite.app.RevenueKeeper.GetRevenue(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expWithdrawer, revenue.GetWithdrawerAddr())
+			suite.Require().False(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer, contract))
+			if tc.expWithdrawer != nil {
+				suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, tc.expWithdrawer, contract))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelRevenue() {
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		byDeployer bool
+		expPass    bool
+	}{
+		{"ok - cancel the revenue", true, true},
+		{"fail - not the deployer", false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			deployer := sdk.AccAddress(suite.address.Bytes())
+			contract := suite.DeployContract(suite.address, 0)
+			_, err := suite.app.RevenueKeeper.RegisterRevenue(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{0}),
+			)
+			suite.Require().NoError(err)
+
+			sender := withdrawer
+			if tc.byDeployer {
+				sender = deployer
+			}
+			_, err = suite.app.RevenueKeeper.CancelRevenue(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgCancelRevenue(contract.Hex(), sender),
+			)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().True(suite.app.RevenueKeeper.IsRevenueRegistered(suite.ctx, contract))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().False(suite.app.RevenueKeeper.IsRevenueRegistered(suite.ctx, contract))
+			suite.Require().False(suite.app.RevenueKeeper.IsDeployerMapSet(suite.ctx, deployer, contract))
+			suite.Require().False(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer, contract))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateParams{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "pass - valid Update msg",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.DefaultParams(),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := suite.app.RevenueKeeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
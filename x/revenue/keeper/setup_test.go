@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/HarryBin2002/kairoschain/v12/app"
+	"github.com/HarryBin2002/kairoschain/v12/constants"
+	"github.com/HarryBin2002/kairoschain/v12/crypto/ethsecp256k1"
+	"github.com/HarryBin2002/kairoschain/v12/testutil"
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/evm/statedb"
+	evmtypes "github.com/HarryBin2002/kairoschain/v12/x/evm/types"
+	feemarkettypes "github.com/HarryBin2002/kairoschain/v12/x/feemarket/types"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	app            *app.Kairoschain
+	queryClient    types.QueryClient
+	queryClientEvm evmtypes.QueryClient
+	priv           cryptotypes.PrivKey
+	address        common.Address
+	signer         keyring.Signer
+	denom          string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	suite.priv = priv
+	suite.address = common.BytesToAddress(priv.PubKey().Address().Bytes())
+	suite.signer = utiltx.NewSigner(priv)
+
+	privCons, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(privCons.PubKey().Address())
+
+	chainID := constants.TestnetFullChainId
+	suite.app = app.Setup(false, feemarkettypes.DefaultGenesisState(), chainID)
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.RevenueKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	queryHelperEvm := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	evmtypes.RegisterQueryServer(queryHelperEvm, suite.app.EvmKeeper)
+	suite.queryClientEvm = evmtypes.NewQueryClient(queryHelperEvm)
+
+	suite.denom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	// set the block proposer as validator, it's the coinbase of the EVM
+	valAddr := sdk.ValAddress(suite.address.Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr, privCons.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper, suite.ctx, validator, true)
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator)
+	suite.Require().NoError(err)
+
+	// fund the deployer account to pay for the tx fees
+	err = testutil.FundAccount(
+		suite.ctx,
+		suite.app.BankKeeper,
+		suite.address.Bytes(),
+		sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(1e18))),
+	)
+	suite.Require().NoError(err)
+}
+
+// DeployContract sets a contract code at the address derived from the
+// deployer and the nonces, as deployed by the deployer directly or through
+// factory contracts.
+func (suite *KeeperTestSuite) DeployContract(deployer common.Address, nonces ...uint64) common.Address {
+	contract := deployer
+	for _, nonce := range nonces {
+		contract = crypto.CreateAddress(contract, nonce)
+	}
+
+	vmdb := statedb.New(suite.ctx, suite.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.ctx.HeaderHash().Bytes())))
+	// the contract stops right away
+	vmdb.SetCode(contract, []byte{0x00})
+	suite.Require().NoError(vmdb.Commit())
+	return contract
+}
+
+// MintFeeCollector mints coins to the fee collector, which holds the fees
+// deducted by the ante handler.
+func (suite *KeeperTestSuite) MintFeeCollector(coins sdk.Coins) {
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, coins)
+	suite.Require().NoError(err)
+}
+
+// CallContract sends an Ethereum transaction calling the contract through the
+// EVM keeper, the fees are minted to the fee collector as if they were
+// deducted by the ante handler.
+func (suite *KeeperTestSuite) CallContract(contract common.Address) *evmtypes.MsgEthereumTxResponse {
+	gasLimit := uint64(100_000)
+	baseFee := suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx)
+	suite.MintFeeCollector(sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewIntFromBigInt(new(big.Int).Mul(baseFee, big.NewInt(int64(gasLimit)))))))
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   chainID,
+		Nonce:     suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		To:        &contract,
+		GasLimit:  gasLimit,
+		GasFeeCap: baseFee,
+		GasTipCap: big.NewInt(1),
+	})
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	res, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+	return res
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrRevenueDisabled              = errorsmod.Register(ModuleName, 2, "revenue module is disabled by governance")
+	ErrRevenueAlreadyRegistered     = errorsmod.Register(ModuleName, 3, "revenue already exists for given contract")
+	ErrRevenueNoContractDeployed    = errorsmod.Register(ModuleName, 4, "no contract deployed")
+	ErrRevenueContractNotRegistered = errorsmod.Register(ModuleName, 5, "no revenue registered for contract")
+	ErrRevenueDeployerIsNotEOA      = errorsmod.Register(ModuleName, 6, "deployer is not an EOA")
+	ErrRevenueWithdrawerBlocked     = errorsmod.Register(ModuleName, 7, "withdrawer address is not allowed to receive funds")
+)
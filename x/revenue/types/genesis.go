@@ -0,0 +1,39 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, revenues []Revenue) GenesisState {
+	return GenesisState{
+		Params:   params,
+		Revenues: revenues,
+	}
+}
+
+// DefaultGenesisState sets default revenue genesis state with no registered
+// contract and default params.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenContract := make(map[string]bool)
+	for _, revenue := range gs.Revenues {
+		if err := revenue.Validate(); err != nil {
+			return err
+		}
+
+		contract := revenue.GetContractAddr().Hex()
+		if seenContract[contract] {
+			return fmt.Errorf("contract duplicated on genesis '%s'", revenue.ContractAddress)
+		}
+
+		seenContract[contract] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	contract := utiltx.GenerateAddress()
+	deployer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default genesis",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Revenues: []types.Revenue{
+					types.NewRevenue(contract, deployer, withdrawer),
+					types.NewRevenue(utiltx.GenerateAddress(), deployer, nil),
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated contract",
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Revenues: []types.Revenue{
+					types.NewRevenue(contract, deployer, withdrawer),
+					types.NewRevenue(contract, deployer, nil),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid deployer",
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Revenues: []types.Revenue{
+					{ContractAddress: contract.Hex(), DeployerAddress: "invalid"},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - zero contract address",
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Revenues: []types.Revenue{
+					{ContractAddress: "0x0000000000000000000000000000000000000000", DeployerAddress: deployer.String()},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid params",
+			&types.GenesisState{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "revenue"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the revenue persistent store
+const (
+	prefixRevenue = iota + 1
+	prefixDeployer
+	prefixWithdrawer
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRevenue    = []byte{prefixRevenue}
+	KeyPrefixDeployer   = []byte{prefixDeployer}
+	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
+	KeyPrefixParams     = []byte{prefixParams}
+)
+
+// GetKeyPrefixDeployer returns the KVStore key prefix for the reverse mapping
+// of the contracts registered by a deployer.
+func GetKeyPrefixDeployer(deployerAddress sdk.AccAddress) []byte {
+	return append(KeyPrefixDeployer, deployerAddress.Bytes()...)
+}
+
+// GetKeyPrefixWithdrawer returns the KVStore key prefix for the reverse mapping
+// of the contracts withdrawn by a withdrawer.
+func GetKeyPrefixWithdrawer(withdrawerAddress sdk.AccAddress) []byte {
+	return append(KeyPrefixWithdrawer, withdrawerAddress.Bytes()...)
+}
@@ -0,0 +1,198 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/HarryBin2002/kairoschain/v12/types"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterRevenue{}
+	_ sdk.Msg = &MsgUpdateRevenue{}
+	_ sdk.Msg = &MsgCancelRevenue{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+const (
+	TypeMsgRegisterRevenue = "register_revenue"
+	TypeMsgUpdateRevenue   = "update_revenue"
+	TypeMsgCancelRevenue   = "cancel_revenue"
+
+	// MaxNonces is the maximum number of nonces of the derivation path of a
+	// registered contract, it limits the depth of the factory contracts.
+	MaxNonces = 20
+)
+
+// NewMsgRegisterRevenue creates new instance of MsgRegisterRevenue
+func NewMsgRegisterRevenue(
+	contract string,
+	deployer,
+	withdrawer sdk.AccAddress,
+	nonces []uint64,
+) *MsgRegisterRevenue {
+	withdrawerAddress := ""
+	if withdrawer != nil {
+		withdrawerAddress = withdrawer.String()
+	}
+
+	return &MsgRegisterRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddress,
+		Nonces:            nonces,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRegisterRevenue) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgRegisterRevenue) Type() string { return TypeMsgRegisterRevenue }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if err := types.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	if msg.WithdrawerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+		}
+	}
+
+	if len(msg.Nonces) < 1 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - empty array")
+	}
+
+	if len(msg.Nonces) > MaxNonces {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - array length must be less than or equal to %d", MaxNonces)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterRevenue) GetSigners() []sdk.AccAddress {
+	from := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUpdateRevenue creates new instance of MsgUpdateRevenue
+func NewMsgUpdateRevenue(
+	contract string,
+	deployer,
+	withdrawer sdk.AccAddress,
+) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUpdateRevenue) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgUpdateRevenue) Type() string { return TypeMsgUpdateRevenue }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if err := types.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUpdateRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateRevenue) GetSigners() []sdk.AccAddress {
+	from := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgCancelRevenue creates new instance of MsgCancelRevenue.
+func NewMsgCancelRevenue(
+	contract string,
+	deployer sdk.AccAddress,
+) *MsgCancelRevenue {
+	return &MsgCancelRevenue{
+		ContractAddress: contract,
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// Route returns the message route for a MsgCancelRevenue.
+func (msg MsgCancelRevenue) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCancelRevenue.
+func (msg MsgCancelRevenue) Type() string { return TypeMsgCancelRevenue }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if err := types.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelRevenue) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
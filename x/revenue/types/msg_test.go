@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/HarryBin2002/kairoschain/v12/testutil/tx"
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+	contract   string
+	deployer   sdk.AccAddress
+	withdrawer sdk.AccAddress
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.contract = utiltx.GenerateAddress().Hex()
+	suite.deployer = utiltx.GenerateAddress().Bytes()
+	suite.withdrawer = utiltx.GenerateAddress().Bytes()
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterRevenue() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterRevenue
+		expPass bool
+	}{
+		{"valid", types.NewMsgRegisterRevenue(suite.contract, suite.deployer, suite.withdrawer, []uint64{1}), true},
+		{"valid without withdrawer", types.NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, []uint64{1, 2}), true},
+		{"invalid contract", types.NewMsgRegisterRevenue("0x", suite.deployer, suite.withdrawer, []uint64{1}), false},
+		{"invalid deployer", &types.MsgRegisterRevenue{ContractAddress: suite.contract, DeployerAddress: "invalid", Nonces: []uint64{1}}, false},
+		{"invalid withdrawer", &types.MsgRegisterRevenue{ContractAddress: suite.contract, DeployerAddress: suite.deployer.String(), WithdrawerAddress: "invalid", Nonces: []uint64{1}}, false},
+		{"empty nonces", types.NewMsgRegisterRevenue(suite.contract, suite.deployer, suite.withdrawer, nil), false},
+		{"too many nonces", types.NewMsgRegisterRevenue(suite.contract, suite.deployer, suite.withdrawer, make([]uint64, types.MaxNonces+1)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal([]sdk.AccAddress{suite.deployer}, tc.msg.GetSigners())
+			suite.Require().NotEmpty(tc.msg.GetSignBytes())
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateRevenue() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateRevenue
+		expPass bool
+	}{
+		{"valid", types.NewMsgUpdateRevenue(suite.contract, suite.deployer, suite.withdrawer), true},
+		{"invalid contract", types.NewMsgUpdateRevenue("0x", suite.deployer, suite.withdrawer), false},
+		{"invalid deployer", &types.MsgUpdateRevenue{ContractAddress: suite.contract, DeployerAddress: "invalid", WithdrawerAddress: suite.withdrawer.String()}, false},
+		{"empty withdrawer", &types.MsgUpdateRevenue{ContractAddress: suite.contract, DeployerAddress: suite.deployer.String()}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal([]sdk.AccAddress{suite.deployer}, tc.msg.GetSigners())
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCancelRevenue() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgCancelRevenue
+		expPass bool
+	}{
+		{"valid", types.NewMsgCancelRevenue(suite.contract, suite.deployer), true},
+		{"invalid contract", types.NewMsgCancelRevenue("0x", suite.deployer), false},
+		{"invalid deployer", &types.MsgCancelRevenue{ContractAddress: suite.contract, DeployerAddress: "invalid"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal([]sdk.AccAddress{suite.deployer}, tc.msg.GetSigners())
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// DefaultDeveloperShares is the default proportion of the transaction fees
+	// distributed to the developers
+	DefaultDeveloperShares = sdk.NewDecWithPrec(50, 2)
+	// DefaultAddrDerivationCostCreate is the default gas cost of the derivation
+	// of a contract address, the gas cost of the CREATE opcode
+	DefaultAddrDerivationCostCreate = uint64(50)
+)
+
+// NewParams creates a new Params object
+func NewParams(
+	enableRevenue bool,
+	developerShares sdk.Dec,
+	addrDerivationCostCreate uint64,
+) Params {
+	return Params{
+		EnableRevenue:            enableRevenue,
+		DeveloperShares:          developerShares,
+		AddrDerivationCostCreate: addrDerivationCostCreate,
+	}
+}
+
+// DefaultParams returns the default revenue module parameters.
+func DefaultParams() Params {
+	return Params{
+		EnableRevenue:            true,
+		DeveloperShares:          DefaultDeveloperShares,
+		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+	}
+}
+
+// Validate performs a basic validation of the revenue parameters.
+func (p Params) Validate() error {
+	return validateShares(p.DeveloperShares)
+}
+
+func validateShares(shares sdk.Dec) error {
+	if shares.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+	if shares.IsNegative() {
+		return fmt.Errorf("value cannot be negative: %s", shares)
+	}
+	if shares.GT(sdk.OneDec()) {
+		return fmt.Errorf("value cannot be greater than 1: %s", shares)
+	}
+	return nil
+}
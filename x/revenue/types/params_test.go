@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/HarryBin2002/kairoschain/v12/x/revenue/types"
+)
+
+type ParamsTestSuite struct {
+	suite.Suite
+}
+
+func TestParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamsTestSuite))
+}
+
+func (suite *ParamsTestSuite) TestParamsValidate() {
+	testCases := []struct {
+		name     string
+		params   types.Params
+		expError bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{
+			"valid",
+			types.NewParams(true, sdk.NewDecWithPrec(25, 2), 100),
+			false,
+		},
+		{
+			"all the fees to the developers",
+			types.NewParams(true, sdk.OneDec(), 100),
+			false,
+		},
+		{
+			"empty",
+			types.Params{},
+			true,
+		},
+		{
+			"negative shares",
+			types.NewParams(true, sdk.NewDec(-1), 100),
+			true,
+		},
+		{
+			"shares greater than one",
+			types.NewParams(true, sdk.NewDecWithPrec(11, 1), 100),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
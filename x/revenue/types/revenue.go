@@ -0,0 +1,62 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/HarryBin2002/kairoschain/v12/types"
+)
+
+// NewRevenue returns an instance of Revenue. If the provided withdrawer
+// address is empty, it sets the value to an empty string.
+func NewRevenue(contract common.Address, deployer, withdrawer sdk.AccAddress) Revenue {
+	withdrawerAddr := ""
+	if len(withdrawer) > 0 {
+		withdrawerAddr = withdrawer.String()
+	}
+
+	return Revenue{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddr,
+	}
+}
+
+// GetContractAddr returns the contract address
+func (r Revenue) GetContractAddr() common.Address {
+	return common.HexToAddress(r.ContractAddress)
+}
+
+// GetDeployerAddr returns the contract deployer address
+func (r Revenue) GetDeployerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.DeployerAddress)
+}
+
+// GetWithdrawerAddr returns the account address to where the funds are sent,
+// it's empty if the funds are sent to the deployer.
+func (r Revenue) GetWithdrawerAddr() sdk.AccAddress {
+	if r.WithdrawerAddress == "" {
+		return nil
+	}
+	return sdk.MustAccAddressFromBech32(r.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of a Revenue
+func (r Revenue) Validate() error {
+	if err := types.ValidateNonZeroAddress(r.ContractAddress); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	if r.WithdrawerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(r.WithdrawerAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid withdrawer address")
+		}
+	}
+
+	return nil
+}
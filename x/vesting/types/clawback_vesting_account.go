@@ -4,6 +4,8 @@ import (
 	"errors"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
@@ -209,3 +211,26 @@ func (va ClawbackVestingAccount) ComputeClawback(
 func (va ClawbackVestingAccount) HasLockedCoins(blockTime time.Time) bool {
 	return !va.GetLockedOnly(blockTime).IsZero()
 }
+
+// ValidateDelegation returns an error if the given amount of the bond denomination
+// exceeds the coins vested at blockTime, as only vested coins can be delegated.
+func (va ClawbackVestingAccount) ValidateDelegation(blockTime time.Time, bondDenom string, amount sdkmath.Int) error {
+	coins := va.GetVestedOnly(blockTime)
+	if coins == nil || coins.Empty() {
+		return errorsmod.Wrap(
+			ErrInsufficientVestedCoins,
+			"account has no vested coins",
+		)
+	}
+
+	vested := coins.AmountOf(bondDenom)
+	if vested.LT(amount) {
+		return errorsmod.Wrapf(
+			ErrInsufficientVestedCoins,
+			"cannot delegate unvested coins. coins vested < delegation amount (%s < %s)",
+			vested, amount,
+		)
+	}
+
+	return nil
+}